@@ -25,10 +25,12 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	systemd "github.com/coreos/go-systemd/daemon"
@@ -54,14 +56,14 @@ import (
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"golang.org/x/net/context"
 )
 
 const (
 	// Maximum duration before timing out read/write requests
 	// Set to a value larger than the timeouts in each watch server.
-	ReadWriteTimeout = time.Minute * 60
-	//TODO: This can be tightened up. It still matches objects named watch or proxy.
-	defaultLongRunningRequestRE = "(/|^)((watch|proxy)(/|$)|(logs?|portforward|exec|attach)/?$)"
+	ReadWriteTimeout            = time.Minute * 60
+	defaultLongRunningRequestRE = master.DefaultLongRunningRequestRE
 )
 
 // APIServer runs a kubernetes api server.
@@ -79,6 +81,7 @@ type APIServer struct {
 	APIGroupPrefix             string
 	DeprecatedStorageVersion   string
 	StorageVersions            string
+	StorageReadVersions        string
 	CloudProvider              string
 	CloudConfigFile            string
 	EventTTL                   time.Duration
@@ -110,12 +113,16 @@ type APIServer struct {
 	EnableProfiling            bool
 	EnableWatchCache           bool
 	MaxRequestsInFlight        int
+	MaxReadOnlyInFlight        int
+	MaxMutatingInFlight        int
+	MaxWatchesInFlight         int
 	MinRequestTimeout          int
 	LongRunningRequestRE       string
 	SSHUser                    string
 	SSHKeyfile                 string
 	MaxConnectionBytesPerSec   int64
 	KubernetesServiceNodePort  int
+	ShutdownDelay              time.Duration
 }
 
 // NewAPIServer creates a new APIServer object with default parameters
@@ -135,6 +142,7 @@ func NewAPIServer() *APIServer {
 		MasterServiceNamespace: api.NamespaceDefault,
 		CertDirectory:          "/var/run/kubernetes",
 		StorageVersions:        latest.AllPreferredGroupVersions(),
+		ShutdownDelay:          master.DefaultShutdownDelay,
 
 		RuntimeConfig: make(util.ConfigurationMap),
 		KubeletConfig: kubeletclient.KubeletClientConfig{
@@ -209,6 +217,9 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 		"Different groups may be stored in different versions. Specified in the format \"group1/version1,group2/version2...\". "+
 		"This flag expects a complete list of storage versions of ALL groups registered in the server. "+
 		"It defaults to a list of preferred versions of all registered groups, which is derived from the KUBE_API_VERSIONS environment variable.")
+	fs.StringVar(&s.StorageReadVersions, "storage-read-versions", s.StorageReadVersions, "Versions, besides the storage versions, that stored resources may be read in while they are migrated to the storage versions. "+
+		"Specified in the format \"group1/version1,group2/version2...\". Resources are always written in the storage version of their group, and are not rewritten when read, so each must be written again before its version is dropped. "+
+		"If a group has read versions, resources stored in any other version fail to be read.")
 	fs.StringVar(&s.CloudProvider, "cloud-provider", s.CloudProvider, "The provider for cloud services.  Empty string for no provider.")
 	fs.StringVar(&s.CloudConfigFile, "cloud-config", s.CloudConfigFile, "The path to the cloud provider configuration file.  Empty string for no configuration file.")
 	fs.DurationVar(&s.EventTTL, "event-ttl", s.EventTTL, "Amount of time to retain events. Default 1 hour.")
@@ -231,7 +242,7 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&s.EtcdServerList, "etcd-servers", s.EtcdServerList, "List of etcd servers to watch (http://ip:port), comma separated. Mutually exclusive with -etcd-config")
 	fs.StringSliceVar(&s.EtcdServersOverrides, "etcd-servers-overrides", s.EtcdServersOverrides, "Per-resource etcd servers overrides, comma separated. The individual override format: group/resource#servers, where servers are http://ip:port, semicolon separated.")
 	fs.StringVar(&s.EtcdPathPrefix, "etcd-prefix", s.EtcdPathPrefix, "The prefix for all resource paths in etcd.")
-	fs.StringSliceVar(&s.CorsAllowedOriginList, "cors-allowed-origins", s.CorsAllowedOriginList, "List of allowed origins for CORS, comma separated.  An allowed origin can be a regular expression to support subdomain matching.  If this list is empty CORS will not be enabled.")
+	fs.StringSliceVar(&s.CorsAllowedOriginList, "cors-allowed-origins", s.CorsAllowedOriginList, "List of allowed origins for CORS, comma separated.  An allowed origin can be a regular expression to support subdomain matching, and must match the whole origin.  If this list is empty CORS will not be enabled.")
 	fs.BoolVar(&s.AllowPrivileged, "allow-privileged", s.AllowPrivileged, "If true, allow privileged containers.")
 	fs.IPNetVar(&s.ServiceClusterIPRange, "service-cluster-ip-range", s.ServiceClusterIPRange, "A CIDR notation IP range from which to assign service cluster IPs. This must not overlap with any IP ranges assigned to nodes for pods.")
 	fs.IPNetVar(&s.ServiceClusterIPRange, "portal-net", s.ServiceClusterIPRange, "Deprecated: see --service-cluster-ip-range instead.")
@@ -246,8 +257,12 @@ func (s *APIServer) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&s.EnableWatchCache, "watch-cache", true, "Enable watch caching in the apiserver")
 	fs.StringVar(&s.ExternalHost, "external-hostname", "", "The hostname to use when generating externalized URLs for this master (e.g. Swagger API Docs.)")
 	fs.IntVar(&s.MaxRequestsInFlight, "max-requests-inflight", 400, "The maximum number of requests in flight at a given time.  When the server exceeds this, it rejects requests.  Zero for no limit.")
+	fs.IntVar(&s.MaxReadOnlyInFlight, "max-readonly-requests-inflight", 0, "The maximum number of get and list requests in flight at a given time, so that a flood of mutating requests can't starve them.  When the server exceeds this, it rejects them.  If this or --max-mutating-requests-inflight is set, they replace --max-requests-inflight, which the unset one defaults to.  Zero to use --max-requests-inflight.")
+	fs.IntVar(&s.MaxMutatingInFlight, "max-mutating-requests-inflight", 0, "The maximum number of create, update, patch and delete requests in flight at a given time, so that a flood of read-only requests can't starve them.  When the server exceeds this, it rejects them.  If this or --max-readonly-requests-inflight is set, they replace --max-requests-inflight, which the unset one defaults to.  Zero to use --max-requests-inflight.")
+	fs.IntVar(&s.MaxWatchesInFlight, "max-watches-inflight", 0, "The maximum number of watches in flight at a given time, which --max-requests-inflight doesn't count.  When the server exceeds this, it rejects watches.  Zero for no limit.")
 	fs.IntVar(&s.MinRequestTimeout, "min-request-timeout", 1800, "An optional field indicating the minimum number of seconds a handler must keep a request open before timing it out. Currently only honored by the watch request handler, which picks a randomized value above this number as the connection timeout, to spread out load.")
 	fs.StringVar(&s.LongRunningRequestRE, "long-running-request-regexp", defaultLongRunningRequestRE, "A regular expression matching long running requests which should be excluded from maximum inflight request handling.")
+	fs.DurationVar(&s.ShutdownDelay, "shutdown-delay", s.ShutdownDelay, "How long the server keeps serving requests on SIGTERM, once /readyz fails and the watches are ended, before it shuts down. Zero shuts down right away.")
 	fs.StringVar(&s.SSHUser, "ssh-user", "", "If non-empty, use secure SSH proxy to the nodes, using this user name")
 	fs.StringVar(&s.SSHKeyfile, "ssh-keyfile", "", "If non-empty, use secure SSH proxy to the nodes, using this user keyfile")
 	fs.Int64Var(&s.MaxConnectionBytesPerSec, "max-connection-bytes-per-sec", 0, "If non-zero, throttle each user connection to this number of bytes/sec.  Currently only applies to long-running requests")
@@ -276,25 +291,21 @@ func (s *APIServer) verifyClusterIPFlags() {
 	}
 }
 
-type newEtcdFunc func([]string, meta.VersionInterfacesFunc, string, string) (storage.Interface, error)
+type newEtcdFunc func([]string, meta.VersionInterfacesFunc, string, []string, string) (storage.Interface, error)
 
-func newEtcd(etcdServerList []string, interfacesFunc meta.VersionInterfacesFunc, storageGroupVersionString, pathPrefix string) (etcdStorage storage.Interface, err error) {
+func newEtcd(etcdServerList []string, interfacesFunc meta.VersionInterfacesFunc, storageGroupVersionString string, readGroupVersionStrings []string, pathPrefix string) (etcdStorage storage.Interface, err error) {
 	if storageGroupVersionString == "" {
 		return etcdStorage, fmt.Errorf("storageVersion is required to create a etcd storage")
 	}
-	storageVersion, err := unversioned.ParseGroupVersion(storageGroupVersionString)
-	if err != nil {
-		return nil, err
-	}
 
 	var storageConfig etcdstorage.EtcdConfig
 	storageConfig.ServerList = etcdServerList
 	storageConfig.Prefix = pathPrefix
-	versionedInterface, err := interfacesFunc(storageVersion)
+	// The storage version can be changed at runtime with Master.SetStorageVersion.
+	storageConfig.Codec, err = storage.NewStorageVersionCodec(interfacesFunc, api.Scheme, storageGroupVersionString, readGroupVersionStrings)
 	if err != nil {
 		return nil, err
 	}
-	storageConfig.Codec = versionedInterface.Codec
 	return storageConfig.NewStorage()
 }
 
@@ -313,8 +324,38 @@ func generateStorageVersionMap(legacyVersion string, storageVersions string) map
 	return storageVersionMap
 }
 
+// inflightLimits returns the limits on all the requests, the read-only ones and the
+// mutating ones in flight. Setting a read-only or a mutating limit replaces the limit
+// on all requests by separate ones, so that reads and writes can't starve each
+// other, the unset one defaulting to the limit on all requests.
+func inflightLimits(all, readOnly, mutating int) (int, int, int) {
+	if readOnly <= 0 && mutating <= 0 {
+		return all, 0, 0
+	}
+	if readOnly <= 0 {
+		readOnly = all
+	}
+	if mutating <= 0 {
+		mutating = all
+	}
+	return 0, readOnly, mutating
+}
+
+// convert the value of --storage-read-versions to a map between group and the groupVersions
+// its resources may be read in.
+func generateStorageReadVersionMap(readVersions string) map[string][]string {
+	readVersionMap := map[string][]string{}
+	if readVersions != "" {
+		for _, gv := range strings.Split(readVersions, ",") {
+			group := apiutil.GetGroup(gv)
+			readVersionMap[group] = append(readVersionMap[group], gv)
+		}
+	}
+	return readVersionMap
+}
+
 // parse the value of --etcd-servers-overrides and update given storageDestinations.
-func updateEtcdOverrides(overrides []string, storageVersions map[string]string, prefix string, storageDestinations *master.StorageDestinations, newEtcdFn newEtcdFunc) {
+func updateEtcdOverrides(overrides []string, storageVersions map[string]string, storageReadVersions map[string][]string, prefix string, storageDestinations *master.StorageDestinations, newEtcdFn newEtcdFunc) {
 	if len(overrides) == 0 {
 		return
 	}
@@ -343,12 +384,12 @@ func updateEtcdOverrides(overrides []string, storageVersions map[string]string,
 		}
 
 		servers := strings.Split(tokens[1], ";")
-		etcdOverrideStorage, err := newEtcdFn(servers, apigroup.InterfacesFor, storageVersions[apigroup.GroupVersion.Group], prefix)
+		etcdOverrideStorage, err := newEtcdFn(servers, apigroup.InterfacesFor, storageVersions[apigroup.GroupVersion.Group], storageReadVersions[apigroup.GroupVersion.Group], prefix)
 		if err != nil {
 			glog.Fatalf("Invalid storage version or misconfigured etcd for %s: %v", tokens[0], err)
 		}
 
-		storageDestinations.AddStorageOverride(group, resource, etcdOverrideStorage)
+		storageDestinations.AddAPIResource(group, resource, etcdOverrideStorage)
 	}
 }
 
@@ -454,7 +495,8 @@ func (s *APIServer) Run(_ []string) error {
 	if _, found := storageVersions[legacyV1Group.GroupVersion.Group]; !found {
 		glog.Fatalf("Couldn't find the storage version for group: %q in storageVersions: %v", legacyV1Group.GroupVersion.Group, storageVersions)
 	}
-	etcdStorage, err := newEtcd(s.EtcdServerList, legacyV1Group.InterfacesFor, storageVersions[legacyV1Group.GroupVersion.Group], s.EtcdPathPrefix)
+	storageReadVersions := generateStorageReadVersionMap(s.StorageReadVersions)
+	etcdStorage, err := newEtcd(s.EtcdServerList, legacyV1Group.InterfacesFor, storageVersions[legacyV1Group.GroupVersion.Group], storageReadVersions[legacyV1Group.GroupVersion.Group], s.EtcdPathPrefix)
 	if err != nil {
 		glog.Fatalf("Invalid storage version or misconfigured etcd: %v", err)
 	}
@@ -468,14 +510,14 @@ func (s *APIServer) Run(_ []string) error {
 		if _, found := storageVersions[expGroup.GroupVersion.Group]; !found {
 			glog.Fatalf("Couldn't find the storage version for group: %q in storageVersions: %v", expGroup.GroupVersion.Group, storageVersions)
 		}
-		expEtcdStorage, err := newEtcd(s.EtcdServerList, expGroup.InterfacesFor, storageVersions[expGroup.GroupVersion.Group], s.EtcdPathPrefix)
+		expEtcdStorage, err := newEtcd(s.EtcdServerList, expGroup.InterfacesFor, storageVersions[expGroup.GroupVersion.Group], storageReadVersions[expGroup.GroupVersion.Group], s.EtcdPathPrefix)
 		if err != nil {
 			glog.Fatalf("Invalid extensions storage version or misconfigured etcd: %v", err)
 		}
 		storageDestinations.AddAPIGroup(extensions.GroupName, expEtcdStorage)
 	}
 
-	updateEtcdOverrides(s.EtcdServersOverrides, storageVersions, s.EtcdPathPrefix, &storageDestinations, newEtcd)
+	updateEtcdOverrides(s.EtcdServersOverrides, storageVersions, storageReadVersions, s.EtcdPathPrefix, &storageDestinations, newEtcd)
 
 	n := s.ServiceClusterIPRange
 
@@ -538,9 +580,13 @@ func (s *APIServer) Run(_ []string) error {
 		}
 	}
 
+	longRunningRE := regexp.MustCompile(s.LongRunningRequestRE)
+	maxRequestsInFlight, maxReadOnlyInFlight, maxMutatingInFlight := inflightLimits(s.MaxRequestsInFlight, s.MaxReadOnlyInFlight, s.MaxMutatingInFlight)
+
 	config := &master.Config{
 		StorageDestinations:       storageDestinations,
 		StorageVersions:           storageVersions,
+		StorageReadVersions:       storageReadVersions,
 		EventTTL:                  s.EventTTL,
 		KubeletClient:             kubeletClient,
 		ServiceClusterIPRange:     &n,
@@ -548,9 +594,11 @@ func (s *APIServer) Run(_ []string) error {
 		EnableLogsSupport:         s.EnableLogsSupport,
 		EnableUISupport:           true,
 		EnableSwaggerSupport:      true,
+		EnableOpenAPISupport:      true,
 		EnableProfiling:           s.EnableProfiling,
 		EnableWatchCache:          s.EnableWatchCache,
 		EnableIndex:               true,
+		EnableMetrics:             true,
 		APIPrefix:                 s.APIPrefix,
 		APIGroupPrefix:            s.APIGroupPrefix,
 		CorsAllowedOriginList:     s.CorsAllowedOriginList,
@@ -564,13 +612,39 @@ func (s *APIServer) Run(_ []string) error {
 		MasterServiceNamespace:    s.MasterServiceNamespace,
 		ExternalHost:              s.ExternalHost,
 		MinRequestTimeout:         s.MinRequestTimeout,
+		LongRunningRequestRE:      longRunningRE,
+		MaxReadOnlyInflight:       maxReadOnlyInFlight,
+		MaxMutatingInflight:       maxMutatingInFlight,
+		MaxInflightWatches:        s.MaxWatchesInFlight,
 		ProxyDialer:               proxyDialerFn,
 		ProxyTLSClientConfig:      proxyTLSClientConfig,
 		Tunneler:                  tunneler,
 		ServiceNodePortRange:      s.ServiceNodePortRange,
 		KubernetesServiceNodePort: s.KubernetesServiceNodePort,
+		ShutdownDelay:             &s.ShutdownDelay,
 	}
-	m := master.New(config)
+	m, err := master.New(config)
+	if err != nil {
+		glog.Fatalf("Invalid master configuration: %v", err)
+	}
+
+	// Drain the master on SIGTERM, so that the rolling restarts of the masters
+	// don't drop requests.
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGTERM)
+		<-signals
+		// The requests that aren't long running time out after a minute, see
+		// longRunningTimeout.
+		ctx, cancel := context.WithTimeout(context.Background(), s.ShutdownDelay+time.Minute)
+		err := m.Drain(ctx)
+		cancel()
+		if err != nil {
+			glog.Errorf("Unable to drain the requests in flight: %v", err)
+		}
+		glog.Flush()
+		os.Exit(0)
+	}()
 
 	// We serve on 2 ports.  See docs/accessing_the_api.md
 	secureLocation := ""
@@ -582,11 +656,10 @@ func (s *APIServer) Run(_ []string) error {
 	// See the flag commentary to understand our assumptions when opening the read-only and read-write ports.
 
 	var sem chan bool
-	if s.MaxRequestsInFlight > 0 {
-		sem = make(chan bool, s.MaxRequestsInFlight)
+	if maxRequestsInFlight > 0 {
+		sem = make(chan bool, maxRequestsInFlight)
 	}
 
-	longRunningRE := regexp.MustCompile(s.LongRunningRequestRE)
 	longRunningTimeout := func(req *http.Request) (<-chan time.Time, string) {
 		// TODO unify this with apiserver.MaxInFlightLimit
 		if longRunningRE.MatchString(req.URL.Path) || req.URL.Query().Get("watch") == "true" {
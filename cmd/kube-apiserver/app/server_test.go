@@ -106,6 +106,53 @@ func TestGenerateStorageVersionMap(t *testing.T) {
 	}
 }
 
+func TestGenerateStorageReadVersionMap(t *testing.T) {
+	testCases := []struct {
+		readVersions string
+		expectedMap  map[string][]string
+	}{
+		{
+			readVersions: "extensions/v1beta1,v1,extensions/v1beta2",
+			expectedMap: map[string][]string{
+				api.GroupName:        {"v1"},
+				extensions.GroupName: {"extensions/v1beta1", "extensions/v1beta2"},
+			},
+		},
+		{
+			readVersions: "",
+			expectedMap:  map[string][]string{},
+		},
+	}
+	for _, test := range testCases {
+		output := generateStorageReadVersionMap(test.readVersions)
+		if !reflect.DeepEqual(test.expectedMap, output) {
+			t.Errorf("unexpected error. expect: %v, got: %v", test.expectedMap, output)
+		}
+	}
+}
+
+func TestInflightLimits(t *testing.T) {
+	testCases := []struct {
+		all, readOnly, mutating                         int
+		expectedAll, expectedReadOnly, expectedMutating int
+	}{
+		{400, 0, 0, 400, 0, 0},
+		{0, 0, 0, 0, 0, 0},
+		// The separate limits replace the limit on all requests.
+		{400, 100, 50, 0, 100, 50},
+		{400, 0, 50, 0, 400, 50},
+		{400, 100, 0, 0, 100, 400},
+		{0, 0, 50, 0, 0, 50},
+	}
+	for _, test := range testCases {
+		all, readOnly, mutating := inflightLimits(test.all, test.readOnly, test.mutating)
+		if all != test.expectedAll || readOnly != test.expectedReadOnly || mutating != test.expectedMutating {
+			t.Errorf("unexpected limits for %d, %d, %d: expected %d, %d, %d, got %d, %d, %d", test.all, test.readOnly, test.mutating,
+				test.expectedAll, test.expectedReadOnly, test.expectedMutating, all, readOnly, mutating)
+		}
+	}
+}
+
 func TestUpdateEtcdOverrides(t *testing.T) {
 	storageVersions := generateStorageVersionMap("", "v1,extensions/v1beta1")
 
@@ -132,7 +179,7 @@ func TestUpdateEtcdOverrides(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		newEtcd := func(serverList []string, _ meta.VersionInterfacesFunc, _, _ string) (storage.Interface, error) {
+		newEtcd := func(serverList []string, _ meta.VersionInterfacesFunc, _ string, _ []string, _ string) (storage.Interface, error) {
 			if !reflect.DeepEqual(test.servers, serverList) {
 				t.Errorf("unexpected server list, expected: %#v, got: %#v", test.servers, serverList)
 			}
@@ -140,7 +187,7 @@ func TestUpdateEtcdOverrides(t *testing.T) {
 		}
 		storageDestinations := master.NewStorageDestinations()
 		override := test.apigroup + "/" + test.resource + "#" + strings.Join(test.servers, ";")
-		updateEtcdOverrides([]string{override}, storageVersions, "", &storageDestinations, newEtcd)
+		updateEtcdOverrides([]string{override}, storageVersions, nil, "", &storageDestinations, newEtcd)
 		apigroup, ok := storageDestinations.APIGroups[test.apigroup]
 		if !ok {
 			t.Errorf("apigroup: %s not created", test.apigroup)
@@ -77,3 +77,21 @@ func (record *attributesRecord) GetObject() runtime.Object {
 func (record *attributesRecord) GetUserInfo() user.Info {
 	return record.userInfo
 }
+
+func (record *attributesRecord) IsDryRun() bool {
+	return false
+}
+
+// dryRunAttributes are the attributes of a request that will not be persisted.
+type dryRunAttributes struct {
+	Attributes
+}
+
+// WithDryRun returns a copy of attributes for a request that will not be persisted.
+func WithDryRun(attributes Attributes) Attributes {
+	return dryRunAttributes{attributes}
+}
+
+func (dryRunAttributes) IsDryRun() bool {
+	return true
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package admission
 
 import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
@@ -26,14 +29,100 @@ type chainAdmissionHandler []Interface
 // NewFromPlugins returns an admission.Interface that will enforce admission control decisions of all
 // the given plugins.
 func NewFromPlugins(client client.Interface, pluginNames []string, configFilePath string) Interface {
-	plugins := []Interface{}
+	chain := &pluginChainAdmissionHandler{}
 	for _, pluginName := range pluginNames {
 		plugin := InitPlugin(pluginName, client, configFilePath)
 		if plugin != nil {
-			plugins = append(plugins, plugin)
+			chain.chainAdmissionHandler = append(chain.chainAdmissionHandler, plugin)
+			chain.names = append(chain.names, pluginName)
+		}
+	}
+	return chain
+}
+
+// pluginChainAdmissionHandler is a chain of admission handlers created from plugins,
+// which knows the names of its plugins.
+type pluginChainAdmissionHandler struct {
+	chainAdmissionHandler
+	names []string
+}
+
+// PluginNames returns the names of the plugins of an admission.Interface created by
+// NewFromPlugins, in the order they admit requests, or nil if handler wasn't.
+func PluginNames(handler Interface) []string {
+	chain, ok := handler.(*pluginChainAdmissionHandler)
+	if !ok {
+		return nil
+	}
+	return append([]string{}, chain.names...)
+}
+
+// AppendPlugin returns an admission.Interface that admits requests with handler, if
+// it isn't nil, then with plugin. The result knows the names of the plugins of
+// handler and of plugin if handler was created by NewFromPlugins or AppendPlugin.
+func AppendPlugin(handler Interface, name string, plugin Interface) Interface {
+	if handler == nil {
+		return &pluginChainAdmissionHandler{chainAdmissionHandler{plugin}, []string{name}}
+	}
+	chain, ok := handler.(*pluginChainAdmissionHandler)
+	if !ok {
+		return chainAdmissionHandler{handler, plugin}
+	}
+	return &pluginChainAdmissionHandler{
+		append(append(chainAdmissionHandler{}, chain.chainAdmissionHandler...), plugin),
+		append(append([]string{}, chain.names...), name),
+	}
+}
+
+// ScopePlugins returns an admission.Interface that admits requests like handler,
+// except that each plugin named in resources only admits the requests for the
+// group resources listed for it. A group resource whose Resource is "*" stands
+// for all the resources of its group. The other plugins admit all requests. It
+// returns an error if handler wasn't created by NewFromPlugins or AppendPlugin,
+// or if a name isn't one of its plugins.
+func ScopePlugins(handler Interface, resources map[string][]unversioned.GroupResource) (Interface, error) {
+	if len(resources) == 0 {
+		return handler, nil
+	}
+	chain, ok := handler.(*pluginChainAdmissionHandler)
+	if !ok {
+		return nil, fmt.Errorf("admission plugins can only be scoped to resources if they are created from plugins")
+	}
+	scoped := &pluginChainAdmissionHandler{
+		append(chainAdmissionHandler{}, chain.chainAdmissionHandler...),
+		append([]string{}, chain.names...),
+	}
+	for name, groupResources := range resources {
+		found := false
+		for i := range scoped.names {
+			if scoped.names[i] != name {
+				continue
+			}
+			found = true
+			scoped.chainAdmissionHandler[i] = &resourceScopedHandler{scoped.chainAdmissionHandler[i], groupResources}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown admission plugin %q", name)
 		}
 	}
-	return chainAdmissionHandler(plugins)
+	return scoped, nil
+}
+
+// resourceScopedHandler is an admission.Interface that only admits the requests
+// for some group resources with the wrapped handler, and admits the others.
+type resourceScopedHandler struct {
+	Interface
+	resources []unversioned.GroupResource
+}
+
+func (h *resourceScopedHandler) Admit(a Attributes) error {
+	resource := a.GetResource()
+	for _, r := range h.resources {
+		if r.Group == resource.Group && (r.Resource == "*" || r.Resource == resource.Resource) {
+			return h.Interface.Admit(a)
+		}
+	}
+	return nil
 }
 
 // NewChainHandler creates a new chain handler from an array of handlers. Used for testing.
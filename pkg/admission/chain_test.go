@@ -18,9 +18,12 @@ package admission
 
 import (
 	"fmt"
+	"io"
+	"reflect"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
 type FakeHandler struct {
@@ -152,3 +155,75 @@ func TestHandles(t *testing.T) {
 		}
 	}
 }
+
+func TestPluginNames(t *testing.T) {
+	for _, name := range []string{"PluginNamesTestA", "PluginNamesTestB"} {
+		name := name
+		RegisterPlugin(name, func(client client.Interface, config io.Reader) (Interface, error) {
+			return makeHandler(name, true, Create), nil
+		})
+	}
+	chain := NewFromPlugins(nil, []string{"PluginNamesTestB", "", "PluginNamesTestA"}, "")
+	if names := PluginNames(chain); !reflect.DeepEqual(names, []string{"PluginNamesTestB", "PluginNamesTestA"}) {
+		t.Errorf("Unexpected plugin names: %v", names)
+	}
+	if err := chain.Admit(NewAttributesRecord(nil, unversioned.GroupKind{}, "", "", unversioned.GroupResource{}, "", Create, nil)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if names := PluginNames(AppendPlugin(chain, "c", makeHandler("c", true, Create))); !reflect.DeepEqual(names, []string{"PluginNamesTestB", "PluginNamesTestA", "c"}) {
+		t.Errorf("Unexpected plugin names: %v", names)
+	}
+	if names := PluginNames(AppendPlugin(nil, "c", makeHandler("c", true, Create))); !reflect.DeepEqual(names, []string{"c"}) {
+		t.Errorf("Unexpected plugin names: %v", names)
+	}
+	if names := PluginNames(NewChainHandler(makeHandler("a", true, Create))); names != nil {
+		t.Errorf("Expected no plugin names for a chain that wasn't created from plugins, got %v", names)
+	}
+}
+
+func TestScopePlugins(t *testing.T) {
+	pods := unversioned.GroupResource{Resource: "pods"}
+	jobs := unversioned.GroupResource{Group: "extensions", Resource: "jobs"}
+	foos := unversioned.GroupResource{Group: "company.com", Resource: "foos"}
+	tests := []struct {
+		name     string
+		resource unversioned.GroupResource
+		calls    map[string]bool
+	}{
+		{"core resource", pods, map[string]bool{"a": true, "b": true, "c": true}},
+		{"resource of another group", jobs, map[string]bool{"a": false, "b": true, "c": true}},
+		{"resource of a group wildcard", foos, map[string]bool{"a": false, "b": false, "c": true}},
+	}
+	for _, test := range tests {
+		a := makeHandler("a", true, Create)
+		b := makeHandler("b", true, Create)
+		c := makeHandler("c", true, Create)
+		chain := AppendPlugin(AppendPlugin(AppendPlugin(nil, "a", a), "b", b), "c", c)
+		scoped, err := ScopePlugins(chain, map[string][]unversioned.GroupResource{
+			"a": {pods},
+			"b": {pods, jobs},
+			"c": {{Resource: "pods"}, {Group: "extensions", Resource: "*"}, {Group: "company.com", Resource: "*"}},
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if err := scoped.Admit(NewAttributesRecord(nil, unversioned.GroupKind{}, "", "", test.resource, "", Create, nil)); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		for name, handler := range map[string]Interface{"a": a, "b": b, "c": c} {
+			if called := handler.(*FakeHandler).admitCalled; called != test.calls[name] {
+				t.Errorf("%s: expected plugin %s to be called %v, got %v", test.name, name, test.calls[name], called)
+			}
+		}
+		if names := PluginNames(scoped); !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+			t.Errorf("%s: unexpected plugin names: %v", test.name, names)
+		}
+	}
+
+	if _, err := ScopePlugins(AppendPlugin(nil, "a", makeHandler("a", true, Create)), map[string][]unversioned.GroupResource{"d": {pods}}); err == nil {
+		t.Errorf("Expected an error scoping an unknown plugin")
+	}
+	if _, err := ScopePlugins(NewChainHandler(makeHandler("a", true, Create)), map[string][]unversioned.GroupResource{"a": {pods}}); err == nil {
+		t.Errorf("Expected an error scoping the plugins of a chain that wasn't created from plugins")
+	}
+}
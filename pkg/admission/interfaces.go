@@ -45,6 +45,9 @@ type Attributes interface {
 	GetKind() unversioned.GroupKind
 	// GetUserInfo is information about the requesting user
 	GetUserInfo() user.Info
+	// IsDryRun returns true if the request will not be persisted. Admission controllers
+	// must then have no side effects, e.g. not charge quota or create other objects.
+	IsDryRun() bool
 }
 
 // Interface is an abstract, pluggable interface for Admission Control decisions.
@@ -0,0 +1,239 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/transport"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/evanphx/json-patch"
+	"github.com/golang/glog"
+)
+
+// DefaultTimeout is how long a webhook is waited for if its config has no timeout.
+const DefaultTimeout = 10 * time.Second
+
+// Config configures an admission webhook.
+type Config struct {
+	// URL is the HTTPS URL where a Request is POSTed for every create and update to
+	// admit. The requests carry the objects being written, so the webhook is never
+	// called over plain HTTP.
+	URL string
+	// CAFile and CAData are the PEM-encoded certificate authorities the certificate
+	// of the webhook is verified with, the system roots if both are empty. CAData
+	// supersedes CAFile.
+	CAFile string
+	CAData []byte
+	// CertFile and KeyFile, or CertData and KeyData which supersede them, are the
+	// PEM-encoded client certificate and key presented to the webhook, if any.
+	CertFile string
+	KeyFile  string
+	CertData []byte
+	KeyData  []byte
+	// Timeout is how long the webhook is waited for, DefaultTimeout if zero.
+	Timeout time.Duration
+	// If true, the requests are admitted when the webhook can't be reached or gives
+	// an invalid response. They are denied otherwise.
+	FailOpen bool
+}
+
+// Request is the JSON body POSTed to the webhook.
+type Request struct {
+	Operation   admission.Operation `json:"operation"`
+	Group       string              `json:"group"`
+	Kind        string              `json:"kind"`
+	Resource    string              `json:"resource"`
+	Subresource string              `json:"subresource,omitempty"`
+	Namespace   string              `json:"namespace,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	UserInfo    UserInfo            `json:"userInfo"`
+	// DryRun is true if the request will not be persisted, in which case the
+	// webhook must have no side effects.
+	DryRun bool `json:"dryRun,omitempty"`
+	// Object is the object of the request, encoded like the clients of its group
+	// version send it.
+	Object json.RawMessage `json:"object,omitempty"`
+}
+
+// UserInfo describes the user making a request.
+type UserInfo struct {
+	Username string   `json:"username,omitempty"`
+	UID      string   `json:"uid,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// Response is the JSON body the webhook answers with.
+type Response struct {
+	// Allowed is true if the request is admitted.
+	Allowed bool `json:"allowed"`
+	// Reason explains why the request is denied.
+	Reason string `json:"reason,omitempty"`
+	// Patch, if set, is a JSON patch (RFC 6902) applied to the object of an admitted
+	// request before it is stored.
+	Patch json.RawMessage `json:"patch,omitempty"`
+}
+
+// CodecFunc returns the codec the objects of a kind are sent to the webhook and
+// patched with, or false if the objects of the kind aren't sent.
+type CodecFunc func(kind unversioned.GroupKind) (runtime.Codec, bool)
+
+type webhook struct {
+	*admission.Handler
+	config Config
+	client *http.Client
+	codecs CodecFunc
+}
+
+// NewWebhook returns an admission handler that POSTs a Request to the webhook of
+// config for every create and update, and denies or patches them as it answers.
+// It returns an error if the URL of config isn't HTTPS or its certificates can't
+// be loaded.
+func NewWebhook(config Config, codecs CodecFunc) (admission.Interface, error) {
+	if u, err := url.Parse(config.URL); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+		return nil, fmt.Errorf("%q is not an absolute HTTPS URL", config.URL)
+	}
+	tlsConfig, err := transport.TLSConfigFor(&transport.Config{TLS: transport.TLSConfig{
+		CAFile:   config.CAFile,
+		CAData:   config.CAData,
+		CertFile: config.CertFile,
+		KeyFile:  config.KeyFile,
+		CertData: config.CertData,
+		KeyData:  config.KeyData,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return &webhook{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		config:  config,
+		client: &http.Client{
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+			Timeout:   timeout,
+		},
+		codecs: codecs,
+	}, nil
+}
+
+func (w *webhook) Admit(a admission.Attributes) error {
+	kind := a.GetKind()
+	request := Request{
+		Operation:   a.GetOperation(),
+		Group:       kind.Group,
+		Kind:        kind.Kind,
+		Resource:    a.GetResource().Resource,
+		Subresource: a.GetSubresource(),
+		Namespace:   a.GetNamespace(),
+		Name:        a.GetName(),
+		DryRun:      a.IsDryRun(),
+	}
+	if userInfo := a.GetUserInfo(); userInfo != nil {
+		request.UserInfo = UserInfo{Username: userInfo.GetName(), UID: userInfo.GetUID(), Groups: userInfo.GetGroups()}
+	}
+	obj := a.GetObject()
+	codec, ok := w.codecs(kind)
+	if obj != nil && ok {
+		data, err := codec.Encode(obj)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		request.Object = data
+	}
+
+	response, err := w.call(&request)
+	if err == nil && len(response.Patch) > 0 {
+		if len(request.Object) == 0 {
+			err = fmt.Errorf("a patch was returned for %s, which has no object to patch", kind)
+		} else {
+			err = patch(codec, obj, request.Object, response.Patch)
+		}
+	}
+	if err != nil {
+		if w.config.FailOpen {
+			glog.Warningf("Admitting %s %s/%s: admission webhook %s failed: %v", kind.Kind, request.Namespace, request.Name, w.config.URL, err)
+			return nil
+		}
+		return admission.NewForbidden(a, fmt.Errorf("admission webhook %s failed: %v", w.config.URL, err))
+	}
+	if !response.Allowed {
+		reason := response.Reason
+		if len(reason) == 0 {
+			reason = "denied by the admission webhook"
+		}
+		return admission.NewForbidden(a, errors.New(reason))
+	}
+	return nil
+}
+
+// call POSTs request to the webhook and returns its response.
+func (w *webhook) call(request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.client.Post(w.config.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, data)
+	}
+	response := &Response{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+	return response, nil
+}
+
+// patch applies the JSON patch patchJS to data, the encoding of obj, and replaces
+// obj with the result. obj is left alone if the patch fails.
+func patch(codec runtime.Codec, obj runtime.Object, data, patchJS []byte) error {
+	jsonPatch, err := jsonpatch.DecodePatch(patchJS)
+	if err != nil {
+		return fmt.Errorf("invalid patch: %v", err)
+	}
+	patched, err := jsonPatch.Apply(data)
+	if err != nil {
+		return fmt.Errorf("invalid patch: %v", err)
+	}
+	out := reflect.New(reflect.TypeOf(obj).Elem())
+	if err := codec.DecodeInto(patched, out.Interface().(runtime.Object)); err != nil {
+		return fmt.Errorf("invalid patched object: %v", err)
+	}
+	reflect.ValueOf(obj).Elem().Set(out.Elem())
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func v1Codecs(kind unversioned.GroupKind) (runtime.Codec, bool) {
+	if kind.Group != api.GroupName {
+		return nil, false
+	}
+	return v1.Codec, true
+}
+
+// newServer starts a TLS server with handler and returns it with the config of a
+// webhook that trusts its certificate.
+func newServer(handler http.HandlerFunc) (*httptest.Server, Config) {
+	server := httptest.NewTLSServer(handler)
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.TLS.Certificates[0].Certificate[0]})
+	return server, Config{URL: server.URL, CAData: caData}
+}
+
+func newWebhook(t *testing.T, config Config, codecs CodecFunc) admission.Interface {
+	handler, err := NewWebhook(config, codecs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return handler
+}
+
+func newPodAttributes(pod *api.Pod) admission.Attributes {
+	return admission.NewAttributesRecord(pod, api.Kind("Pod"), pod.Namespace, pod.Name, api.Resource("pods"), "", admission.Create, &user.DefaultInfo{Name: "alice", Groups: []string{"devs"}})
+}
+
+func TestAdmit(t *testing.T) {
+	testCases := map[string]struct {
+		status   int
+		response string
+		failOpen bool
+		allowed  bool
+		labels   map[string]string
+	}{
+		"allowed":                 {http.StatusOK, `{"allowed":true}`, false, true, map[string]string{"app": "web"}},
+		"denied":                  {http.StatusOK, `{"allowed":false,"reason":"no pods on fridays"}`, true, false, map[string]string{"app": "web"}},
+		"patched":                 {http.StatusOK, `{"allowed":true,"patch":[{"op":"add","path":"/metadata/labels/team","value":"a"}]}`, false, true, map[string]string{"app": "web", "team": "a"}},
+		"invalid patch":           {http.StatusOK, `{"allowed":true,"patch":[{"op":"test","path":"/metadata/name","value":"other"}]}`, false, false, map[string]string{"app": "web"}},
+		"invalid patch fail open": {http.StatusOK, `{"allowed":true,"patch":[{"op":"test","path":"/metadata/name","value":"other"}]}`, true, true, map[string]string{"app": "web"}},
+		"error":                   {http.StatusInternalServerError, `oops`, false, false, map[string]string{"app": "web"}},
+		"error fail open":         {http.StatusInternalServerError, `oops`, true, true, map[string]string{"app": "web"}},
+		"invalid response":        {http.StatusOK, `allowed`, false, false, map[string]string{"app": "web"}},
+	}
+	for name, testCase := range testCases {
+		var request Request
+		server, config := newServer(func(w http.ResponseWriter, req *http.Request) {
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+			}
+			w.WriteHeader(testCase.status)
+			w.Write([]byte(testCase.response))
+		})
+
+		pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "default", Labels: map[string]string{"app": "web"}}}
+		config.FailOpen = testCase.failOpen
+		handler := newWebhook(t, config, v1Codecs)
+		err := handler.Admit(newPodAttributes(pod))
+		server.Close()
+
+		if testCase.allowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !testCase.allowed && !apierrors.IsForbidden(err) {
+			t.Errorf("%s: expected a forbidden error, got %v", name, err)
+		}
+		if len(pod.Labels) != len(testCase.labels) || pod.Labels["team"] != testCase.labels["team"] {
+			t.Errorf("%s: expected labels %v, got %v", name, testCase.labels, pod.Labels)
+		}
+		if request.Operation != admission.Create || request.Kind != "Pod" || request.Resource != "pods" || request.Namespace != "default" || request.Name != "test" || request.UserInfo.Username != "alice" || request.DryRun {
+			t.Errorf("%s: unexpected request: %#v", name, request)
+		}
+		sent := v1.Pod{}
+		if err := json.Unmarshal(request.Object, &sent); err != nil || sent.Kind != "Pod" || sent.APIVersion != "v1" || sent.Name != "test" {
+			t.Errorf("%s: unexpected object: %s (%v)", name, request.Object, err)
+		}
+	}
+}
+
+func TestAdmitDryRun(t *testing.T) {
+	var request Request
+	server, config := newServer(func(w http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&request)
+		w.Write([]byte(`{"allowed":true}`))
+	})
+	defer server.Close()
+
+	handler := newWebhook(t, config, v1Codecs)
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "default"}}
+	if err := handler.Admit(admission.WithDryRun(newPodAttributes(pod))); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !request.DryRun {
+		t.Errorf("expected the request to be sent as a dry run, got %#v", request)
+	}
+}
+
+func TestAdmitTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server, config := newServer(func(w http.ResponseWriter, req *http.Request) {
+		<-done
+	})
+	defer server.Close()
+	defer close(done)
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "default"}}
+	for _, failOpen := range []bool{false, true} {
+		config.Timeout, config.FailOpen = 10*time.Millisecond, failOpen
+		handler := newWebhook(t, config, v1Codecs)
+		err := handler.Admit(newPodAttributes(pod))
+		if failOpen && err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if !failOpen && !apierrors.IsForbidden(err) {
+			t.Errorf("expected a forbidden error, got %v", err)
+		}
+	}
+}
+
+func TestAdmitWithoutCodec(t *testing.T) {
+	var request Request
+	server, config := newServer(func(w http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&request)
+		w.Write([]byte(`{"allowed":true,"patch":[{"op":"add","path":"/metadata/labels","value":{}}]}`))
+	})
+	defer server.Close()
+
+	handler := newWebhook(t, config, func(unversioned.GroupKind) (runtime.Codec, bool) { return nil, false })
+	if err := handler.Admit(newPodAttributes(&api.Pod{})); !apierrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error for a patch without an object, got %v", err)
+	}
+	if len(request.Object) != 0 {
+		t.Errorf("expected no object, got %s", request.Object)
+	}
+	if handler.Handles(admission.Delete) || handler.Handles(admission.Connect) {
+		t.Errorf("expected only creates and updates to be handled")
+	}
+}
+
+func TestAdmitUntrustedCertificate(t *testing.T) {
+	called := false
+	server, config := newServer(func(w http.ResponseWriter, req *http.Request) {
+		called = true
+		w.Write([]byte(`{"allowed":true}`))
+	})
+	defer server.Close()
+
+	config.CAData = nil
+	handler := newWebhook(t, config, v1Codecs)
+	if err := handler.Admit(newPodAttributes(&api.Pod{})); !apierrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error for a webhook with an untrusted certificate, got %v", err)
+	}
+	if called {
+		t.Errorf("expected the webhook not to be sent the request")
+	}
+}
+
+func TestNewWebhookInvalidConfig(t *testing.T) {
+	for _, config := range []Config{
+		{URL: "http://admission.example.com/admit"},
+		{URL: "/admit"},
+		{URL: "https://admission.example.com/admit", CertFile: "/nonexistent/cert.pem", KeyFile: "/nonexistent/key.pem"},
+	} {
+		if _, err := NewWebhook(config, v1Codecs); err == nil {
+			t.Errorf("expected an error for %#v", config)
+		}
+	}
+}
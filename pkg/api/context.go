@@ -55,6 +55,19 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// dryRunKey is the context key for requests that must not be persisted.
+const dryRunKey key = 2
+
+// requestIDKey is the context key for the request ID.
+const requestIDKey key = 3
+
+// impersonatorKey is the context key for the user impersonating the request user.
+const impersonatorKey key = 4
+
+// clientRequestKey is the context key for the requests of API clients, as
+// opposed to those the apiserver makes itself.
+const clientRequestKey key = 5
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -74,6 +87,26 @@ func WithValue(parent Context, key interface{}, val interface{}) Context {
 	return context.WithValue(internalCtx, key, val)
 }
 
+// WithTimeout returns a copy of parent that is cancelled after timeout, and a function
+// that cancels it sooner. The function must be called once the context is no longer used.
+func WithTimeout(parent Context, timeout time.Duration) (Context, context.CancelFunc) {
+	internalCtx, ok := parent.(context.Context)
+	if !ok {
+		panic(stderrs.New("Invalid context type"))
+	}
+	return context.WithTimeout(internalCtx, timeout)
+}
+
+// WithCancel returns a copy of parent that is cancelled when the returned function
+// is called. The function must be called once the context is no longer used.
+func WithCancel(parent Context) (Context, context.CancelFunc) {
+	internalCtx, ok := parent.(context.Context)
+	if !ok {
+		panic(stderrs.New("Invalid context type"))
+	}
+	return context.WithCancel(internalCtx)
+}
+
 // WithNamespace returns a copy of parent in which the namespace value is set
 func WithNamespace(parent Context, namespace string) Context {
 	return WithValue(parent, namespaceKey, namespace)
@@ -119,3 +152,50 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// WithDryRun returns a copy of parent in which the request is marked as a dry run
+func WithDryRun(parent Context) Context {
+	return WithValue(parent, dryRunKey, true)
+}
+
+// IsDryRun returns true if the request on the ctx must not be persisted
+func IsDryRun(ctx Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey).(bool)
+	return dryRun
+}
+
+// WithRequestID returns a copy of parent in which the request ID value is set
+func WithRequestID(parent Context, requestID string) Context {
+	return WithValue(parent, requestIDKey, requestID)
+}
+
+// RequestIDFrom returns the value of the request ID key on the ctx
+func RequestIDFrom(ctx Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey).(string)
+	return requestID, ok
+}
+
+// WithImpersonator returns a copy of parent in which the impersonator value is set
+func WithImpersonator(parent Context, impersonator user.Info) Context {
+	return WithValue(parent, impersonatorKey, impersonator)
+}
+
+// ImpersonatorFrom returns the value of the impersonator key on the ctx
+func ImpersonatorFrom(ctx Context) (user.Info, bool) {
+	impersonator, ok := ctx.Value(impersonatorKey).(user.Info)
+	return impersonator, ok
+}
+
+// WithClientRequest returns a copy of parent marked as the context of a request
+// of an API client
+func WithClientRequest(parent Context) Context {
+	return WithValue(parent, clientRequestKey, true)
+}
+
+// IsClientRequest returns true if the ctx is the context of a request of an API
+// client, false if it is one the apiserver makes itself, e.g. for a controller
+// or a watch cache
+func IsClientRequest(ctx Context) bool {
+	clientRequest, _ := ctx.Value(clientRequestKey).(bool)
+	return clientRequest
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
 )
 
 // TestNamespaceContext validates that a namespace can be get/set on a context object
@@ -66,3 +67,48 @@ func TestValidNamespace(t *testing.T) {
 		t.Errorf("Expected the empty string")
 	}
 }
+
+// TestDryRun validates that the dry run marker is carried on the context
+func TestDryRun(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if api.IsDryRun(ctx) {
+		t.Errorf("expected a new context not to be a dry run")
+	}
+	if !api.IsDryRun(api.WithDryRun(ctx)) {
+		t.Errorf("expected the context to be a dry run")
+	}
+}
+
+// TestRequestID validates that the request ID is carried on the context
+func TestRequestID(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if _, ok := api.RequestIDFrom(ctx); ok {
+		t.Errorf("expected a new context not to have a request ID")
+	}
+	if requestID, ok := api.RequestIDFrom(api.WithRequestID(ctx, "abc")); !ok || requestID != "abc" {
+		t.Errorf("expected request ID abc, got %q", requestID)
+	}
+}
+
+// TestImpersonator validates that the impersonator is carried on the context
+func TestImpersonator(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if _, ok := api.ImpersonatorFrom(ctx); ok {
+		t.Errorf("expected a new context not to have an impersonator")
+	}
+	impersonator, ok := api.ImpersonatorFrom(api.WithImpersonator(ctx, &user.DefaultInfo{Name: "admin"}))
+	if !ok || impersonator.GetName() != "admin" {
+		t.Errorf("expected impersonator admin, got %v", impersonator)
+	}
+}
+
+// TestClientRequest validates that the contexts of client requests are marked
+func TestClientRequest(t *testing.T) {
+	ctx := api.NewDefaultContext()
+	if api.IsClientRequest(ctx) {
+		t.Errorf("expected a new context not to be that of a client request")
+	}
+	if !api.IsClientRequest(api.WithClientRequest(ctx)) {
+		t.Errorf("expected the context to be that of a client request")
+	}
+}
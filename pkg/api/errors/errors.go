@@ -217,6 +217,27 @@ func NewServiceUnavailable(reason string) error {
 	}}
 }
 
+// NewRequestEntityTooLargeError creates an error that indicates that the request body is too large to be processed.
+func NewRequestEntityTooLargeError(message string) error {
+	return &StatusError{unversioned.Status{
+		Status:  unversioned.StatusFailure,
+		Code:    http.StatusRequestEntityTooLarge,
+		Reason:  unversioned.StatusReasonRequestEntityTooLarge,
+		Message: fmt.Sprintf("Request entity too large: %s", message),
+	}}
+}
+
+// NewNotAcceptable creates an error that indicates that the response can not be encoded in
+// any of the content types the client accepts.
+func NewNotAcceptable(message string) error {
+	return &StatusError{unversioned.Status{
+		Status:  unversioned.StatusFailure,
+		Code:    http.StatusNotAcceptable,
+		Reason:  unversioned.StatusReasonNotAcceptable,
+		Message: message,
+	}}
+}
+
 // NewMethodNotSupported returns an error indicating the requested action is not supported on this kind.
 func NewMethodNotSupported(kind, action string) error {
 	return &StatusError{unversioned.Status{
@@ -273,6 +294,21 @@ func NewTimeoutError(message string, retryAfterSeconds int) error {
 	}}
 }
 
+// NewTooManyRequestsError returns an error indicating that the server is limiting
+// the rate of requests, and that the client should retry after retryAfterSeconds.
+// Like the other 429 responses, it has the reason StatusReasonTimeout.
+func NewTooManyRequestsError(message string, retryAfterSeconds int) error {
+	return &StatusError{unversioned.Status{
+		Status:  unversioned.StatusFailure,
+		Code:    StatusTooManyRequests,
+		Reason:  unversioned.StatusReasonTimeout,
+		Message: fmt.Sprintf("Too many requests: %s", message),
+		Details: &unversioned.StatusDetails{
+			RetryAfterSeconds: int32(retryAfterSeconds),
+		},
+	}}
+}
+
 // NewGenericServerResponse returns a new error for server responses that are not in a recognizable form.
 func NewGenericServerResponse(code int, verb, kind, name, serverMessage string, retryAfterSeconds int, isUnexpectedResponse bool) error {
 	reason := unversioned.StatusReasonUnknown
@@ -300,6 +336,9 @@ func NewGenericServerResponse(code int, verb, kind, name, serverMessage string,
 	case http.StatusMethodNotAllowed:
 		reason = unversioned.StatusReasonMethodNotAllowed
 		message = "the server does not allow this method on the requested resource"
+	case http.StatusNotAcceptable:
+		reason = unversioned.StatusReasonNotAcceptable
+		message = "the server can not encode the response in a content type we accept"
 	case StatusUnprocessableEntity:
 		reason = unversioned.StatusReasonInvalid
 		message = "the server rejected our request due to an error in our request"
@@ -378,6 +417,24 @@ func IsBadRequest(err error) bool {
 	return reasonForError(err) == unversioned.StatusReasonBadRequest
 }
 
+// IsRequestEntityTooLarge determines if err is an error which indicates the request body
+// exceeded the size allowed by the server.
+func IsRequestEntityTooLarge(err error) bool {
+	return reasonForError(err) == unversioned.StatusReasonRequestEntityTooLarge
+}
+
+// IsNotAcceptable determines if err is an error which indicates the response could not be
+// encoded in a content type the client accepts.
+func IsNotAcceptable(err error) bool {
+	return reasonForError(err) == unversioned.StatusReasonNotAcceptable
+}
+
+// IsGone determines if err is an error which indicates the resource version the
+// request read from is too old.
+func IsGone(err error) bool {
+	return reasonForError(err) == unversioned.StatusReasonGone
+}
+
 // IsUnauthorized determines if err is an error which indicates that the request is unauthorized and
 // requires authentication by the user.
 func IsUnauthorized(err error) bool {
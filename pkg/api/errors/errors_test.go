@@ -66,6 +66,15 @@ func TestErrorNew(t *testing.T) {
 	if !IsBadRequest(NewBadRequest("reason")) {
 		t.Errorf("expected to be %s", unversioned.StatusReasonBadRequest)
 	}
+	if !IsRequestEntityTooLarge(NewRequestEntityTooLargeError("reason")) {
+		t.Errorf("expected to be %s", unversioned.StatusReasonRequestEntityTooLarge)
+	}
+	if !IsNotAcceptable(NewNotAcceptable("reason")) {
+		t.Errorf("expected to be %s", unversioned.StatusReasonNotAcceptable)
+	}
+	if !IsGone(NewGone("reason")) {
+		t.Errorf("expected to be %s", unversioned.StatusReasonGone)
+	}
 	if !IsForbidden(NewForbidden("test", "2", errors.New("reason"))) {
 		t.Errorf("expected to be %s", unversioned.StatusReasonForbidden)
 	}
@@ -81,6 +90,9 @@ func TestErrorNew(t *testing.T) {
 	if time, ok := SuggestsClientDelay(NewTimeoutError("test reason", 10)); time != 10 || !ok {
 		t.Errorf("expected to be %s", unversioned.StatusReasonTimeout)
 	}
+	if time, ok := SuggestsClientDelay(NewTooManyRequestsError("test reason", 1)); time != 1 || !ok {
+		t.Errorf("expected to be %s", unversioned.StatusReasonTimeout)
+	}
 	if !IsMethodNotSupported(NewMethodNotSupported("foo", "delete")) {
 		t.Errorf("expected to be %s", unversioned.StatusReasonMethodNotAllowed)
 	}
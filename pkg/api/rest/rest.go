@@ -72,6 +72,15 @@ type Getter interface {
 	Get(ctx api.Context, name string) (runtime.Object, error)
 }
 
+// Exporter is an object whose resources have cluster-specific fields besides their
+// standard object metadata and status, which are stripped when the resources are
+// exported, e.g. with GET ?export=true.
+type Exporter interface {
+	// Export strips the cluster-specific fields of obj that aren't part of its
+	// object metadata or status, so that it can be created in another cluster.
+	Export(obj runtime.Object) error
+}
+
 // GetterWithOptions is an object that retrieve a named RESTful resource and takes
 // additional options on the get request. It allows a caller to also receive the
 // subpath of the GET request.
@@ -170,6 +179,13 @@ type Updater interface {
 	Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error)
 }
 
+// DryRunner is an object whose Create and Update honor api.IsDryRun, running
+// the request through validation without persisting the result.
+type DryRunner interface {
+	// SupportsDryRun returns true if dry run requests can be served.
+	SupportsDryRun() bool
+}
+
 // CreaterUpdater is a storage object that must support both create and update.
 // Go prevents embedded interfaces that implement the same method.
 type CreaterUpdater interface {
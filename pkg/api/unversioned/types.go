@@ -121,6 +121,9 @@ type StatusDetails struct {
 	Causes []StatusCause `json:"causes,omitempty"`
 	// If specified, the time in seconds before the operation should be retried.
 	RetryAfterSeconds int32 `json:"retryAfterSeconds,omitempty"`
+	// The ID of the request the status is the response of, as in its X-Request-ID
+	// header.
+	RequestID string `json:"requestID,omitempty"`
 }
 
 // Values of Status.Status
@@ -252,6 +255,16 @@ const (
 	// Retrying the request after some time might succeed.
 	// Status code 503
 	StatusReasonServiceUnavailable StatusReason = "ServiceUnavailable"
+
+	// StatusReasonRequestEntityTooLarge means that the request body exceeds the size
+	// the server is willing to process. The request may succeed if its body is made smaller.
+	// Status code 413
+	StatusReasonRequestEntityTooLarge StatusReason = "RequestEntityTooLarge"
+
+	// StatusReasonNotAcceptable means that the server can not encode the response in any
+	// of the content types the client accepts, as listed in its Accept header.
+	// Status code 406
+	StatusReasonNotAcceptable StatusReason = "NotAcceptable"
 )
 
 // StatusCause provides more information about an api.Status failure, including
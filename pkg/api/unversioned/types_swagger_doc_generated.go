@@ -157,6 +157,7 @@ var map_StatusDetails = map[string]string{
 	"kind":              "The kind attribute of the resource associated with the status StatusReason. On some operations may differ from the requested resource Kind. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds",
 	"causes":            "The Causes array includes more details associated with the StatusReason failure. Not all StatusReasons may provide detailed causes.",
 	"retryAfterSeconds": "If specified, the time in seconds before the operation should be retried.",
+	"requestID":         "The ID of the request the status is the response of, as in its X-Request-ID header.",
 }
 
 func (StatusDetails) SwaggerDoc() map[string]string {
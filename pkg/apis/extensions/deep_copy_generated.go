@@ -1512,6 +1512,23 @@ func deepCopy_extensions_ThirdPartyResource(in ThirdPartyResource, out *ThirdPar
 	} else {
 		out.Versions = nil
 	}
+	if in.AdditionalPrinterColumns != nil {
+		out.AdditionalPrinterColumns = make([]ThirdPartyResourceColumn, len(in.AdditionalPrinterColumns))
+		for i := range in.AdditionalPrinterColumns {
+			if err := deepCopy_extensions_ThirdPartyResourceColumn(in.AdditionalPrinterColumns[i], &out.AdditionalPrinterColumns[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalPrinterColumns = nil
+	}
+	return nil
+}
+
+func deepCopy_extensions_ThirdPartyResourceColumn(in ThirdPartyResourceColumn, out *ThirdPartyResourceColumn, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	out.Description = in.Description
 	return nil
 }
 
@@ -1670,6 +1687,7 @@ func init() {
 		deepCopy_extensions_ScaleStatus,
 		deepCopy_extensions_SubresourceReference,
 		deepCopy_extensions_ThirdPartyResource,
+		deepCopy_extensions_ThirdPartyResourceColumn,
 		deepCopy_extensions_ThirdPartyResourceData,
 		deepCopy_extensions_ThirdPartyResourceDataList,
 		deepCopy_extensions_ThirdPartyResourceList,
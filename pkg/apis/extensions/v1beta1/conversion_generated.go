@@ -3088,6 +3088,16 @@ func autoconvert_extensions_ThirdPartyResource_To_v1beta1_ThirdPartyResource(in
 	} else {
 		out.Versions = nil
 	}
+	if in.AdditionalPrinterColumns != nil {
+		out.AdditionalPrinterColumns = make([]ThirdPartyResourceColumn, len(in.AdditionalPrinterColumns))
+		for i := range in.AdditionalPrinterColumns {
+			if err := convert_extensions_ThirdPartyResourceColumn_To_v1beta1_ThirdPartyResourceColumn(&in.AdditionalPrinterColumns[i], &out.AdditionalPrinterColumns[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalPrinterColumns = nil
+	}
 	return nil
 }
 
@@ -3095,6 +3105,20 @@ func convert_extensions_ThirdPartyResource_To_v1beta1_ThirdPartyResource(in *ext
 	return autoconvert_extensions_ThirdPartyResource_To_v1beta1_ThirdPartyResource(in, out, s)
 }
 
+func autoconvert_extensions_ThirdPartyResourceColumn_To_v1beta1_ThirdPartyResourceColumn(in *extensions.ThirdPartyResourceColumn, out *ThirdPartyResourceColumn, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*extensions.ThirdPartyResourceColumn))(in)
+	}
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	out.Description = in.Description
+	return nil
+}
+
+func convert_extensions_ThirdPartyResourceColumn_To_v1beta1_ThirdPartyResourceColumn(in *extensions.ThirdPartyResourceColumn, out *ThirdPartyResourceColumn, s conversion.Scope) error {
+	return autoconvert_extensions_ThirdPartyResourceColumn_To_v1beta1_ThirdPartyResourceColumn(in, out, s)
+}
+
 func autoconvert_extensions_ThirdPartyResourceData_To_v1beta1_ThirdPartyResourceData(in *extensions.ThirdPartyResourceData, out *ThirdPartyResourceData, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*extensions.ThirdPartyResourceData))(in)
@@ -4079,6 +4103,16 @@ func autoconvert_v1beta1_ThirdPartyResource_To_extensions_ThirdPartyResource(in
 	} else {
 		out.Versions = nil
 	}
+	if in.AdditionalPrinterColumns != nil {
+		out.AdditionalPrinterColumns = make([]extensions.ThirdPartyResourceColumn, len(in.AdditionalPrinterColumns))
+		for i := range in.AdditionalPrinterColumns {
+			if err := convert_v1beta1_ThirdPartyResourceColumn_To_extensions_ThirdPartyResourceColumn(&in.AdditionalPrinterColumns[i], &out.AdditionalPrinterColumns[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalPrinterColumns = nil
+	}
 	return nil
 }
 
@@ -4086,6 +4120,20 @@ func convert_v1beta1_ThirdPartyResource_To_extensions_ThirdPartyResource(in *Thi
 	return autoconvert_v1beta1_ThirdPartyResource_To_extensions_ThirdPartyResource(in, out, s)
 }
 
+func autoconvert_v1beta1_ThirdPartyResourceColumn_To_extensions_ThirdPartyResourceColumn(in *ThirdPartyResourceColumn, out *extensions.ThirdPartyResourceColumn, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*ThirdPartyResourceColumn))(in)
+	}
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	out.Description = in.Description
+	return nil
+}
+
+func convert_v1beta1_ThirdPartyResourceColumn_To_extensions_ThirdPartyResourceColumn(in *ThirdPartyResourceColumn, out *extensions.ThirdPartyResourceColumn, s conversion.Scope) error {
+	return autoconvert_v1beta1_ThirdPartyResourceColumn_To_extensions_ThirdPartyResourceColumn(in, out, s)
+}
+
 func autoconvert_v1beta1_ThirdPartyResourceData_To_extensions_ThirdPartyResourceData(in *ThirdPartyResourceData, out *extensions.ThirdPartyResourceData, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*ThirdPartyResourceData))(in)
@@ -4245,6 +4293,7 @@ func init() {
 		autoconvert_extensions_ScaleStatus_To_v1beta1_ScaleStatus,
 		autoconvert_extensions_Scale_To_v1beta1_Scale,
 		autoconvert_extensions_SubresourceReference_To_v1beta1_SubresourceReference,
+		autoconvert_extensions_ThirdPartyResourceColumn_To_v1beta1_ThirdPartyResourceColumn,
 		autoconvert_extensions_ThirdPartyResourceDataList_To_v1beta1_ThirdPartyResourceDataList,
 		autoconvert_extensions_ThirdPartyResourceData_To_v1beta1_ThirdPartyResourceData,
 		autoconvert_extensions_ThirdPartyResourceList_To_v1beta1_ThirdPartyResourceList,
@@ -4331,6 +4380,7 @@ func init() {
 		autoconvert_v1beta1_ScaleStatus_To_extensions_ScaleStatus,
 		autoconvert_v1beta1_Scale_To_extensions_Scale,
 		autoconvert_v1beta1_SubresourceReference_To_extensions_SubresourceReference,
+		autoconvert_v1beta1_ThirdPartyResourceColumn_To_extensions_ThirdPartyResourceColumn,
 		autoconvert_v1beta1_ThirdPartyResourceDataList_To_extensions_ThirdPartyResourceDataList,
 		autoconvert_v1beta1_ThirdPartyResourceData_To_extensions_ThirdPartyResourceData,
 		autoconvert_v1beta1_ThirdPartyResourceList_To_extensions_ThirdPartyResourceList,
@@ -1551,6 +1551,23 @@ func deepCopy_v1beta1_ThirdPartyResource(in ThirdPartyResource, out *ThirdPartyR
 	} else {
 		out.Versions = nil
 	}
+	if in.AdditionalPrinterColumns != nil {
+		out.AdditionalPrinterColumns = make([]ThirdPartyResourceColumn, len(in.AdditionalPrinterColumns))
+		for i := range in.AdditionalPrinterColumns {
+			if err := deepCopy_v1beta1_ThirdPartyResourceColumn(in.AdditionalPrinterColumns[i], &out.AdditionalPrinterColumns[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalPrinterColumns = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta1_ThirdPartyResourceColumn(in ThirdPartyResourceColumn, out *ThirdPartyResourceColumn, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.JSONPath = in.JSONPath
+	out.Description = in.Description
 	return nil
 }
 
@@ -1710,6 +1727,7 @@ func init() {
 		deepCopy_v1beta1_ScaleStatus,
 		deepCopy_v1beta1_SubresourceReference,
 		deepCopy_v1beta1_ThirdPartyResource,
+		deepCopy_v1beta1_ThirdPartyResourceColumn,
 		deepCopy_v1beta1_ThirdPartyResourceData,
 		deepCopy_v1beta1_ThirdPartyResourceDataList,
 		deepCopy_v1beta1_ThirdPartyResourceList,
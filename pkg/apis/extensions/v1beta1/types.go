@@ -143,6 +143,10 @@ type ThirdPartyResource struct {
 
 	// Versions are versions for this third party object
 	Versions []APIVersion `json:"versions,omitempty"`
+
+	// AdditionalPrinterColumns are the columns shown for the objects of this
+	// resource in table output, after their name and age.
+	AdditionalPrinterColumns []ThirdPartyResourceColumn `json:"additionalPrinterColumns,omitempty"`
 }
 
 // ThirdPartyResourceList is a list of ThirdPartyResources.
@@ -165,6 +169,20 @@ type APIVersion struct {
 	APIGroup string `json:"apiGroup,omitempty"`
 }
 
+// ThirdPartyResourceColumn describes a column of the table output of the objects
+// of a third party resource.
+type ThirdPartyResourceColumn struct {
+	// Name is the header of the column.
+	Name string `json:"name"`
+
+	// JSONPath is the JSONPath expression evaluated against each object to get
+	// the value of its cell, e.g. .spec.replicas.
+	JSONPath string `json:"jsonPath"`
+
+	// Description is a human readable description of the column.
+	Description string `json:"description,omitempty"`
+}
+
 // An internal object, used for versioned storage in etcd.  Not exposed to the end user.
 type ThirdPartyResourceData struct {
 	unversioned.TypeMeta `json:",inline"`
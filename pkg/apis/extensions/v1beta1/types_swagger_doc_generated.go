@@ -468,16 +468,28 @@ func (SubresourceReference) SwaggerDoc() map[string]string {
 }
 
 var map_ThirdPartyResource = map[string]string{
-	"":            "A ThirdPartyResource is a generic representation of a resource, it is used by add-ons and plugins to add new resource types to the API.  It consists of one or more Versions of the api.",
-	"metadata":    "Standard object metadata",
-	"description": "Description is the description of this object.",
-	"versions":    "Versions are versions for this third party object",
+	"":                         "A ThirdPartyResource is a generic representation of a resource, it is used by add-ons and plugins to add new resource types to the API.  It consists of one or more Versions of the api.",
+	"metadata":                 "Standard object metadata",
+	"description":              "Description is the description of this object.",
+	"versions":                 "Versions are versions for this third party object",
+	"additionalPrinterColumns": "AdditionalPrinterColumns are the columns shown for the objects of this resource in table output, after their name and age.",
 }
 
 func (ThirdPartyResource) SwaggerDoc() map[string]string {
 	return map_ThirdPartyResource
 }
 
+var map_ThirdPartyResourceColumn = map[string]string{
+	"":            "ThirdPartyResourceColumn describes a column of the table output of the objects of a third party resource.",
+	"name":        "Name is the header of the column.",
+	"jsonPath":    "JSONPath is the JSONPath expression evaluated against each object to get the value of its cell, e.g. .spec.replicas.",
+	"description": "Description is a human readable description of the column.",
+}
+
+func (ThirdPartyResourceColumn) SwaggerDoc() map[string]string {
+	return map_ThirdPartyResourceColumn
+}
+
 var map_ThirdPartyResourceData = map[string]string{
 	"":         "An internal object, used for versioned storage in etcd.  Not exposed to the end user.",
 	"metadata": "Standard object metadata.",
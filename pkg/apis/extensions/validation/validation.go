@@ -27,6 +27,7 @@ import (
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/jsonpath"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
@@ -129,6 +130,30 @@ func ValidateThirdPartyResource(obj *extensions.ThirdPartyResource) field.ErrorL
 		}
 		versions.Insert(version.Name)
 	}
+	allErrs = append(allErrs, validateThirdPartyResourceColumns(obj.AdditionalPrinterColumns, field.NewPath("additionalPrinterColumns"))...)
+	return allErrs
+}
+
+// validateThirdPartyResourceColumns tests that the columns have unique names,
+// and JSONPath expressions that parse.
+func validateThirdPartyResourceColumns(columns []extensions.ThirdPartyResourceColumn, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.String{}
+	for ix := range columns {
+		column := &columns[ix]
+		idxPath := fldPath.Index(ix)
+		if len(column.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name")))
+		} else if names.Has(strings.ToLower(column.Name)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), column.Name))
+		}
+		names.Insert(strings.ToLower(column.Name))
+		if len(column.JSONPath) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("jsonPath")))
+		} else if err := jsonpath.New(column.Name).Parse("{" + column.JSONPath + "}"); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("jsonPath"), column.JSONPath, err.Error()))
+		}
+	}
 	return allErrs
 }
 
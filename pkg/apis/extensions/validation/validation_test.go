@@ -1295,6 +1295,41 @@ func TestValidateScale(t *testing.T) {
 	}
 }
 
+func TestValidateThirdPartyResourceColumns(t *testing.T) {
+	newResource := func(columns ...extensions.ThirdPartyResourceColumn) *extensions.ThirdPartyResource {
+		return &extensions.ThirdPartyResource{
+			ObjectMeta:               api.ObjectMeta{Name: "foo.company.com", Namespace: api.NamespaceDefault},
+			Versions:                 []extensions.APIVersion{{Name: "v1"}},
+			AdditionalPrinterColumns: columns,
+		}
+	}
+
+	successCases := []*extensions.ThirdPartyResource{
+		newResource(),
+		newResource(extensions.ThirdPartyResourceColumn{Name: "Replicas", JSONPath: ".spec.replicas"}, extensions.ThirdPartyResourceColumn{Name: "Image", JSONPath: ".spec.containers[0].image"}),
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateThirdPartyResource(successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]*extensions.ThirdPartyResource{
+		"additionalPrinterColumns[0].name":     newResource(extensions.ThirdPartyResourceColumn{JSONPath: ".spec.replicas"}),
+		"additionalPrinterColumns[1].name":     newResource(extensions.ThirdPartyResourceColumn{Name: "Replicas", JSONPath: ".spec.replicas"}, extensions.ThirdPartyResourceColumn{Name: "replicas", JSONPath: ".status.replicas"}),
+		"additionalPrinterColumns[0].jsonPath": newResource(extensions.ThirdPartyResourceColumn{Name: "Replicas"}),
+		"additionalPrinterColumns[1].jsonPath": newResource(extensions.ThirdPartyResourceColumn{Name: "Replicas", JSONPath: ".spec.replicas"}, extensions.ThirdPartyResourceColumn{Name: "Image", JSONPath: ".spec.containers[0"}),
+	}
+	for field, errorCase := range errorCases {
+		errs := ValidateThirdPartyResource(errorCase)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", field, errs)
+		} else if errs[0].Field != field {
+			t.Errorf("%s: unexpected error: %v", field, errs[0])
+		}
+	}
+}
+
 func newInt(val int) *int {
 	p := new(int)
 	*p = val
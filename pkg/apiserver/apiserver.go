@@ -48,20 +48,20 @@ import (
 
 	"github.com/emicklei/go-restful"
 	"github.com/golang/glog"
-	"github.com/prometheus/client_golang/prometheus"
 )
 
 func init() {
 	metrics.Register()
 }
 
-// monitorFilter creates a filter that reports the metrics for a given resource and action.
-func monitorFilter(action, resource string) restful.FilterFunction {
+// monitorFilter creates a filter that reports the metrics for a given resource and action
+// to requestMetrics.
+func monitorFilter(requestMetrics *metrics.RequestMetrics, action, resource string) restful.FilterFunction {
 	return func(req *restful.Request, res *restful.Response, chain *restful.FilterChain) {
 		reqStart := time.Now()
 		chain.ProcessFilter(req, res)
 		httpCode := res.StatusCode()
-		metrics.Monitor(&action, &resource, util.GetClient(req.Request), &httpCode, reqStart)
+		requestMetrics.Monitor(&action, &resource, util.GetClient(req.Request), &httpCode, reqStart)
 	}
 }
 
@@ -106,6 +106,34 @@ type APIGroupVersion struct {
 	Context api.RequestContextMapper
 
 	MinRequestTimeout time.Duration
+
+	// StrictDecoding makes creates and updates fail with a 422 Unprocessable Entity
+	// if the object in their body has fields its versioned type doesn't have.
+	StrictDecoding bool
+
+	// DeprecationMessage, if set, marks the group version as deprecated. It is sent
+	// in a Warning header with every response of the group version's handlers.
+	DeprecationMessage string
+
+	// ProtobufCodec, if set, encodes the objects returned to the clients that ask
+	// for ProtobufContentType in their Accept header. Without it, or for objects it
+	// can't encode, those clients get JSON if they accept it, and a 406 Not
+	// Acceptable otherwise.
+	ProtobufCodec runtime.Codec
+
+	// DefaultContentType is the content type of the objects returned to the clients
+	// whose Accept header doesn't prefer one, JSONContentType if empty.
+	DefaultContentType string
+
+	// SelfLinkPrefix, if set, is prepended to the self links of the objects returned
+	// by the handlers, so that they point at the URL clients reach the API at, e.g.
+	// behind a gateway that rewrites paths. It is a path, like /k8s, or a URL, like
+	// https://gateway.example.com/k8s.
+	SelfLinkPrefix string
+
+	// RequestMetrics record the requests served for the group version. Defaults to
+	// metrics.DefaultRequestMetrics.
+	RequestMetrics *metrics.RequestMetrics
 }
 
 type ProxyDialerFunc func(network, addr string) (net.Conn, error)
@@ -161,6 +189,10 @@ func (g *APIGroupVersion) newInstaller() *APIInstaller {
 		info:              g.RequestInfoResolver,
 		prefix:            prefix,
 		minRequestTimeout: g.MinRequestTimeout,
+		requestMetrics:    g.RequestMetrics,
+	}
+	if installer.requestMetrics == nil {
+		installer.requestMetrics = metrics.DefaultRequestMetrics
 	}
 	return installer
 }
@@ -170,7 +202,6 @@ func (g *APIGroupVersion) newInstaller() *APIInstaller {
 func InstallSupport(mux Mux, ws *restful.WebService, enableResettingMetrics bool, checks ...healthz.HealthzChecker) {
 	// TODO: convert healthz and metrics to restful and remove container arg
 	healthz.InstallHandler(mux, checks...)
-	mux.Handle("/metrics", prometheus.Handler())
 	if enableResettingMetrics {
 		mux.HandleFunc("/resetMetrics", metrics.Reset)
 	}
@@ -270,7 +301,16 @@ func AddApisWebService(container *restful.Container, apiPrefix string, groups []
 // Adds a service to return the supported versions, preferred version, and name
 // of a group. E.g., a such web service will be registered at /apis/extensions.
 func AddGroupWebService(container *restful.Container, path string, group unversioned.APIGroup) {
-	groupHandler := GroupHandler(group)
+	AddGroupWebServiceFunc(container, path, func() unversioned.APIGroup { return group })
+}
+
+// AddGroupWebServiceFunc adds a service to return the information of the group
+// returned by groupFunc when the service is called, so that the versions of the
+// group can change without the service being replaced.
+func AddGroupWebServiceFunc(container *restful.Container, path string, groupFunc func() unversioned.APIGroup) {
+	groupHandler := func(req *restful.Request, resp *restful.Response) {
+		GroupHandler(groupFunc())(req, resp)
+	}
 	ws := new(restful.WebService)
 	ws.Path(path)
 	ws.Doc("get information of a group")
@@ -419,9 +459,15 @@ func prettyJSON(codec runtime.Codec, object runtime.Object, w http.ResponseWrite
 }
 
 // errorJSON renders an error to the response. Returns the HTTP status code of the error.
+// An error that tells the client when to retry sets the Retry-After header, which the
+// client waits for before retrying.
 func errorJSON(err error, codec runtime.Codec, w http.ResponseWriter) int {
 	status := errToAPIStatus(err)
+	SetStatusRequestID(status, w)
 	code := int(status.Code)
+	if status.Details != nil && status.Details.RetryAfterSeconds > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(status.Details.RetryAfterSeconds)))
+	}
 	writeJSON(code, codec, status, w, true)
 	return code
 }
@@ -431,6 +477,7 @@ func errorJSON(err error, codec runtime.Codec, w http.ResponseWriter) int {
 func errorJSONFatal(err error, codec runtime.Codec, w http.ResponseWriter) int {
 	util.HandleError(fmt.Errorf("apiserver was unable to write a JSON response: %v", err))
 	status := errToAPIStatus(err)
+	SetStatusRequestID(status, w)
 	code := int(status.Code)
 	output, err := runtime.Encode(codec, status)
 	if err != nil {
@@ -1189,6 +1189,65 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestGetExport(t *testing.T) {
+	storage := map[string]rest.Storage{}
+	simpleStorage := SimpleRESTStorage{
+		item: apiservertesting.Simple{
+			ObjectMeta: api.ObjectMeta{
+				Name:              "id",
+				Namespace:         "default",
+				UID:               "uid",
+				ResourceVersion:   "10",
+				Generation:        2,
+				CreationTimestamp: unversioned.Now(),
+				Labels:            map[string]string{"app": "test"},
+			},
+			Other: "foo",
+		},
+	}
+	selfLinker := &setTestSelfLinker{
+		t:         t,
+		name:      "id",
+		namespace: "default",
+	}
+	storage["simple"] = &simpleStorage
+	handler := handleLinker(storage, selfLinker)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	path := server.URL + "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version + "/namespaces/default/simple/id"
+	resp, err := http.Get(path + "?export=true")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+	var itemOut apiservertesting.Simple
+	if _, err := extractBody(resp, &itemOut); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := api.ObjectMeta{
+		Name:   "id",
+		Labels: map[string]string{"app": "test"},
+	}
+	if !reflect.DeepEqual(expected, itemOut.ObjectMeta) || itemOut.Other != "foo" {
+		t.Errorf("expected the cluster-specific fields to be stripped, got %#v", itemOut)
+	}
+	if selfLinker.called {
+		t.Errorf("expected no self link to be set")
+	}
+
+	resp, err = http.Get(path + "?export=maybe")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected a bad request for an invalid export value, got %d", resp.StatusCode)
+	}
+}
+
 func TestGetBinary(t *testing.T) {
 	simpleStorage := SimpleRESTStorage{
 		stream: &SimpleStream{
@@ -1888,6 +1947,57 @@ func TestPatch(t *testing.T) {
 	}
 }
 
+// recordingAdmission is an admission.Interface that records the object of the
+// last request it admitted, and whether it was a dry run.
+type recordingAdmission struct {
+	*admission.Handler
+	object runtime.Object
+	dryRun bool
+}
+
+func (a *recordingAdmission) Admit(attributes admission.Attributes) error {
+	a.object = attributes.GetObject()
+	a.dryRun = attributes.IsDryRun()
+	return nil
+}
+
+func TestPatchAdmitsPatchedObject(t *testing.T) {
+	ID := "id"
+	simpleStorage := SimpleRESTStorage{item: apiservertesting.Simple{
+		ObjectMeta: api.ObjectMeta{Name: ID},
+		Other:      "bar",
+	}}
+	admit := &recordingAdmission{Handler: admission.NewHandler(admission.Update)}
+	handler := handleInternal(map[string]rest.Storage{"simple": &simpleStorage}, admit, selfLinker)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, patchType := range []api.PatchType{api.JSONPatchType, api.MergePatchType} {
+		body := `{"other":"foo"}`
+		if patchType == api.JSONPatchType {
+			body = `[{"op":"replace","path":"/other","value":"foo"}]`
+		}
+		request, err := http.NewRequest("PATCH", server.URL+"/"+prefix+"/"+testGroupVersion.Group+"/"+testGroupVersion.Version+"/namespaces/default/simple/"+ID, bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		request.Header.Set("Content-Type", string(patchType))
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			t.Errorf("%s: unexpected response %#v", patchType, response)
+		}
+		simple, ok := admit.object.(*apiservertesting.Simple)
+		if !ok || simple.Other != "foo" {
+			t.Errorf("%s: expected the patched object to be admitted, got %#v", patchType, admit.object)
+		}
+		admit.object = nil
+	}
+}
+
 func TestPatchRequiresMatchingName(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	ID := "id"
@@ -2532,6 +2642,134 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+// TestStrictDecoding verifies that creates and updates of objects with unknown
+// fields fail when strict decoding is on, and only then.
+func TestStrictDecoding(t *testing.T) {
+	for _, strict := range []bool{false, true} {
+		container := restful.NewContainer()
+		container.Router(restful.CurlyRouter{})
+		group := APIGroupVersion{
+			Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{}},
+			Root:                "/" + prefix,
+			GroupVersion:        testGroupVersion,
+			RequestInfoResolver: newTestRequestInfoResolver(),
+
+			Creater:   api.Scheme,
+			Convertor: api.Scheme,
+			Typer:     api.Scheme,
+			Codec:     codec,
+			Linker:    selfLinker,
+			Mapper:    namespaceMapper,
+
+			OptionsExternalVersion: &testGroupVersion,
+			Admit:                  admissionControl,
+			Context:                requestContextMapper,
+
+			StrictDecoding: strict,
+		}
+		if err := group.InstallREST(container); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server := httptest.NewServer(container.ServeMux)
+
+		base := server.URL + "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version + "/namespaces/default/simple"
+		apiVersion := testGroupVersion.String()
+		for _, test := range []struct {
+			method, url, body string
+			unknown           bool
+		}{
+			{"POST", base, `{"kind":"Simple","apiVersion":"` + apiVersion + `","metadata":{"name":"id"},"other":"bar"}`, false},
+			{"POST", base, `{"kind":"Simple","apiVersion":"` + apiVersion + `","metadata":{"name":"id","lables":{}},"othr":"bar"}`, true},
+			{"PUT", base + "/id", `{"kind":"Simple","apiVersion":"` + apiVersion + `","metadata":{"name":"id"},"other":"bar"}`, false},
+			{"PUT", base + "/id", `{"kind":"Simple","apiVersion":"` + apiVersion + `","metadata":{"name":"id"},"othr":"bar"}`, true},
+		} {
+			request, err := http.NewRequest(test.method, test.url, bytes.NewBufferString(test.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			body, _ := ioutil.ReadAll(response.Body)
+			response.Body.Close()
+
+			if !strict || !test.unknown {
+				if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+					t.Errorf("strict=%v %s %s: unexpected status %d: %s", strict, test.method, test.body, response.StatusCode, body)
+				}
+				continue
+			}
+			if response.StatusCode != apierrs.StatusUnprocessableEntity {
+				t.Errorf("%s %s: expected status %d, got %d: %s", test.method, test.body, apierrs.StatusUnprocessableEntity, response.StatusCode, body)
+				continue
+			}
+			if !strings.Contains(string(body), "othr") {
+				t.Errorf("%s %s: expected the unknown field to be reported, got %s", test.method, test.body, body)
+			}
+			if test.method == "POST" && !strings.Contains(string(body), "metadata.lables") {
+				t.Errorf("%s %s: expected the unknown metadata field to be reported, got %s", test.method, test.body, body)
+			}
+		}
+		server.Close()
+	}
+}
+
+// DryRunRESTStorage is a SimpleRESTStorage that serves dry run requests.
+type DryRunRESTStorage struct {
+	SimpleRESTStorage
+	dryRun bool
+}
+
+func (storage *DryRunRESTStorage) SupportsDryRun() bool {
+	return true
+}
+
+func (storage *DryRunRESTStorage) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	storage.dryRun = api.IsDryRun(ctx)
+	return storage.SimpleRESTStorage.Create(ctx, obj)
+}
+
+func TestCreateDryRun(t *testing.T) {
+	table := []struct {
+		storage      rest.Storage
+		query        string
+		expectedCode int
+		dryRun       bool
+	}{
+		{&DryRunRESTStorage{}, "", http.StatusCreated, false},
+		{&DryRunRESTStorage{}, "?dryRun=All", http.StatusCreated, true},
+		{&DryRunRESTStorage{}, "?dryRun=Some", http.StatusBadRequest, false},
+		{&SimpleRESTStorage{}, "?dryRun=All", http.StatusBadRequest, false},
+	}
+	for i, item := range table {
+		admit := &recordingAdmission{Handler: admission.NewHandler(admission.Create)}
+		handler := handleInternal(map[string]rest.Storage{"foo": item.storage}, admit, selfLinker)
+		server := httptest.NewServer(handler)
+
+		data, err := runtime.Encode(codec, &apiservertesting.Simple{Other: "bar"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		response, err := http.Post(server.URL+"/"+prefix+"/"+testGroupVersion.Group+"/"+testGroupVersion.Version+"/namespaces/default/foo"+item.query, "application/json", bytes.NewBuffer(data))
+		server.Close()
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		response.Body.Close()
+		if response.StatusCode != item.expectedCode {
+			t.Errorf("%d: expected status %d, got %d", i, item.expectedCode, response.StatusCode)
+		}
+		if storage, ok := item.storage.(*DryRunRESTStorage); ok && storage.dryRun != item.dryRun {
+			t.Errorf("%d: expected dry run %v, got %v", i, item.dryRun, storage.dryRun)
+		}
+		if admit.dryRun != item.dryRun {
+			t.Errorf("%d: expected admission of a dry run %v, got %v", i, item.dryRun, admit.dryRun)
+		}
+	}
+}
+
 func TestCreateInNamespace(t *testing.T) {
 	storage := SimpleRESTStorage{
 		injectedFunction: func(obj runtime.Object) (runtime.Object, error) {
@@ -2725,6 +2963,26 @@ func TestWriteRAWJSONMarshalError(t *testing.T) {
 	}
 }
 
+// TestErrorJSONRetryAfter verifies that the errors that tell the client when to
+// retry set the Retry-After header.
+func TestErrorJSONRetryAfter(t *testing.T) {
+	testCases := []struct {
+		err        error
+		retryAfter string
+	}{
+		{apierrs.NewTooManyRequestsError("slow down", 2), "2"},
+		{apierrs.NewServerTimeout("pods", "list", 0), ""},
+		{apierrs.NewNotFound("pods", "foo"), ""},
+	}
+	for _, testCase := range testCases {
+		w := httptest.NewRecorder()
+		errorJSON(testCase.err, codec, w)
+		if retryAfter := w.Header().Get("Retry-After"); retryAfter != testCase.retryAfter {
+			t.Errorf("%v: expected Retry-After %q, got %q", testCase.err, testCase.retryAfter, retryAfter)
+		}
+	}
+}
+
 func TestCreateTimeout(t *testing.T) {
 	testOver := make(chan struct{})
 	defer close(testOver)
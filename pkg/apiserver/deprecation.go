@@ -0,0 +1,34 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"strconv"
+
+	"github.com/emicklei/go-restful"
+)
+
+// deprecationWarningFilter returns a filter that adds a Warning header with the
+// miscellaneous persistent warning code 299 and message to every response, e.g.
+// Warning: 299 - "extensions/v1beta1 is deprecated".
+func deprecationWarningFilter(message string) restful.FilterFunction {
+	warning := "299 - " + strconv.Quote(message)
+	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+		resp.Header().Add("Warning", warning)
+		chain.ProcessFilter(req, resp)
+	}
+}
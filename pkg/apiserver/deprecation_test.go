@@ -0,0 +1,77 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+
+	"github.com/emicklei/go-restful"
+)
+
+// TestDeprecationWarning verifies that every response of a deprecated group
+// version carries a Warning header with its deprecation message, and that the
+// responses of other group versions don't.
+func TestDeprecationWarning(t *testing.T) {
+	for _, message := range []string{"", `test.group/version is deprecated, use "test.group/version2"`} {
+		container := restful.NewContainer()
+		container.Router(restful.CurlyRouter{})
+		group := APIGroupVersion{
+			Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{}},
+			Root:                "/" + prefix,
+			GroupVersion:        testGroupVersion,
+			RequestInfoResolver: newTestRequestInfoResolver(),
+
+			Creater:   api.Scheme,
+			Convertor: api.Scheme,
+			Typer:     api.Scheme,
+			Codec:     codec,
+			Linker:    selfLinker,
+			Mapper:    namespaceMapper,
+
+			OptionsExternalVersion: &testGroupVersion,
+			Context:                requestContextMapper,
+
+			DeprecationMessage: message,
+		}
+		if err := group.InstallREST(container); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server := httptest.NewServer(container.ServeMux)
+
+		base := server.URL + "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version
+		for _, path := range []string{"", "/namespaces/default/simple", "/namespaces/default/simple/missing"} {
+			resp, err := http.Get(base + path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			expected := []string(nil)
+			if len(message) > 0 {
+				expected = []string{`299 - "test.group/version is deprecated, use \"test.group/version2\""`}
+			}
+			if warnings := resp.Header["Warning"]; len(warnings) != len(expected) || (len(expected) > 0 && warnings[0] != expected[0]) {
+				t.Errorf("%q %s: expected warnings %v, got %v", message, path, expected, warnings)
+			}
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/emicklei/go-restful"
+)
+
+// AggregatedDiscovery lists every API group served, with the resources of each of
+// its versions, so that clients can discover the whole API in a single request.
+type AggregatedDiscovery struct {
+	Groups []DiscoveryGroup `json:"groups"`
+}
+
+// DiscoveryGroup is an API group of an AggregatedDiscovery. The legacy group has
+// no name.
+type DiscoveryGroup struct {
+	Name             string                        `json:"name"`
+	PreferredVersion string                        `json:"preferredVersion,omitempty"`
+	Versions         []unversioned.APIResourceList `json:"versions"`
+}
+
+// AggregatedDiscoveryHandler returns a handler that serves the AggregatedDiscovery
+// of the group versions installed in container. It is assembled from the group and
+// resource discovery routes of the web services registered when it is called, so
+// that it includes the groups added or removed later, like third party ones.
+func AggregatedDiscoveryHandler(container *restful.Container) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeRawJSON(http.StatusOK, aggregateDiscovery(container.RegisteredWebServices(), req), w)
+	})
+}
+
+// aggregateDiscovery calls the discovery routes of services with req and merges
+// their responses. The legacy group comes first, the other groups by name.
+func aggregateDiscovery(services []*restful.WebService, req *http.Request) *AggregatedDiscovery {
+	groups := map[string]*DiscoveryGroup{}
+	group := func(name string) *DiscoveryGroup {
+		if _, found := groups[name]; !found {
+			groups[name] = &DiscoveryGroup{Name: name, Versions: []unversioned.APIResourceList{}}
+		}
+		return groups[name]
+	}
+	for _, service := range services {
+		for _, route := range service.Routes() {
+			switch route.Operation {
+			case "getAPIGroup":
+				apiGroup := unversioned.APIGroup{}
+				if callDiscoveryRoute(route, req, &apiGroup) {
+					group(apiGroup.Name).PreferredVersion = apiGroup.PreferredVersion.Version
+				}
+			case "getAPIResources":
+				resources := unversioned.APIResourceList{}
+				if !callDiscoveryRoute(route, req, &resources) {
+					continue
+				}
+				groupVersion, err := unversioned.ParseGroupVersion(resources.GroupVersion)
+				if err != nil {
+					continue
+				}
+				g := group(groupVersion.Group)
+				replaced := false
+				for i := range g.Versions {
+					// A web service updated by UpdateREST has a route per update.
+					if g.Versions[i].GroupVersion == resources.GroupVersion {
+						g.Versions[i] = resources
+						replaced = true
+					}
+				}
+				if !replaced {
+					g.Versions = append(g.Versions, resources)
+				}
+			}
+		}
+	}
+
+	names := []string{}
+	for name, g := range groups {
+		if len(g.Versions) == 0 {
+			// The group has no version installed anymore.
+			continue
+		}
+		if len(g.PreferredVersion) == 0 {
+			version, _ := unversioned.ParseGroupVersion(g.Versions[0].GroupVersion)
+			g.PreferredVersion = version.Version
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	discovery := &AggregatedDiscovery{Groups: []DiscoveryGroup{}}
+	for _, name := range names {
+		discovery.Groups = append(discovery.Groups, *groups[name])
+	}
+	return discovery
+}
+
+// callDiscoveryRoute calls the function of a discovery route with req, and decodes
+// its response into obj. It returns false if the route didn't respond with one.
+func callDiscoveryRoute(route restful.Route, req *http.Request, obj interface{}) bool {
+	recorder := &discoveryRecorder{header: http.Header{}, code: http.StatusOK}
+	route.Function(restful.NewRequest(req), restful.NewResponse(recorder))
+	return recorder.code == http.StatusOK && json.Unmarshal(recorder.body.Bytes(), obj) == nil
+}
+
+// discoveryRecorder is an http.ResponseWriter that keeps the response of a
+// discovery route.
+type discoveryRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (r *discoveryRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *discoveryRecorder) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *discoveryRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
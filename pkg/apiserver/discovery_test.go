@@ -0,0 +1,108 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/emicklei/go-restful"
+)
+
+// TestAggregatedDiscovery verifies that the aggregated discovery document lists
+// the groups installed in a container, with the resources of their versions, as
+// the web services are added and removed.
+func TestAggregatedDiscovery(t *testing.T) {
+	container := restful.NewContainer()
+	container.Router(restful.CurlyRouter{})
+	for _, groupVersion := range []unversioned.GroupVersion{testGroupVersion, newGroupVersion} {
+		groupVersion := groupVersion
+		group := APIGroupVersion{
+			Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{}},
+			Root:                "/" + prefix,
+			GroupVersion:        groupVersion,
+			RequestInfoResolver: newTestRequestInfoResolver(),
+
+			Creater:   api.Scheme,
+			Convertor: api.Scheme,
+			Typer:     api.Scheme,
+			Codec:     codec,
+			Linker:    selfLinker,
+			Mapper:    namespaceMapper,
+
+			OptionsExternalVersion: &groupVersion,
+			Context:                requestContextMapper,
+		}
+		if err := group.InstallREST(container); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	AddGroupWebService(container, "/"+prefix+"/"+testAPIGroup, unversioned.APIGroup{
+		Name: testAPIGroup,
+		Versions: []unversioned.GroupVersionForDiscovery{
+			{GroupVersion: testGroupVersion.String(), Version: testGroupVersion.Version},
+			{GroupVersion: newGroupVersion.String(), Version: newGroupVersion.Version},
+		},
+		PreferredVersion: unversioned.GroupVersionForDiscovery{GroupVersion: newGroupVersion.String(), Version: newGroupVersion.Version},
+	})
+	server := httptest.NewServer(AggregatedDiscoveryHandler(container))
+	defer server.Close()
+
+	get := func() AggregatedDiscovery {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		discovery := AggregatedDiscovery{}
+		if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return discovery
+	}
+
+	discovery := get()
+	if len(discovery.Groups) != 1 {
+		t.Fatalf("expected one group, got %#v", discovery.Groups)
+	}
+	group := discovery.Groups[0]
+	if group.Name != testAPIGroup || group.PreferredVersion != newGroupVersion.Version || len(group.Versions) != 2 {
+		t.Fatalf("unexpected group: %#v", group)
+	}
+	for i, groupVersion := range []unversioned.GroupVersion{testGroupVersion, newGroupVersion} {
+		resources := group.Versions[i]
+		if resources.GroupVersion != groupVersion.String() || len(resources.APIResources) == 0 || resources.APIResources[0].Name != "simple" {
+			t.Errorf("unexpected resources of %s: %#v", groupVersion, resources)
+		}
+	}
+
+	for _, service := range container.RegisteredWebServices() {
+		if service.RootPath() == "/"+prefix+"/"+newGroupVersion.Group+"/"+newGroupVersion.Version {
+			container.Remove(service)
+		}
+	}
+	discovery = get()
+	if len(discovery.Groups) != 1 || len(discovery.Groups[0].Versions) != 1 || discovery.Groups[0].Versions[0].GroupVersion != testGroupVersion.String() {
+		t.Errorf("expected only %s after its other version was removed, got %#v", testGroupVersion, discovery.Groups)
+	}
+}
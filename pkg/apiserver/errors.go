@@ -69,6 +69,26 @@ func errToAPIStatus(err error) *unversioned.Status {
 	}
 }
 
+// RequestIDHeader is the header carrying the ID of a request, in the request if the
+// client picked it and in its response.
+const RequestIDHeader = "X-Request-ID"
+
+// SetStatusRequestID sets the ID of the request whose response is written to w, if
+// the response carries one, in the details of status. Clients that only keep the
+// body of an error can so still tell which request failed.
+func SetStatusRequestID(status *unversioned.Status, w http.ResponseWriter) {
+	requestID := w.Header().Get(RequestIDHeader)
+	if len(requestID) == 0 {
+		return
+	}
+	details := unversioned.StatusDetails{}
+	if status.Details != nil {
+		details = *status.Details
+	}
+	details.RequestID = requestID
+	status.Details = &details
+}
+
 // notFound renders a simple not found error.
 func notFound(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"regexp"
@@ -77,9 +78,23 @@ func MaxInFlightLimit(c chan bool, longRunningRequestRE *regexp.Regexp, handler
 	if c == nil {
 		return handler
 	}
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return MaxInFlightLimitFunc(func(r *http.Request) chan bool {
 		if longRunningRequestRE.MatchString(r.URL.Path) {
 			// Skip tracking long running events.
+			return nil
+		}
+		return c
+	}, handler)
+}
+
+// MaxInFlightLimitFunc limits the number of in-flight requests like MaxInFlightLimit,
+// except that the channel whose buffer size limits a request is the one returned by
+// semaphore, so that different kinds of requests can have separate limits. The
+// requests semaphore returns nil for are not limited.
+func MaxInFlightLimitFunc(semaphore func(*http.Request) chan bool, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := semaphore(r)
+		if c == nil {
 			handler.ServeHTTP(w, r)
 			return
 		}
@@ -99,6 +114,61 @@ func tooManyRequests(w http.ResponseWriter) {
 	http.Error(w, "Too many requests, please try again later.", errors.StatusTooManyRequests)
 }
 
+// MaxRequestBodyBytes limits the size of the request bodies the passed in handler can read to
+// limit bytes. Requests declaring a larger Content-Length are rejected with 413 Request Entity
+// Too Large before reaching the handler; reading past the limit of any other body fails with an
+// error that API handlers render as 413. A limit of zero or less disables the check. The long
+// running requests, whose path matches longRunningRequestRE if it is not nil, are not limited,
+// since proxy, exec and attach requests stream their bodies.
+func MaxRequestBodyBytes(limit int64, longRunningRequestRE *regexp.Regexp, handler http.Handler) http.Handler {
+	if limit <= 0 {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if longRunningRequestRE != nil && longRunningRequestRE.MatchString(req.URL.Path) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if req.ContentLength > limit {
+			requestEntityTooLarge(w, limit)
+			return
+		}
+		if req.Body != nil {
+			req.Body = &limitedBody{ReadCloser: req.Body, remaining: limit, limit: limit}
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+func requestEntityTooLarge(w http.ResponseWriter, limit int64) {
+	// Return a 413 status indicating "Request Entity Too Large"
+	http.Error(w, fmt.Sprintf("Request body exceeds the limit of %d bytes.", limit), http.StatusRequestEntityTooLarge)
+}
+
+// limitedBody is a request body that fails with a RequestEntityTooLarge error once more than
+// limit bytes have been read from it.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	limit     int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, errors.NewRequestEntityTooLargeError(fmt.Sprintf("request body exceeds the limit of %d bytes", b.limit))
+	}
+	// Read one byte past the limit so that a body of exactly limit bytes is not rejected.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), errors.NewRequestEntityTooLargeError(fmt.Sprintf("request body exceeds the limit of %d bytes", b.limit))
+	}
+	return n, err
+}
+
 // RecoverPanics wraps an http Handler to recover and log panics.
 func RecoverPanics(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -354,15 +424,20 @@ func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, re
 func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attributes {
 	attribs := authorizer.AttributesRecord{}
 
+	// The request info is resolved unless it was put in the request context.
+	var requestInfo RequestInfo
+	resolved := false
 	ctx, ok := r.requestContextMapper.Get(req)
 	if ok {
 		user, ok := api.UserFrom(ctx)
 		if ok {
 			attribs.User = user
 		}
+		requestInfo, resolved = RequestInfoFrom(ctx)
+	}
+	if !resolved {
+		requestInfo, _ = r.requestInfoResolver.GetRequestInfo(req)
 	}
-
-	requestInfo, _ := r.requestInfoResolver.GetRequestInfo(req)
 
 	// Start with common attributes that apply to resource and non-resource requests
 	attribs.ResourceRequest = requestInfo.IsResourceRequest
@@ -422,6 +497,24 @@ type RequestInfo struct {
 	Parts []string
 }
 
+// requestInfoKeyType is the type of requestInfoKey, so that it doesn't collide
+// with the keys of other packages.
+type requestInfoKeyType int
+
+// requestInfoKey is the context key for the RequestInfo of a request.
+const requestInfoKey requestInfoKeyType = 0
+
+// WithRequestInfo returns a copy of parent in which the request info value is set
+func WithRequestInfo(parent api.Context, info RequestInfo) api.Context {
+	return api.WithValue(parent, requestInfoKey, info)
+}
+
+// RequestInfoFrom returns the value of the request info key on the ctx
+func RequestInfoFrom(ctx api.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey).(RequestInfo)
+	return info, ok
+}
+
 type RequestInfoResolver struct {
 	APIPrefixes          sets.String
 	GrouplessAPIPrefixes sets.String
@@ -17,9 +17,11 @@ limitations under the License.
 package apiserver
 
 import (
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
@@ -147,6 +149,86 @@ func TestMaxInFlight(t *testing.T) {
 	expectHTTP(server.URL, http.StatusOK, t)
 }
 
+// Tests that MaxInFlightLimitFunc limits the requests sharing a channel together,
+// and leaves alone the ones it returns no channel for.
+func TestMaxInFlightLimitFunc(t *testing.T) {
+	writes, reads := make(chan bool, 1), make(chan bool, 1)
+	block := make(chan struct{})
+	handler := MaxInFlightLimitFunc(func(r *http.Request) chan bool {
+		switch r.Method {
+		case "POST":
+			return writes
+		case "GET":
+			return reads
+		}
+		return nil
+	}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/block" {
+			<-block
+		}
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(httptest.NewRecorder(), &http.Request{Method: "POST", URL: &url.URL{Path: "/block"}})
+		close(done)
+	}()
+	for len(writes) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for method, expected := range map[string]int{"POST": errors.StatusTooManyRequests, "GET": http.StatusOK, "PUT": http.StatusOK} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, &http.Request{Method: method, URL: &url.URL{Path: "/"}})
+		if w.Code != expected {
+			t.Errorf("%s: expected status %d, got %d", method, expected, w.Code)
+		}
+	}
+	close(block)
+	<-done
+}
+
+// Tests that MaxRequestBodyBytes rejects bodies larger than the limit, both when the
+// Content-Length is known up front and when the body is streamed, except for the long
+// running requests.
+func TestMaxRequestBodyBytes(t *testing.T) {
+	const limit = 10
+	server := httptest.NewServer(MaxRequestBodyBytes(limit, regexp.MustCompile("/exec$"), http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			if _, err := readBody(req); err != nil {
+				errorJSON(err, testapi.Default.Codec(), w)
+			}
+		},
+	)))
+	defer server.Close()
+
+	testCases := []struct {
+		path     string
+		body     io.Reader
+		expected int
+	}{
+		{"/", strings.NewReader(""), http.StatusOK},
+		{"/", strings.NewReader(strings.Repeat("a", limit)), http.StatusOK},
+		{"/", strings.NewReader(strings.Repeat("a", limit+1)), http.StatusRequestEntityTooLarge},
+		// A reader of unknown length is sent chunked, without a Content-Length.
+		{"/", ioutil.NopCloser(strings.NewReader(strings.Repeat("a", limit))), http.StatusOK},
+		{"/", ioutil.NopCloser(strings.NewReader(strings.Repeat("a", limit+1))), http.StatusRequestEntityTooLarge},
+		// The long running requests aren't limited.
+		{"/api/v1/namespaces/default/pods/foo/exec", strings.NewReader(strings.Repeat("a", limit+1)), http.StatusOK},
+		{"/api/v1/namespaces/default/pods/foo/exec", ioutil.NopCloser(strings.NewReader(strings.Repeat("a", limit+1))), http.StatusOK},
+	}
+	for i, testCase := range testCases {
+		resp, err := http.Post(server.URL+testCase.path, "application/json", testCase.body)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != testCase.expected {
+			t.Errorf("%d: expected %d, got %d", i, testCase.expected, resp.StatusCode)
+		}
+	}
+}
+
 func TestReadOnly(t *testing.T) {
 	server := httptest.NewServer(ReadOnly(http.HandlerFunc(
 		func(w http.ResponseWriter, req *http.Request) {
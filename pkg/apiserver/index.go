@@ -26,13 +26,7 @@ import (
 )
 
 func IndexHandler(container *restful.Container, muxHelper *MuxHelper) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, r *http.Request) {
-		status := http.StatusOK
-		if r.URL.Path != "/" && r.URL.Path != "/index.html" {
-			// Since "/" matches all paths, handleIndex is called for all paths for which there is no handler registered.
-			// We want to to return a 404 status with a list of all valid paths, incase of an invalid URL request.
-			status = http.StatusNotFound
-		}
+	return IndexHandlerForPaths(func() []string {
 		var handledPaths []string
 		// Extract the paths handled using restful.WebService
 		for _, ws := range container.RegisteredWebServices() {
@@ -40,6 +34,21 @@ func IndexHandler(container *restful.Container, muxHelper *MuxHelper) func(http.
 		}
 		// Extract the paths handled using mux handler.
 		handledPaths = append(handledPaths, muxHelper.RegisteredPaths...)
+		return handledPaths
+	})
+}
+
+// IndexHandlerForPaths returns a handler that lists the paths returned by paths,
+// which is called on every request so that the listing follows the served paths.
+func IndexHandlerForPaths(paths func() []string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		if r.URL.Path != "/" && r.URL.Path != "/index.html" {
+			// Since "/" matches all paths, handleIndex is called for all paths for which there is no handler registered.
+			// We want to to return a 404 status with a list of all valid paths, incase of an invalid URL request.
+			status = http.StatusNotFound
+		}
+		handledPaths := paths()
 		sort.Strings(handledPaths)
 		writeRawJSON(status, unversioned.RootPaths{Paths: handledPaths}, w)
 	}
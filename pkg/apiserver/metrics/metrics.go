@@ -25,52 +25,88 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-var (
+// RequestMetrics are the metrics of the API requests served by a master. They
+// are a prometheus.Collector, so that each master can register them against its
+// own registry.
+type RequestMetrics struct {
 	// TODO(a-robinson): Add unit tests for the handling of these metrics once
 	// the upstream library supports it.
-	requestCounter = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "apiserver_request_count",
-			Help: "Counter of apiserver requests broken out for each verb, API resource, client, and HTTP response code.",
-		},
-		[]string{"verb", "resource", "client", "code"},
-	)
-	requestLatencies = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name: "apiserver_request_latencies",
-			Help: "Response latency distribution in microseconds for each verb, resource and client.",
-			// Use buckets ranging from 125 ms to 8 seconds.
-			Buckets: prometheus.ExponentialBuckets(125000, 2.0, 7),
-		},
-		[]string{"verb", "resource"},
-	)
-	requestLatenciesSummary = prometheus.NewSummaryVec(
-		prometheus.SummaryOpts{
-			Name: "apiserver_request_latencies_summary",
-			Help: "Response latency summary in microseconds for each verb and resource.",
-			// Make the sliding window of 1h.
-			MaxAge: time.Hour,
-		},
-		[]string{"verb", "resource"},
-	)
-)
+	requestCounter          *prometheus.CounterVec
+	requestLatencies        *prometheus.HistogramVec
+	requestLatenciesSummary *prometheus.SummaryVec
+}
+
+// NewRequestMetrics returns new, unregistered, request metrics.
+func NewRequestMetrics() *RequestMetrics {
+	return &RequestMetrics{
+		requestCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "apiserver_request_count",
+				Help: "Counter of apiserver requests broken out for each verb, API resource, client, and HTTP response code.",
+			},
+			[]string{"verb", "resource", "client", "code"},
+		),
+		requestLatencies: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name: "apiserver_request_latencies",
+				Help: "Response latency distribution in microseconds for each verb, resource and client.",
+				// Use buckets ranging from 125 ms to 8 seconds.
+				Buckets: prometheus.ExponentialBuckets(125000, 2.0, 7),
+			},
+			[]string{"verb", "resource"},
+		),
+		requestLatenciesSummary: prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name: "apiserver_request_latencies_summary",
+				Help: "Response latency summary in microseconds for each verb and resource.",
+				// Make the sliding window of 1h.
+				MaxAge: time.Hour,
+			},
+			[]string{"verb", "resource"},
+		),
+	}
+}
+
+// DefaultRequestMetrics are the request metrics registered against the global
+// prometheus registry.
+var DefaultRequestMetrics = NewRequestMetrics()
+
+func (m *RequestMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requestCounter.Describe(ch)
+	m.requestLatencies.Describe(ch)
+	m.requestLatenciesSummary.Describe(ch)
+}
+
+func (m *RequestMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.requestCounter.Collect(ch)
+	m.requestLatencies.Collect(ch)
+	m.requestLatenciesSummary.Collect(ch)
+}
+
+// Monitor records a request for resource, served with httpCode.
+func (m *RequestMetrics) Monitor(verb, resource *string, client string, httpCode *int, reqStart time.Time) {
+	m.requestCounter.WithLabelValues(*verb, *resource, client, strconv.Itoa(*httpCode)).Inc()
+	m.requestLatencies.WithLabelValues(*verb, *resource).Observe(float64((time.Since(reqStart)) / time.Microsecond))
+	m.requestLatenciesSummary.WithLabelValues(*verb, *resource).Observe(float64((time.Since(reqStart)) / time.Microsecond))
+}
+
+// ServeReset resets the metrics.
+func (m *RequestMetrics) ServeReset(w http.ResponseWriter, req *http.Request) {
+	m.requestCounter.Reset()
+	m.requestLatencies.Reset()
+	m.requestLatenciesSummary.Reset()
+	io.WriteString(w, "metrics reset\n")
+}
 
 // Register all metrics.
 func Register() {
-	prometheus.MustRegister(requestCounter)
-	prometheus.MustRegister(requestLatencies)
-	prometheus.MustRegister(requestLatenciesSummary)
+	prometheus.MustRegister(DefaultRequestMetrics)
 }
 
 func Monitor(verb, resource *string, client string, httpCode *int, reqStart time.Time) {
-	requestCounter.WithLabelValues(*verb, *resource, client, strconv.Itoa(*httpCode)).Inc()
-	requestLatencies.WithLabelValues(*verb, *resource).Observe(float64((time.Since(reqStart)) / time.Microsecond))
-	requestLatenciesSummary.WithLabelValues(*verb, *resource).Observe(float64((time.Since(reqStart)) / time.Microsecond))
+	DefaultRequestMetrics.Monitor(verb, resource, client, httpCode, reqStart)
 }
 
 func Reset(w http.ResponseWriter, req *http.Request) {
-	requestCounter.Reset()
-	requestLatencies.Reset()
-	requestLatenciesSummary.Reset()
-	io.WriteString(w, "metrics reset\n")
+	DefaultRequestMetrics.ServeReset(w, req)
 }
@@ -0,0 +1,154 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/emicklei/go-restful"
+)
+
+const (
+	// JSONContentType is the content type of the objects encoded by the codec of
+	// their group version.
+	JSONContentType = "application/json"
+	// ProtobufContentType is the content type of the objects encoded by the
+	// protobuf codec of their group version.
+	ProtobufContentType = "application/vnd.kubernetes.protobuf"
+)
+
+// acceptedMediaType is a media type of an Accept header and its quality.
+type acceptedMediaType struct {
+	mediaType string
+	quality   float64
+}
+
+type byQuality []acceptedMediaType
+
+func (q byQuality) Len() int           { return len(q) }
+func (q byQuality) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q byQuality) Less(i, j int) bool { return q[i].quality > q[j].quality }
+
+// acceptedMediaTypes returns the media types of the Accept header accept, most
+// preferred first. The media types the header refuses, with a quality of 0, and
+// the ones that don't parse are left out.
+func acceptedMediaTypes(accept string) []string {
+	accepted := []acceptedMediaType{}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+		accepted = append(accepted, acceptedMediaType{mediaType, quality})
+	}
+	sort.Stable(byQuality(accepted))
+	mediaTypes := make([]string, 0, len(accepted))
+	for _, a := range accepted {
+		mediaTypes = append(mediaTypes, a.mediaType)
+	}
+	return mediaTypes
+}
+
+// negotiateContentType returns the content type, among JSON and, if protobuf is
+// true, protobuf, that the Accept header accept prefers. An empty header, or a
+// wildcard, stands for defaultContentType when it can be encoded and for JSON
+// otherwise. A header that allows none of the content types gets JSON.
+func negotiateContentType(accept, defaultContentType string, protobuf bool) string {
+	if defaultContentType != ProtobufContentType || !protobuf {
+		defaultContentType = JSONContentType
+	}
+	if len(strings.TrimSpace(accept)) == 0 {
+		return defaultContentType
+	}
+	for _, mediaType := range acceptedMediaTypes(accept) {
+		switch {
+		case mediaType == JSONContentType:
+			return JSONContentType
+		case mediaType == ProtobufContentType && protobuf:
+			return ProtobufContentType
+		case mediaType == "*/*" || mediaType == "application/*":
+			return defaultContentType
+		}
+	}
+	return JSONContentType
+}
+
+// writeNegotiated renders a returned runtime.Object to the response like write,
+// except that objects that aren't streamed are encoded in the content type that
+// is negotiated from the Accept header of req among the ones scope can encode.
+// An object that the protobuf codec of scope fails to encode is sent as JSON.
+func writeNegotiated(statusCode int, scope RequestScope, object runtime.Object, w http.ResponseWriter, req *http.Request) {
+	if _, ok := object.(rest.ResourceStreamer); ok {
+		write(statusCode, scope.Kind.GroupVersion(), scope.Codec, object, w, req)
+		return
+	}
+	if negotiateContentType(req.Header.Get("Accept"), scope.DefaultContentType, scope.ProtobufCodec != nil) == ProtobufContentType {
+		if data, err := runtime.Encode(scope.ProtobufCodec, object); err == nil {
+			w.Header().Set("Content-Type", ProtobufContentType)
+			w.WriteHeader(statusCode)
+			w.Write(data)
+			return
+		}
+	}
+	writeJSON(statusCode, scope.Codec, object, w, isPrettyPrint(req))
+}
+
+// JSONFallbackRouter returns a restful.RouteSelector that selects routes like
+// router, except that the requests whose Accept header none of the routes of
+// their path produce are routed, and answered, as if they accepted JSON rather
+// than refused with a 406 Not Acceptable.
+func JSONFallbackRouter(router restful.RouteSelector) restful.RouteSelector {
+	return jsonFallbackRouter{router}
+}
+
+type jsonFallbackRouter struct {
+	restful.RouteSelector
+}
+
+func (r jsonFallbackRouter) SelectRoute(webServices []*restful.WebService, req *http.Request) (*restful.WebService, *restful.Route, error) {
+	webService, route, err := r.RouteSelector.SelectRoute(webServices, req)
+	if serviceErr, ok := err.(restful.ServiceError); ok && serviceErr.Code == http.StatusNotAcceptable {
+		req.Header.Set("Accept", JSONContentType)
+		return r.RouteSelector.SelectRoute(webServices, req)
+	}
+	return webService, route, err
+}
+
+// supportedContentTypes returns the content types the objects of scope can be
+// encoded in.
+func supportedContentTypes(scope RequestScope) []string {
+	if scope.ProtobufCodec != nil {
+		return []string{JSONContentType, ProtobufContentType}
+	}
+	return []string{JSONContentType}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	apiservertesting "k8s.io/kubernetes/pkg/apiserver/testing"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/emicklei/go-restful"
+)
+
+// fakeProtobufCodec stands in for a protobuf codec by prefixing the JSON
+// encoding of objects, or fails to encode them.
+type fakeProtobufCodec struct {
+	runtime.Codec
+	fail bool
+}
+
+func (c fakeProtobufCodec) Encode(obj runtime.Object) ([]byte, error) {
+	if c.fail {
+		return nil, errors.New("does not implement protobuf marshalling")
+	}
+	data, err := c.Codec.Encode(obj)
+	return append([]byte("protobuf:"), data...), err
+}
+
+// TestNegotiateContentType verifies the content types negotiated from Accept
+// headers, with and without a protobuf codec.
+func TestNegotiateContentType(t *testing.T) {
+	testCases := []struct {
+		accept             string
+		defaultContentType string
+		protobuf           bool
+		expected           string
+	}{
+		{"", "", true, JSONContentType},
+		{"", ProtobufContentType, true, ProtobufContentType},
+		{"", ProtobufContentType, false, JSONContentType},
+		{"*/*", ProtobufContentType, true, ProtobufContentType},
+		{"application/*", "", true, JSONContentType},
+		{"application/json", ProtobufContentType, true, JSONContentType},
+		{"application/json;as=Table", "", true, JSONContentType},
+		{"application/vnd.kubernetes.protobuf", "", true, ProtobufContentType},
+		{"application/vnd.kubernetes.protobuf, application/json", "", true, ProtobufContentType},
+		{"application/vnd.kubernetes.protobuf, application/json", "", false, JSONContentType},
+		{"application/json;q=0.5, application/vnd.kubernetes.protobuf", "", true, ProtobufContentType},
+		{"application/vnd.kubernetes.protobuf;q=0.5, application/json", "", true, JSONContentType},
+		{"application/vnd.kubernetes.protobuf, */*;q=0.1", "", false, JSONContentType},
+		{"application/vnd.kubernetes.protobuf", "", false, JSONContentType},
+		{"application/json;q=0", "", true, JSONContentType},
+		{"text/html", "", true, JSONContentType},
+		{"text/html", ProtobufContentType, true, JSONContentType},
+	}
+	for _, testCase := range testCases {
+		contentType := negotiateContentType(testCase.accept, testCase.defaultContentType, testCase.protobuf)
+		if contentType != testCase.expected {
+			t.Errorf("%q (default %q, protobuf %v): expected %q, got %q", testCase.accept, testCase.defaultContentType, testCase.protobuf, testCase.expected, contentType)
+		}
+	}
+}
+
+// TestWriteNegotiated verifies the content types of the responses of a group
+// version, for the clients that ask for protobuf, JSON or something else. The
+// clients that accept none of the content types get JSON.
+func TestWriteNegotiated(t *testing.T) {
+	testCases := []struct {
+		protobufCodec runtime.Codec
+		accept        string
+		status        int
+		contentType   string
+	}{
+		{nil, "", http.StatusOK, JSONContentType},
+		{nil, "application/vnd.kubernetes.protobuf, application/json", http.StatusOK, JSONContentType},
+		{nil, "application/vnd.kubernetes.protobuf", http.StatusOK, JSONContentType},
+		{fakeProtobufCodec{Codec: codec}, "", http.StatusOK, JSONContentType},
+		{fakeProtobufCodec{Codec: codec}, "application/json", http.StatusOK, JSONContentType},
+		{fakeProtobufCodec{Codec: codec}, "application/vnd.kubernetes.protobuf", http.StatusOK, ProtobufContentType},
+		{fakeProtobufCodec{Codec: codec}, "application/vnd.kubernetes.protobuf, application/json", http.StatusOK, ProtobufContentType},
+		{fakeProtobufCodec{Codec: codec}, "text/html", http.StatusOK, JSONContentType},
+		{fakeProtobufCodec{Codec: codec, fail: true}, "application/vnd.kubernetes.protobuf, application/json", http.StatusOK, JSONContentType},
+		{fakeProtobufCodec{Codec: codec, fail: true}, "application/vnd.kubernetes.protobuf", http.StatusOK, JSONContentType},
+	}
+	for i, testCase := range testCases {
+		container := restful.NewContainer()
+		container.Router(JSONFallbackRouter(restful.CurlyRouter{}))
+		group := APIGroupVersion{
+			Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{item: apiservertesting.Simple{Other: "foo"}}},
+			Root:                "/" + prefix,
+			GroupVersion:        testGroupVersion,
+			RequestInfoResolver: newTestRequestInfoResolver(),
+
+			Creater:   api.Scheme,
+			Convertor: api.Scheme,
+			Typer:     api.Scheme,
+			Codec:     codec,
+			Linker:    selfLinker,
+			Mapper:    namespaceMapper,
+
+			OptionsExternalVersion: &testGroupVersion,
+			Context:                requestContextMapper,
+
+			ProtobufCodec: testCase.protobufCodec,
+		}
+		if err := group.InstallREST(container); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server := httptest.NewServer(container.ServeMux)
+
+		req, err := http.NewRequest("GET", server.URL+"/"+prefix+"/"+testGroupVersion.Group+"/"+testGroupVersion.Version+"/namespaces/default/simple/id", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req.Header.Set("Accept", testCase.accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		server.Close()
+
+		if resp.StatusCode != testCase.status {
+			t.Errorf("%d: expected status %d, got %d: %s", i, testCase.status, resp.StatusCode, body)
+		}
+		if contentType := resp.Header.Get("Content-Type"); len(testCase.contentType) > 0 && contentType != testCase.contentType {
+			t.Errorf("%d: expected content type %q, got %q", i, testCase.contentType, contentType)
+		}
+		if isProtobuf := strings.HasPrefix(string(body), "protobuf:"); isProtobuf != (testCase.contentType == ProtobufContentType) {
+			t.Errorf("%d: unexpected body for %s: %s", i, testCase.contentType, body)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// PartialObjectMetadataListContentType is the content type of the lists that only
+// have the metadata of their objects.
+const PartialObjectMetadataListContentType = "application/json;as=PartialObjectMetadataList"
+
+// PartialObjectMetadata is the metadata of an object, without the rest of it.
+type PartialObjectMetadata struct {
+	unversioned.TypeMeta `json:",inline"`
+	api.ObjectMeta       `json:"metadata,omitempty"`
+}
+
+// PartialObjectMetadataList is a list with only the metadata of its objects. It is
+// served to the list requests whose Accept header asks for
+// PartialObjectMetadataListContentType, e.g. by the clients that only read the
+// names and labels of the objects, so that the objects aren't encoded whole.
+type PartialObjectMetadataList struct {
+	unversioned.TypeMeta `json:",inline"`
+	PartialListMeta      `json:"metadata,omitempty"`
+	Items                []PartialObjectMetadata `json:"items"`
+}
+
+// PartialListMeta is the metadata of a PartialObjectMetadataList.
+type PartialListMeta struct {
+	unversioned.ListMeta `json:",inline"`
+
+	// Continue is the continue token of the next page, if the list is a page of a
+	// paginated list that has more objects.
+	Continue string `json:"continue,omitempty"`
+}
+
+// pagedList is implemented by the lists that are a page of a paginated list.
+type pagedList interface {
+	// NextPage returns the continue token of the next page, or an empty string on
+	// the last page.
+	NextPage() string
+}
+
+// acceptsPartialObjectMetadataList returns true if an Accept header lists
+// PartialObjectMetadataListContentType.
+func acceptsPartialObjectMetadataList(accept string) bool {
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(value))
+		if err == nil && mediaType == JSONContentType && params["as"] == "PartialObjectMetadataList" {
+			return true
+		}
+	}
+	return false
+}
+
+// newPartialObjectMetadataList returns the metadata of list and of its items, of
+// the group version groupVersion.
+func newPartialObjectMetadataList(list runtime.Object, groupVersion unversioned.GroupVersion) (*PartialObjectMetadataList, error) {
+	listMeta, err := api.ListMetaFor(list)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	partial := &PartialObjectMetadataList{
+		TypeMeta:        unversioned.TypeMeta{Kind: "PartialObjectMetadataList", APIVersion: groupVersion.String()},
+		PartialListMeta: PartialListMeta{ListMeta: *listMeta},
+		Items:           make([]PartialObjectMetadata, 0, len(items)),
+	}
+	if paged, ok := list.(pagedList); ok {
+		partial.Continue = paged.NextPage()
+	}
+	for _, item := range items {
+		objectMeta, err := api.ObjectMetaFor(item)
+		if err != nil {
+			return nil, err
+		}
+		partial.Items = append(partial.Items, PartialObjectMetadata{
+			TypeMeta:   unversioned.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: groupVersion.String()},
+			ObjectMeta: *objectMeta,
+		})
+	}
+	return partial, nil
+}
+
+// writePartialObjectMetadataList writes the PartialObjectMetadataList of list to w.
+func writePartialObjectMetadataList(list runtime.Object, scope RequestScope, w http.ResponseWriter) {
+	partial, err := newPartialObjectMetadataList(list, scope.Kind.GroupVersion())
+	if err != nil {
+		errorJSON(apierrors.NewInternalError(err), scope.Codec, w)
+		return
+	}
+	data, err := json.Marshal(partial)
+	if err != nil {
+		errorJSON(apierrors.NewInternalError(err), scope.Codec, w)
+		return
+	}
+	w.Header().Set("Content-Type", PartialObjectMetadataListContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
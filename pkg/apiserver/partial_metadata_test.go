@@ -0,0 +1,110 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	apiservertesting "k8s.io/kubernetes/pkg/apiserver/testing"
+)
+
+// TestAcceptsPartialObjectMetadataList verifies the Accept headers that ask for
+// the metadata of the objects of lists.
+func TestAcceptsPartialObjectMetadataList(t *testing.T) {
+	testCases := map[string]bool{
+		"":                          false,
+		"application/json":          false,
+		"application/json;as=Table": false,
+		"application/json;as=PartialObjectMetadataList":                                      true,
+		"application/json; as=PartialObjectMetadataList":                                     true,
+		"application/vnd.kubernetes.protobuf, application/json;as=PartialObjectMetadataList": true,
+		"text/plain;as=PartialObjectMetadataList":                                            false,
+	}
+	for accept, expected := range testCases {
+		if accepts := acceptsPartialObjectMetadataList(accept); accepts != expected {
+			t.Errorf("%q: expected %v, got %v", accept, expected, accepts)
+		}
+	}
+}
+
+// TestListPartialObjectMetadata verifies that the lists asked for with
+// PartialObjectMetadataListContentType only have the metadata of their objects.
+func TestListPartialObjectMetadata(t *testing.T) {
+	storage := map[string]rest.Storage{}
+	simpleStorage := SimpleRESTStorage{
+		list: []apiservertesting.Simple{
+			{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "other", Labels: map[string]string{"a": "b"}}, Other: "secret"},
+			{ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "other"}, Other: "secret"},
+		},
+	}
+	storage["simple"] = &simpleStorage
+	server := httptest.NewServer(handle(storage))
+	defer server.Close()
+
+	req, err := http.NewRequest("GET", server.URL+"/"+prefix+"/"+testGroupVersion.Group+"/"+testGroupVersion.Version+"/simple", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("Accept", PartialObjectMetadataListContentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != PartialObjectMetadataListContentType {
+		t.Errorf("expected content type %q, got %q", PartialObjectMetadataListContentType, contentType)
+	}
+	if strings.Contains(string(body), "secret") {
+		t.Errorf("expected only the metadata of the objects, got %s", body)
+	}
+
+	list := PartialObjectMetadataList{}
+	if err := json.Unmarshal(body, &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if list.Kind != "PartialObjectMetadataList" || list.APIVersion != testGroupVersion.String() {
+		t.Errorf("unexpected type of the list: %#v", list.TypeMeta)
+	}
+	names := []string{}
+	for _, item := range list.Items {
+		if item.Kind != "PartialObjectMetadata" || item.APIVersion != testGroupVersion.String() {
+			t.Errorf("unexpected type of %s: %#v", item.Name, item.TypeMeta)
+		}
+		names = append(names, item.Name)
+	}
+	if expected := []string{"foo", "bar"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected items %v, got %v", expected, names)
+	}
+	if len(list.Items) > 0 && !reflect.DeepEqual(list.Items[0].Labels, map[string]string{"a": "b"}) {
+		t.Errorf("unexpected labels: %v", list.Items[0].Labels)
+	}
+}
@@ -47,6 +47,7 @@ type ProxyHandler struct {
 	codec               runtime.Codec
 	context             api.RequestContextMapper
 	requestInfoResolver *RequestInfoResolver
+	requestMetrics      *metrics.RequestMetrics
 }
 
 func (r *ProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
@@ -56,7 +57,7 @@ func (r *ProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	var apiResource string
 	var httpCode int
 	reqStart := time.Now()
-	defer metrics.Monitor(&verb, &apiResource, util.GetClient(req), &httpCode, reqStart)
+	defer r.requestMetrics.Monitor(&verb, &apiResource, util.GetClient(req), &httpCode, reqStart)
 
 	requestInfo, err := r.requestInfoResolver.GetRequestInfo(req)
 	if err != nil || !requestInfo.IsResourceRequest {
@@ -23,6 +23,8 @@ import (
 	"net/http"
 	"net/url"
 	gpath "path"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,10 +34,12 @@ import (
 	"k8s.io/kubernetes/pkg/api/meta"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/conversion"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/emicklei/go-restful"
 	"github.com/evanphx/json-patch"
@@ -76,6 +80,16 @@ type RequestScope struct {
 	Resource    unversioned.GroupVersionResource
 	Kind        unversioned.GroupVersionKind
 	Subresource string
+
+	// StrictDecoding rejects the objects created or updated with fields their
+	// versioned type doesn't have.
+	StrictDecoding bool
+
+	// ProtobufCodec, if set, encodes the responses of the clients that ask for
+	// ProtobufContentType. DefaultContentType is the content type of the responses
+	// of the clients that don't prefer one.
+	ProtobufCodec      runtime.Codec
+	DefaultContentType string
 }
 
 // getterFunc performs a get request with the given context and object name. The request
@@ -86,8 +100,10 @@ type getterFunc func(ctx api.Context, name string, req *restful.Request) (runtim
 const MaxPatchConflicts = 5
 
 // getResourceHandler is an HTTP handler function for get requests. It delegates to the
-// passed-in getterFunc to perform the actual get.
-func getResourceHandler(scope RequestScope, getter getterFunc) restful.RouteFunction {
+// passed-in getterFunc to perform the actual get. If the request has export=true,
+// the cluster-specific fields of the object are stripped, with the help of storage
+// if it is a rest.Exporter.
+func getResourceHandler(scope RequestScope, storage interface{}, getter getterFunc) restful.RouteFunction {
 	return func(req *restful.Request, res *restful.Response) {
 		w := res.ResponseWriter
 		namespace, name, err := scope.Namer.Name(req)
@@ -98,22 +114,33 @@ func getResourceHandler(scope RequestScope, getter getterFunc) restful.RouteFunc
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
 
+		export, err := isExport(req.Request)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
+
 		result, err := getter(ctx, name, req)
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
 		}
-		if err := setSelfLink(result, req, scope.Namer); err != nil {
+		if export {
+			err = exportObject(result, storage)
+		} else {
+			err = setSelfLink(result, req, scope.Namer)
+		}
+		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
 		}
-		write(http.StatusOK, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
 // GetResource returns a function that handles retrieving a single resource from a rest.Storage object.
 func GetResource(r rest.Getter, scope RequestScope) restful.RouteFunction {
-	return getResourceHandler(scope,
+	return getResourceHandler(scope, r,
 		func(ctx api.Context, name string, req *restful.Request) (runtime.Object, error) {
 			// For performance tracking purposes.
 			trace := util.NewTrace("Get " + req.Request.URL.Path)
@@ -124,7 +151,7 @@ func GetResource(r rest.Getter, scope RequestScope) restful.RouteFunction {
 
 // GetResourceWithOptions returns a function that handles retrieving a single resource from a rest.Storage object.
 func GetResourceWithOptions(r rest.GetterWithOptions, scope RequestScope, internalKind, externalKind unversioned.GroupVersionKind, subpath bool, subpathKey string) restful.RouteFunction {
-	return getResourceHandler(scope,
+	return getResourceHandler(scope, r,
 		func(ctx api.Context, name string, req *restful.Request) (runtime.Object, error) {
 			opts, err := getRequestOptions(req, scope, internalKind, externalKind, subpath, subpathKey)
 			if err != nil {
@@ -212,7 +239,7 @@ type responder struct {
 }
 
 func (r *responder) Object(statusCode int, obj runtime.Object) {
-	write(statusCode, r.scope.Kind.GroupVersion(), r.scope.Codec, obj, r.w, r.req)
+	writeNegotiated(statusCode, r.scope, obj, r.w, r.req)
 }
 
 func (r *responder) Error(err error) {
@@ -318,7 +345,12 @@ func ListResource(r rest.Lister, rw rest.Watcher, scope RequestScope, forceWatch
 			return
 		}
 		trace.Step("Self-linking done")
-		write(http.StatusOK, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		if acceptsPartialObjectMetadataList(req.Request.Header.Get("Accept")) {
+			writePartialObjectMetadataList(result, scope, w)
+			trace.Step(fmt.Sprintf("Writing http response done (%d items metadata)", numberOfItems))
+			return
+		}
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 		trace.Step(fmt.Sprintf("Writing http response done (%d items)", numberOfItems))
 	}
 }
@@ -350,6 +382,15 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		var storage interface{} = r
+		if adapter, ok := r.(*namedCreaterAdapter); ok {
+			storage = adapter.Creater
+		}
+		ctx, err = withDryRun(ctx, req, storage)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -367,10 +408,17 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		}
 		trace.Step("Conversion done")
 
+		if scope.StrictDecoding {
+			if err := checkUnknownFields(scope, body, obj); err != nil {
+				errorJSON(err, scope.Codec, w)
+				return
+			}
+		}
+
 		if admit != nil && admit.Handles(admission.Create) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Create, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, admission.NewAttributesRecord(obj, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Create, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -397,10 +445,35 @@ func createHandler(r rest.NamedCreater, scope RequestScope, typer runtime.Object
 		}
 		trace.Step("Self-link added")
 
-		write(http.StatusCreated, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusCreated, scope, result, w, req.Request)
+	}
+}
+
+// withDryRun marks ctx as a dry run if the request asks for it with ?dryRun=All.
+// Storage that does not implement rest.DryRunner cannot serve such requests.
+func withDryRun(ctx api.Context, req *restful.Request, storage interface{}) (api.Context, error) {
+	switch dryRun := req.Request.URL.Query().Get("dryRun"); dryRun {
+	case "":
+		return ctx, nil
+	case "All":
+		if dryRunner, ok := storage.(rest.DryRunner); !ok || !dryRunner.SupportsDryRun() {
+			return nil, errors.NewBadRequest("dry run is not supported for this resource")
+		}
+		return api.WithDryRun(ctx), nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid dryRun value %q, only \"All\" is supported", dryRun))
 	}
 }
 
+// admissionAttributes returns attributes marked as a dry run if the request on ctx
+// is one, so that admission has no side effects for it.
+func admissionAttributes(ctx api.Context, attributes admission.Attributes) admission.Attributes {
+	if api.IsDryRun(ctx) {
+		return admission.WithDryRun(attributes)
+	}
+	return attributes
+}
+
 // CreateNamedResource returns a function that will handle a resource creation with name.
 func CreateNamedResource(r rest.NamedCreater, scope RequestScope, typer runtime.ObjectTyper, admit admission.Interface) restful.RouteFunction {
 	return createHandler(r, scope, typer, admit, true)
@@ -436,19 +509,17 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			return
 		}
 
-		obj := r.New()
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
 
-		// PATCH requires same permission as UPDATE
-		if admit.Handles(admission.Update) {
-			userInfo, _ := api.UserFrom(ctx)
-
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Update, userInfo))
-			if err != nil {
-				errorJSON(err, scope.Codec, w)
-				return
+		// PATCH requires same permission as UPDATE, and is admitted with the patched
+		// object every time it is about to be stored, see patchResource.
+		admitUpdate := func(updatedObject runtime.Object) error {
+			if !admit.Handles(admission.Update) {
+				return nil
 			}
+			userInfo, _ := api.UserFrom(ctx)
+			return admit.Admit(admission.NewAttributesRecord(updatedObject, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Update, userInfo))
 		}
 
 		versionedObj, err := converter.ConvertToVersion(r.New(), scope.Kind.GroupVersion().String())
@@ -470,7 +541,7 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			return
 		}
 
-		result, err := patchResource(ctx, timeout, versionedObj, r, name, patchType, patchJS, scope.Namer, scope.Codec)
+		result, err := patchResource(ctx, admitUpdate, timeout, versionedObj, r, name, patchType, patchJS, scope.Namer, scope.Codec)
 		if err != nil {
 			errorJSON(err, scope.Codec, w)
 			return
@@ -481,13 +552,24 @@ func PatchResource(r rest.Patcher, scope RequestScope, typer runtime.ObjectTyper
 			return
 		}
 
-		write(http.StatusOK, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 
 }
 
+// updateAdmissionFunc admits the update of an object to updatedObject.
+type updateAdmissionFunc func(updatedObject runtime.Object) error
+
 // patchResource divides PatchResource for easier unit testing
-func patchResource(ctx api.Context, timeout time.Duration, versionedObj runtime.Object, patcher rest.Patcher, name string, patchType api.PatchType, patchJS []byte, namer ScopeNamer, codec runtime.Codec) (runtime.Object, error) {
+//
+// The patched object is admitted before each attempt to store it. When an attempt
+// conflicts, the patch is applied again to the current object, which is admitted
+// again, so a patch is admitted up to MaxPatchConflicts+1 times, each time with
+// the object that is then stored. The admission plugins thus see the object they
+// admit last as the one stored, and the mutations they make are applied to it, but
+// they must tolerate being called more than once per request. A refusal fails the
+// patch, whichever attempt it comes at.
+func patchResource(ctx api.Context, admit updateAdmissionFunc, timeout time.Duration, versionedObj runtime.Object, patcher rest.Patcher, name string, patchType api.PatchType, patchJS []byte, namer ScopeNamer, codec runtime.Codec) (runtime.Object, error) {
 	namespace := api.NamespaceValue(ctx)
 
 	original, err := patcher.Get(ctx, name)
@@ -514,6 +596,9 @@ func patchResource(ctx api.Context, timeout time.Duration, versionedObj runtime.
 
 	return finishRequest(timeout, func() (runtime.Object, error) {
 		// update should never create as previous get would fail
+		if err := admit(objToUpdate); err != nil {
+			return nil, err
+		}
 		updateObject, _, updateErr := patcher.Update(ctx, objToUpdate)
 		for i := 0; i < MaxPatchConflicts && (errors.IsConflict(updateErr)); i++ {
 
@@ -566,6 +651,9 @@ func patchResource(ctx api.Context, timeout time.Duration, versionedObj runtime.
 			if err := runtime.DecodeInto(codec, newlyPatchedObjJS, objToUpdate); err != nil {
 				return nil, err
 			}
+			if err := admit(objToUpdate); err != nil {
+				return nil, err
+			}
 
 			updateObject, _, updateErr = patcher.Update(ctx, objToUpdate)
 		}
@@ -593,6 +681,11 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		}
 		ctx := scope.ContextFunc(req)
 		ctx = api.WithNamespace(ctx, namespace)
+		ctx, err = withDryRun(ctx, req, r)
+		if err != nil {
+			errorJSON(err, scope.Codec, w)
+			return
+		}
 
 		body, err := readBody(req.Request)
 		if err != nil {
@@ -614,10 +707,17 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 			return
 		}
 
+		if scope.StrictDecoding {
+			if err := checkUnknownFields(scope, body, obj); err != nil {
+				errorJSON(err, scope.Codec, w)
+				return
+			}
+		}
+
 		if admit != nil && admit.Handles(admission.Update) {
 			userInfo, _ := api.UserFrom(ctx)
 
-			err = admit.Admit(admission.NewAttributesRecord(obj, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Update, userInfo))
+			err = admit.Admit(admissionAttributes(ctx, admission.NewAttributesRecord(obj, scope.Kind.GroupKind(), namespace, name, scope.Resource.GroupResource(), scope.Subresource, admission.Update, userInfo)))
 			if err != nil {
 				errorJSON(err, scope.Codec, w)
 				return
@@ -647,7 +747,7 @@ func UpdateResource(r rest.Updater, scope RequestScope, typer runtime.ObjectType
 		if wasCreated {
 			status = http.StatusCreated
 		}
-		writeJSON(status, scope.Codec, result, w, isPrettyPrint(req.Request))
+		writeNegotiated(status, scope, result, w, req.Request)
 	}
 }
 
@@ -726,7 +826,7 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, scope RequestScope,
 				}
 			}
 		}
-		write(http.StatusOK, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
@@ -819,7 +919,7 @@ func DeleteCollection(r rest.CollectionDeleter, checkBody bool, scope RequestSco
 				}
 			}
 		}
-		write(http.StatusOK, scope.Kind.GroupVersion(), scope.Codec, result, w, req.Request)
+		writeNegotiated(http.StatusOK, scope, result, w, req.Request)
 	}
 }
 
@@ -875,6 +975,52 @@ func transformDecodeError(typer runtime.ObjectTyper, baseErr error, into runtime
 	return errors.NewBadRequest(fmt.Sprintf("the object provided is unrecognized (must be of type %s): %v", objectGroupVersionKind.Kind, baseErr))
 }
 
+// isExport returns true if req asks for its object to be exported.
+func isExport(req *http.Request) (bool, error) {
+	value := req.URL.Query().Get("export")
+	if len(value) == 0 {
+		return false, nil
+	}
+	export, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequest(fmt.Sprintf("invalid export value %q: %v", value, err))
+	}
+	return export, nil
+}
+
+// exportObject strips the fields of obj that are specific to where it is stored:
+// its namespace, the fields of its object metadata set by the server, its name if
+// it was generated, its status, and the fields stripped by storage if it is a
+// rest.Exporter. The exported object can be created as is in any namespace of
+// another cluster.
+func exportObject(obj runtime.Object, storage interface{}) error {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("unable to export %T: %v", obj, err))
+	}
+	objectMeta.Namespace = ""
+	if len(objectMeta.GenerateName) > 0 {
+		objectMeta.Name = ""
+	}
+	objectMeta.UID = ""
+	objectMeta.ResourceVersion = ""
+	objectMeta.SelfLink = ""
+	objectMeta.Generation = 0
+	objectMeta.CreationTimestamp = unversioned.Time{}
+	objectMeta.DeletionTimestamp = nil
+	objectMeta.DeletionGracePeriodSeconds = nil
+
+	if v, err := conversion.EnforcePtr(obj); err == nil && v.Kind() == reflect.Struct {
+		if status := v.FieldByName("Status"); status.IsValid() && status.CanSet() {
+			status.Set(reflect.Zero(status.Type()))
+		}
+	}
+	if exporter, ok := storage.(rest.Exporter); ok {
+		return exporter.Export(obj)
+	}
+	return nil
+}
+
 // setSelfLink sets the self link of an object (or the child items in a list) to the base URL of the request
 // plus the path and query generated by the provided linkFunc
 func setSelfLink(obj runtime.Object, req *restful.Request, namer ScopeNamer) error {
@@ -913,6 +1059,30 @@ func checkName(obj runtime.Object, name, namespace string, namer ScopeNamer) err
 	return nil
 }
 
+// checkUnknownFields returns an invalid error, listing the fields of the object in
+// body that its versioned type doesn't have, if there are any. obj is the object
+// decoded from body.
+func checkUnknownFields(scope RequestScope, body []byte, obj runtime.Object) error {
+	versioned, err := scope.Creater.New(scope.Kind)
+	if err != nil {
+		return err
+	}
+	unknown, err := runtime.UnknownFields(body, versioned)
+	if err != nil {
+		return errors.NewBadRequest(err.Error())
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	errs := field.ErrorList{}
+	for _, path := range unknown {
+		name := path[strings.LastIndexAny(path, ".[")+1:]
+		errs = append(errs, &field.Error{Type: field.ErrorTypeInvalid, Field: path, BadValue: strings.TrimSuffix(name, "]"), Detail: "unknown field"})
+	}
+	_, name, _ := scope.Namer.ObjectName(obj)
+	return errors.NewInvalid(scope.Kind.Kind, name, errs)
+}
+
 // setListSelfLink sets the self link of a list to the base URL, then sets the self links
 // on all child objects returned. Returns the number of items in the list.
 func setListSelfLink(obj runtime.Object, req *restful.Request, namer ScopeNamer) (int, error) {
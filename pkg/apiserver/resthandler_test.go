@@ -162,6 +162,7 @@ func (tc *patchTestCase) Run(t *testing.T) {
 	ctx = api.WithNamespace(ctx, namespace)
 
 	namer := &testNamer{namespace, name}
+	admit := func(updatedObject runtime.Object) error { return nil }
 
 	versionedObj, err := api.Scheme.ConvertToVersion(&api.Pod{}, "v1")
 	if err != nil {
@@ -208,7 +209,7 @@ func (tc *patchTestCase) Run(t *testing.T) {
 
 		}
 
-		resultObj, err := patchResource(ctx, 1*time.Second, versionedObj, testPatcher, name, patchType, patch, namer, codec)
+		resultObj, err := patchResource(ctx, admit, 1*time.Second, versionedObj, testPatcher, name, patchType, patch, namer, codec)
 		if len(tc.expectedError) != 0 {
 			if err == nil || err.Error() != tc.expectedError {
 				t.Errorf("%s: expected error %v, but got %v", tc.name, tc.expectedError, err)
@@ -329,3 +330,50 @@ func TestPatchResourceWithConflict(t *testing.T) {
 
 	tc.Run(t)
 }
+
+// TestPatchResourceAdmitsEachAttempt verifies that a patch retried after a
+// conflict is admitted again with the object patched on the current one, and
+// that a refusal at the retry fails the patch.
+func TestPatchResourceAdmitsEachAttempt(t *testing.T) {
+	codec := latest.GroupOrDie(api.GroupName).Codec
+	versionedObj, err := api.Scheme.ConvertToVersion(&api.Pod{}, "v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	startingPod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar", ResourceVersion: "1"}}
+	updatePod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar", ResourceVersion: "2"}, Spec: api.PodSpec{NodeName: "anywhere"}}
+	patch := []byte(`{"metadata":{"labels":{"patched":"true"}}}`)
+	ctx := api.WithNamespace(api.NewDefaultContext(), "bar")
+
+	for _, refuseRetry := range []bool{false, true} {
+		admitted := []*api.Pod{}
+		admit := func(updatedObject runtime.Object) error {
+			pod := *updatedObject.(*api.Pod)
+			admitted = append(admitted, &pod)
+			if refuseRetry && len(admitted) > 1 {
+				return apierrors.NewForbidden("pods", "foo", errors.New("refused"))
+			}
+			return nil
+		}
+		patcher := &testPatcher{startingPod: startingPod, updatePod: updatePod}
+		result, err := patchResource(ctx, admit, time.Second, versionedObj, patcher, "foo", api.StrategicMergePatchType, patch, &testNamer{"bar", "foo"}, codec)
+
+		if len(admitted) != 2 {
+			t.Fatalf("refuse retry %v: expected 2 admissions, got %d", refuseRetry, len(admitted))
+		}
+		if admitted[0].ResourceVersion != "1" || admitted[1].ResourceVersion != "2" || admitted[1].Spec.NodeName != "anywhere" || admitted[1].Labels["patched"] != "true" {
+			t.Errorf("refuse retry %v: unexpected admitted objects: %#v, %#v", refuseRetry, admitted[0], admitted[1])
+		}
+		if refuseRetry {
+			if !apierrors.IsForbidden(err) || result != nil {
+				t.Errorf("expected the patch to be refused, got %v, %v", result, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if pod := result.(*api.Pod); pod.ResourceVersion != "2" || pod.Labels["patched"] != "true" {
+			t.Errorf("unexpected result: %#v", pod.ObjectMeta)
+		}
+	}
+}
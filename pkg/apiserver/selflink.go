@@ -0,0 +1,60 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// selfLinkPrefixNamer is a ScopeNamer that rebases the self links it sets onto
+// a prefix, e.g. the URL a gateway that rewrites paths exposes the API at.
+type selfLinkPrefixNamer struct {
+	ScopeNamer
+	prefix *url.URL
+}
+
+// withSelfLinkPrefix returns namer, with its self links rebased onto prefix if
+// prefix is not empty. prefix is either a path, like /k8s, or a URL, like
+// https://gateway.example.com/k8s, whose scheme and host replace the ones of
+// the request.
+func withSelfLinkPrefix(namer ScopeNamer, prefix string) (ScopeNamer, error) {
+	if len(prefix) == 0 {
+		return namer, nil
+	}
+	u, err := url.Parse(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid self link prefix %q: %v", prefix, err)
+	}
+	return selfLinkPrefixNamer{namer, u}, nil
+}
+
+func (n selfLinkPrefixNamer) SetSelfLink(obj runtime.Object, link string) error {
+	u, err := url.Parse(link)
+	if err != nil {
+		return n.ScopeNamer.SetSelfLink(obj, link)
+	}
+	if len(n.prefix.Host) > 0 {
+		u.Scheme = n.prefix.Scheme
+		u.Host = n.prefix.Host
+	}
+	u.Path = strings.TrimSuffix(n.prefix.Path, "/") + u.Path
+	return n.ScopeNamer.SetSelfLink(obj, u.String())
+}
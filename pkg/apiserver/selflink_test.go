@@ -0,0 +1,116 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	apiservertesting "k8s.io/kubernetes/pkg/apiserver/testing"
+
+	"github.com/emicklei/go-restful"
+)
+
+// TestSelfLinkPrefix verifies that the self links set by the handlers of a group
+// version are rebased onto its self link prefix, which may be a path or a URL.
+func TestSelfLinkPrefix(t *testing.T) {
+	itemPath := "/" + prefix + "/" + testGroupVersion.Group + "/" + testGroupVersion.Version + "/namespaces/default/simple/id"
+	testCases := []struct {
+		selfLinkPrefix string
+		expected       string
+	}{
+		{"", itemPath},
+		{"/gateway", "/gateway" + itemPath},
+		{"/gateway/", "/gateway" + itemPath},
+		{"https://gateway.example.com/k8s", "https://gateway.example.com/k8s" + itemPath},
+		{"https://gateway.example.com", "https://gateway.example.com" + itemPath},
+	}
+	for _, testCase := range testCases {
+		selfLinker := &setTestSelfLinker{
+			t:           t,
+			expectedSet: testCase.expected,
+			name:        "id",
+			namespace:   "default",
+		}
+		container := restful.NewContainer()
+		container.Router(restful.CurlyRouter{})
+		group := APIGroupVersion{
+			Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{item: apiservertesting.Simple{Other: "foo"}}},
+			Root:                "/" + prefix,
+			GroupVersion:        testGroupVersion,
+			RequestInfoResolver: newTestRequestInfoResolver(),
+
+			Creater:   api.Scheme,
+			Convertor: api.Scheme,
+			Typer:     api.Scheme,
+			Codec:     codec,
+			Linker:    selfLinker,
+			Mapper:    namespaceMapper,
+
+			OptionsExternalVersion: &testGroupVersion,
+			Context:                requestContextMapper,
+
+			SelfLinkPrefix: testCase.selfLinkPrefix,
+		}
+		if err := group.InstallREST(container); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server := httptest.NewServer(container.ServeMux)
+		resp, err := http.Get(server.URL + itemPath)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		server.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%q: unexpected status %d", testCase.selfLinkPrefix, resp.StatusCode)
+		}
+		if !selfLinker.called {
+			t.Errorf("%q: never set self link", testCase.selfLinkPrefix)
+		}
+	}
+}
+
+// TestInvalidSelfLinkPrefix verifies that a group version with a self link prefix
+// that is not a URL fails to install.
+func TestInvalidSelfLinkPrefix(t *testing.T) {
+	group := APIGroupVersion{
+		Storage:             map[string]rest.Storage{"simple": &SimpleRESTStorage{}},
+		Root:                "/" + prefix,
+		GroupVersion:        testGroupVersion,
+		RequestInfoResolver: newTestRequestInfoResolver(),
+
+		Creater:   api.Scheme,
+		Convertor: api.Scheme,
+		Typer:     api.Scheme,
+		Codec:     codec,
+		Linker:    selfLinker,
+		Mapper:    namespaceMapper,
+
+		OptionsExternalVersion: &testGroupVersion,
+		Context:                requestContextMapper,
+
+		SelfLinkPrefix: "http://[gateway",
+	}
+	if err := group.InstallREST(restful.NewContainer()); err == nil {
+		t.Errorf("expected an error installing a group version with an invalid self link prefix")
+	}
+}
@@ -23,7 +23,9 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/golang/glog"
 	"k8s.io/kubernetes/pkg/probe"
 	"k8s.io/kubernetes/pkg/util"
 )
@@ -41,6 +43,12 @@ type Server struct {
 	Path        string
 	EnableHTTPS bool
 	Validate    ValidatorFn
+	// Retries is the number of times a failed check is retried before the
+	// server is reported unhealthy.
+	Retries int
+	// RetryBackoff is the delay before the first retry, it doubles with every
+	// subsequent retry.
+	RetryBackoff time.Duration
 }
 
 type ServerStatus struct {
@@ -51,8 +59,23 @@ type ServerStatus struct {
 	Err        string       `json:"err,omitempty"`
 }
 
-// TODO: can this use pkg/probe/http
+// DoServerCheck checks the health of the server, retrying failed checks up to
+// server.Retries times with exponential backoff.
 func (server *Server) DoServerCheck(rt http.RoundTripper) (probe.Result, string, error) {
+	backoff := server.RetryBackoff
+	for retry := 0; ; retry++ {
+		result, data, err := server.doServerCheck(rt)
+		if result == probe.Success || retry >= server.Retries {
+			return result, data, err
+		}
+		glog.V(4).Infof("Health check of %s failed, retrying in %v: %v", net.JoinHostPort(server.Addr, strconv.Itoa(server.Port)), backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// TODO: can this use pkg/probe/http
+func (server *Server) doServerCheck(rt http.RoundTripper) (probe.Result, string, error) {
 	var client *http.Client
 	scheme := "http://"
 	if server.EnableHTTPS {
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/probe"
 )
@@ -93,3 +94,48 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+type flakyRoundTripper struct {
+	failures int
+	calls    int
+}
+
+func (f *flakyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString("ok")),
+		StatusCode: http.StatusOK,
+	}, nil
+}
+
+func TestValidateRetries(t *testing.T) {
+	tests := []struct {
+		retries        int
+		failures       int
+		expectedStatus probe.Result
+		expectedCalls  int
+	}{
+		{0, 0, probe.Success, 1},
+		{0, 1, probe.Unknown, 1},
+		{2, 2, probe.Success, 3},
+		{2, 5, probe.Unknown, 3},
+	}
+
+	for i, test := range tests {
+		s := Server{Addr: "foo.com", Port: 8080, Path: "/healthz", Retries: test.retries, RetryBackoff: time.Millisecond}
+		rt := &flakyRoundTripper{failures: test.failures}
+		status, _, err := s.DoServerCheck(rt)
+		if status != test.expectedStatus {
+			t.Errorf("%d: expected %s, got %s", i, test.expectedStatus, status)
+		}
+		if (status == probe.Success) != (err == nil) {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+		if rt.calls != test.expectedCalls {
+			t.Errorf("%d: expected %d calls, got %d", i, test.expectedCalls, rt.calls)
+		}
+	}
+}
@@ -86,8 +86,11 @@ type WatchServer struct {
 	t        timeoutFactory
 }
 
-// HandleWS implements a websocket handler.
+// HandleWS implements a websocket handler. Every event is sent as a text frame
+// of its JSON encoding. The connection is closed with a close frame once the
+// watch ends, or the client closes it.
 func (w *WatchServer) HandleWS(ws *websocket.Conn) {
+	defer ws.Close()
 	done := make(chan struct{})
 	go func() {
 		var unused interface{}
@@ -16,6 +16,14 @@ limitations under the License.
 
 package user
 
+const (
+	// Anonymous is the name of the user of the requests that don't authenticate.
+	Anonymous = "system:anonymous"
+	// AllUnauthenticated is the group of the users of the requests that don't
+	// authenticate.
+	AllUnauthenticated = "system:unauthenticated"
+)
+
 // Info describes a user that has been authenticated to the system.
 type Info interface {
 	// GetName returns the name that uniquely identifies this user among all
@@ -60,12 +60,19 @@ func NamedCheck(name string, check func(r *http.Request) error) HealthzChecker {
 
 // InstallHandler registers a handler for health checking on the path "/healthz" to mux.
 func InstallHandler(mux mux, checks ...HealthzChecker) {
+	InstallPathHandler(mux, "/healthz", checks...)
+}
+
+// InstallPathHandler registers a handler for health checking on the given path to mux,
+// and a handler for every check on path/<check name>. This allows checks with different
+// purposes, e.g. readiness and liveness, to be served separately.
+func InstallPathHandler(mux mux, path string, checks ...HealthzChecker) {
 	if len(checks) == 0 {
 		checks = []HealthzChecker{PingHealthz}
 	}
-	mux.Handle("/healthz", handleRootHealthz(checks...))
+	mux.Handle(path, handleRootHealthz(checks...))
 	for _, check := range checks {
-		mux.Handle(fmt.Sprintf("/healthz/%v", check.Name()), adaptCheckToHandler(check.Check))
+		mux.Handle(fmt.Sprintf("%s/%v", path, check.Name()), adaptCheckToHandler(check.Check))
 	}
 }
 
@@ -41,6 +41,32 @@ func TestInstallHandler(t *testing.T) {
 	}
 }
 
+func TestInstallPathHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	InstallPathHandler(mux, "/readyz", NamedCheck("bad", func(_ *http.Request) error {
+		return errors.New("not ready")
+	}))
+	tests := []struct {
+		path           string
+		expectedStatus int
+	}{
+		{"/readyz", http.StatusInternalServerError},
+		{"/readyz/bad", http.StatusInternalServerError},
+		{"/healthz", http.StatusNotFound},
+	}
+	for i, test := range tests {
+		req, err := http.NewRequest("GET", fmt.Sprintf("http://example.com%v", test.path), nil)
+		if err != nil {
+			t.Fatalf("case[%d] Unexpected error: %v", i, err)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != test.expectedStatus {
+			t.Errorf("case[%d] Expected: %v, got: %v", i, test.expectedStatus, w.Code)
+		}
+	}
+}
+
 func TestMulitipleChecks(t *testing.T) {
 	tests := []struct {
 		path             string
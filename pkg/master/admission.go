@@ -0,0 +1,105 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/latest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/emicklei/go-restful"
+)
+
+// admissionPluginsPath is the debug endpoint that serves the names of the
+// admission plugins of the master, when profiling is enabled.
+const admissionPluginsPath = "/debug/admission"
+
+// AdmissionPlugins returns the names of the admission plugins of the master, in
+// the order they admit requests, or nil if its admission control wasn't created
+// from plugins by admission.NewFromPlugins.
+func (m *Master) AdmissionPlugins() []string {
+	return admission.PluginNames(m.admissionControl)
+}
+
+// serveAdmissionPlugins writes the names of the admission plugins of the master
+// as a JSON object, e.g. {"plugins": ["NamespaceLifecycle", "LimitRanger"]}.
+func (m *Master) serveAdmissionPlugins(w http.ResponseWriter, req *http.Request) {
+	plugins := m.AdmissionPlugins()
+	if plugins == nil {
+		plugins = []string{}
+	}
+	data, err := json.Marshal(struct {
+		Plugins []string `json:"plugins"`
+	}{plugins})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", restful.MIME_JSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// webhookPluginName is the name of the admission plugin of the admission webhook.
+const webhookPluginName = "Webhook"
+
+// parseAdmissionPluginResources parses the resources of the admission plugins of
+// Config.AdmissionPluginResources.
+func parseAdmissionPluginResources(pluginResources map[string][]string) (map[string][]unversioned.GroupResource, error) {
+	parsed := map[string][]unversioned.GroupResource{}
+	for name, resources := range pluginResources {
+		if len(resources) == 0 {
+			return nil, fmt.Errorf("admission plugin %q has no resources", name)
+		}
+		for _, resource := range resources {
+			groupResource, err := parseGroupResource(resource)
+			if err != nil {
+				return nil, fmt.Errorf("admission plugin %q: %v", name, err)
+			}
+			parsed[name] = append(parsed[name], groupResource)
+		}
+	}
+	return parsed, nil
+}
+
+// admissionCodec returns the codec the objects of kind are sent to the admission
+// webhook with, the one their clients use, or false if kind isn't served or is
+// Secret, whose data never leaves the apiserver.
+func (m *Master) admissionCodec(kind unversioned.GroupKind) (runtime.Codec, bool) {
+	if kind == api.Kind("Secret") {
+		return nil, false
+	}
+	switch kind.Group {
+	case api.GroupName:
+		return v1.Codec, true
+	case extensions.GroupName:
+		return latest.GroupOrDie(extensions.GroupName).Codec, true
+	}
+	if m.HasThirdPartyResourcePath(makeThirdPartyPath(kind.Group)) {
+		return thirdpartyresourcedata.NewCodec(latest.GroupOrDie(extensions.GroupName).Codec, kind.Kind), true
+	}
+	return nil, false
+}
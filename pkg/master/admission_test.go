@@ -0,0 +1,223 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/admission/webhook"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+	secretetcd "k8s.io/kubernetes/pkg/registry/secret/etcd"
+	serviceaccountetcd "k8s.io/kubernetes/pkg/registry/serviceaccount/etcd"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+	"k8s.io/kubernetes/plugin/pkg/admission/admit"
+	"k8s.io/kubernetes/plugin/pkg/admission/deny"
+
+	"github.com/emicklei/go-restful"
+)
+
+func init() {
+	for _, name := range []string{"MasterTestFirst", "MasterTestSecond"} {
+		admission.RegisterPlugin(name, func(client client.Interface, config io.Reader) (admission.Interface, error) {
+			return admit.NewAlwaysAdmit(), nil
+		})
+	}
+	admission.RegisterPlugin("MasterTestDeny", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return deny.NewAlwaysDeny(), nil
+	})
+}
+
+// TestAdmissionPlugins verifies that the names of the admission plugins of the
+// master are returned in order, and served at the admission debug endpoint only
+// when profiling is enabled.
+func TestAdmissionPlugins(t *testing.T) {
+	for _, profiling := range []bool{false, true} {
+		master, etcdserver, config, assert := setUpForInit(t)
+		config.EnableProfiling = profiling
+
+		master.init(&config)
+		master.admissionControl = admission.NewFromPlugins(nil, []string{"MasterTestSecond", "MasterTestFirst"}, "")
+		assert.Equal([]string{"MasterTestSecond", "MasterTestFirst"}, master.AdmissionPlugins())
+
+		server := httptest.NewServer(master.mux.(*http.ServeMux))
+		resp, err := http.Get(server.URL + admissionPluginsPath)
+		if assert.NoError(err) {
+			if !profiling {
+				resp.Body.Close()
+				assert.Equal(http.StatusNotFound, resp.StatusCode)
+			} else {
+				assert.Equal(http.StatusOK, resp.StatusCode)
+				plugins := struct {
+					Plugins []string `json:"plugins"`
+				}{}
+				assert.NoError(decodeResponse(resp, &plugins))
+				assert.Equal([]string{"MasterTestSecond", "MasterTestFirst"}, plugins.Plugins)
+			}
+		}
+		server.Close()
+
+		master.admissionControl = admit.NewAlwaysAdmit()
+		assert.Nil(master.AdmissionPlugins())
+		etcdserver.Terminate(t)
+	}
+}
+
+// TestAdmissionWebhook verifies that the admission webhook is sent the creations
+// of core and third party objects, encoded like their clients send them, and
+// that it can deny them or patch them before they are stored. It is never sent
+// the objects of Secrets.
+func TestAdmissionWebhook(t *testing.T) {
+	requests := []webhook.Request{}
+	hook := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		request := webhook.Request{}
+		if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		requests = append(requests, request)
+		if len(request.Object) == 0 {
+			w.Write([]byte(`{"allowed":true}`))
+			return
+		}
+		object := api.ObjectMeta{}
+		if err := json.Unmarshal(request.Object, &struct {
+			Metadata *api.ObjectMeta `json:"metadata"`
+		}{&object}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if strings.HasPrefix(object.Name, "denied") {
+			w.Write([]byte(`{"allowed":false,"reason":"denied by test"}`))
+			return
+		}
+		w.Write([]byte(`{"allowed":true,"patch":[{"op":"add","path":"/metadata/labels","value":{"admitted":"true"}}]}`))
+	}))
+	defer hook.Close()
+
+	master, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: hook.TLS.Certificates[0].Certificate[0]})
+	hookAdmission, err := webhook.NewWebhook(webhook.Config{URL: hook.URL, CAData: caData}, master.admissionCodec)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	master.admissionControl = admission.AppendPlugin(nil, "Webhook", hookAdmission)
+	master.apiPrefix = "/api"
+	master.requestContextMapper = api.NewRequestContextMapper()
+	master.storage = map[string]rest.Storage{
+		"secrets":         secretetcd.NewREST(config.StorageDestinations.Get("", "secrets"), config.storageDecorator("", "secrets", nil)),
+		"serviceAccounts": serviceaccountetcd.NewREST(config.StorageDestinations.Get("", "serviceAccounts"), config.storageDecorator("", "serviceaccounts", nil)),
+	}
+	master.handlerContainer = restful.NewContainer()
+	if !assert.NoError(master.api_v1().InstallREST(master.handlerContainer)) {
+		t.FailNow()
+	}
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	})) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	for _, testCase := range []struct {
+		path, kind, apiVersion, group string
+	}{
+		{"/api/v1/namespaces/default/serviceaccounts", "ServiceAccount", "v1", ""},
+		{"/apis/company.com/v1/namespaces/default/foos", "Foo", "company.com/v1", "company.com"},
+	} {
+		requests = requests[:0]
+		for _, name := range []string{"admitted", "denied"} {
+			resp, err := http.Post(server.URL+testCase.path, "application/json", strings.NewReader(`{"kind":"`+testCase.kind+`","apiVersion":"`+testCase.apiVersion+`","metadata":{"name":"`+name+`"}}`))
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			object := api.ObjectMeta{}
+			decodeResponse(resp, &struct {
+				Metadata *api.ObjectMeta `json:"metadata"`
+			}{&object})
+			if name == "denied" {
+				assert.Equal(http.StatusForbidden, resp.StatusCode, testCase.path)
+				continue
+			}
+			assert.Equal(http.StatusCreated, resp.StatusCode, testCase.path)
+			assert.Equal(map[string]string{"admitted": "true"}, object.Labels, testCase.path)
+		}
+		if assert.Len(requests, 2, testCase.path) {
+			assert.Equal(admission.Create, requests[0].Operation)
+			assert.Equal(testCase.group, requests[0].Group)
+			assert.Equal(testCase.kind, requests[0].Kind)
+			assert.Contains(string(requests[0].Object), `"apiVersion":"`+testCase.apiVersion+`"`)
+		}
+	}
+
+	requests = requests[:0]
+	resp, err := http.Post(server.URL+"/api/v1/namespaces/default/secrets", "application/json", strings.NewReader(`{"kind":"Secret","apiVersion":"v1","metadata":{"name":"denied"},"data":{"password":"c2VjcmV0"}}`))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+	if assert.Len(requests, 1) {
+		assert.Equal("Secret", requests[0].Kind)
+		assert.Empty(requests[0].Object)
+	}
+}
+
+// TestAdmissionPluginResources verifies that the admission plugins scoped to some
+// resources only admit the requests for those resources.
+func TestAdmissionPluginResources(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	config.KubeletClient = kubeletclient.FakeKubeletClient{}
+	config.AdmissionControl = admission.NewFromPlugins(nil, []string{"MasterTestFirst", "MasterTestDeny"}, "")
+	config.AdmissionPluginResources = map[string][]string{"MasterTestDeny": {"secrets", "company.com/*"}}
+
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal([]string{"MasterTestFirst", "MasterTestDeny"}, master.AdmissionPlugins())
+	for _, testCase := range []struct {
+		resource unversioned.GroupResource
+		denied   bool
+	}{
+		{unversioned.GroupResource{Resource: "secrets"}, true},
+		{unversioned.GroupResource{Resource: "pods"}, false},
+		{unversioned.GroupResource{Group: extensions.GroupName, Resource: "jobs"}, false},
+		{unversioned.GroupResource{Group: "company.com", Resource: "foos"}, true},
+	} {
+		err := master.admissionControl.Admit(admission.NewAttributesRecord(nil, unversioned.GroupKind{}, "default", "a", testCase.resource, "", admission.Create, nil))
+		assert.Equal(testCase.denied, err != nil, "%v", testCase.resource)
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// anonymousAuthenticator returns an authenticator that identifies the requests
+// auth, if not nil, identifies, and makes the others anonymous: their user is
+// user.Anonymous, in the user.AllUnauthenticated group. The requests auth fails
+// to check, e.g. with invalid credentials, still fail.
+func anonymousAuthenticator(auth authenticator.Request) authenticator.Request {
+	return authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+		if auth != nil {
+			info, ok, err := auth.AuthenticateRequest(req)
+			if err != nil || ok {
+				return info, ok, err
+			}
+		}
+		return &user.DefaultInfo{Name: user.Anonymous, Groups: []string{user.AllUnauthenticated}}, true, nil
+	})
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+)
+
+// TestAnonymousAuth verifies that the requests the authenticator can't identify
+// are rejected, unless anonymous requests are enabled, in which case they are
+// authorized as the anonymous user. Requests with invalid credentials are always
+// rejected.
+func TestAnonymousAuth(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		_, etcdserver, config, assert := setUp(t)
+		config.KubeletClient = kubeletclient.FakeKubeletClient{}
+		lock := sync.Mutex{}
+		var authorized user.Info
+		config.Authenticator = authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+			switch req.Header.Get("Authorization") {
+			case "Bearer alice":
+				return &user.DefaultInfo{Name: "alice"}, true, nil
+			case "Bearer invalid":
+				return nil, false, errors.New("invalid token")
+			}
+			return nil, false, nil
+		})
+		config.Authorizer = authorizer.AuthorizerFunc(func(a authorizer.Attributes) error {
+			lock.Lock()
+			defer lock.Unlock()
+			authorized = &user.DefaultInfo{Name: a.GetUserName(), Groups: a.GetGroups()}
+			return nil
+		})
+		config.AnonymousAuthEnabled = enabled
+
+		master, err := New(&config)
+		if !assert.NoError(err) {
+			etcdserver.Terminate(t)
+			t.FailNow()
+		}
+		server := httptest.NewServer(master.Handler)
+
+		for _, testCase := range []struct {
+			authorization string
+			code          int
+			user          user.Info
+		}{
+			{"Bearer alice", http.StatusOK, &user.DefaultInfo{Name: "alice"}},
+			{"Bearer invalid", http.StatusUnauthorized, nil},
+			{"", http.StatusUnauthorized, nil},
+		} {
+			if enabled && len(testCase.authorization) == 0 {
+				testCase.code = http.StatusOK
+				testCase.user = &user.DefaultInfo{Name: user.Anonymous, Groups: []string{user.AllUnauthenticated}}
+			}
+			lock.Lock()
+			authorized = nil
+			lock.Unlock()
+
+			req, err := http.NewRequest("GET", server.URL+"/api", nil)
+			if !assert.NoError(err) {
+				continue
+			}
+			if len(testCase.authorization) > 0 {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if !assert.NoError(err) {
+				continue
+			}
+			resp.Body.Close()
+			assert.Equal(testCase.code, resp.StatusCode, "anonymous %v, %q", enabled, testCase.authorization)
+			lock.Lock()
+			assert.Equal(testCase.user, authorized, "anonymous %v, %q", enabled, testCase.authorization)
+			lock.Unlock()
+		}
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
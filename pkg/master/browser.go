@@ -0,0 +1,149 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bufio"
+	"html/template"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// faviconPath is the path browsers fetch the icon of a site from.
+const faviconPath = "/favicon.ico"
+
+// serveFavicon serves the master's favicon file, or nothing if it has none.
+func (m *Master) serveFavicon(w http.ResponseWriter, req *http.Request) {
+	if len(m.faviconPath) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	http.ServeFile(w, req, m.faviconPath)
+}
+
+// notFoundPage is the page of the 404 responses to browsers.
+var notFoundPage = template.Must(template.New("notFound").Parse(`<!DOCTYPE html>
+<html>
+<head><title>404 Not Found</title></head>
+<body>
+<h1>Not Found</h1>
+<p>The Kubernetes API server has nothing at {{.Path}}.</p>
+{{if .Index}}<p>The paths it serves are listed at <a href="/">/</a>.</p>
+{{end}}</body>
+</html>
+`))
+
+// withNotFoundPage wraps handler, if UI support is enabled, so that the 404
+// responses to browsers, i.e. the clients that prefer HTML to JSON, are a human
+// readable page rather than a Status. API clients keep getting the Status.
+func (m *Master) withNotFoundPage(handler http.Handler) http.Handler {
+	if !m.enableUISupport {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !prefersHTML(req.Header.Get("Accept")) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		writer := &notFoundPageWriter{delegatingResponseWriter: delegatingResponseWriter{w}}
+		handler.ServeHTTP(writer, req)
+		if !writer.notFound {
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Del("Content-Length")
+		w.WriteHeader(http.StatusNotFound)
+		notFoundPage.Execute(w, struct {
+			Path  string
+			Index bool
+		}{req.URL.Path, m.enableIndex})
+	})
+}
+
+// prefersHTML returns true if the Accept header accept lists HTML with a higher
+// quality than JSON, like the ones of browsers. Wildcards are not taken as HTML.
+func prefersHTML(accept string) bool {
+	htmlQuality, jsonQuality := 0.0, 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case "text/html", "application/xhtml+xml":
+			if quality > htmlQuality {
+				htmlQuality = quality
+			}
+		case "application/json":
+			if quality > jsonQuality {
+				jsonQuality = quality
+			}
+		}
+	}
+	return htmlQuality > 0 && htmlQuality > jsonQuality
+}
+
+// notFoundPageWriter discards the body of a 404 response, which is replaced by
+// the page, and writes the other responses through.
+type notFoundPageWriter struct {
+	delegatingResponseWriter
+	notFound    bool
+	wroteHeader bool
+}
+
+func (w *notFoundPageWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code == http.StatusNotFound {
+		w.notFound = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *notFoundPageWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notFound {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *notFoundPageWriter) Flush() {
+	if w.notFound {
+		return
+	}
+	w.delegatingResponseWriter.Flush()
+}
+
+func (w *notFoundPageWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.wroteHeader = true
+	return w.delegatingResponseWriter.Hijack()
+}
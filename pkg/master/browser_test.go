@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+)
+
+// TestPrefersHTML verifies the Accept headers recognized as the ones of browsers.
+func TestPrefersHTML(t *testing.T) {
+	testCases := map[string]bool{
+		"":                      false,
+		"*/*":                   false,
+		"application/json":      false,
+		"application/json, */*": false,
+		"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8": true,
+		"application/xhtml+xml":             true,
+		"text/html;q=0.5, application/json": false,
+		"text/html, application/json;q=0.5": true,
+		"text/html;q=0":                     false,
+	}
+	for accept, expected := range testCases {
+		if prefersHTML(accept) != expected {
+			t.Errorf("%q: expected %v", accept, expected)
+		}
+	}
+}
+
+// TestServeFavicon verifies that /favicon.ico serves the configured file, or
+// nothing if there is none.
+func TestServeFavicon(t *testing.T) {
+	w := httptest.NewRecorder()
+	(&Master{}).serveFavicon(w, &http.Request{})
+	if w.Code != http.StatusNoContent || w.Body.Len() != 0 {
+		t.Errorf("expected an empty 204, got %d: %s", w.Code, w.Body.String())
+	}
+
+	favicon, err := ioutil.TempFile("", "favicon")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(favicon.Name())
+	favicon.WriteString("icon")
+	favicon.Close()
+	req, _ := http.NewRequest("GET", faviconPath, nil)
+	w = httptest.NewRecorder()
+	(&Master{faviconPath: favicon.Name()}).serveFavicon(w, req)
+	if w.Code != http.StatusOK || w.Body.String() != "icon" {
+		t.Errorf("expected the favicon, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+// TestNotFoundPage verifies that browsers get a page for the paths and objects
+// that are not found, while API clients keep getting a Status, and the other
+// responses are left alone.
+func TestNotFoundPage(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	config.KubeletClient = kubeletclient.FakeKubeletClient{}
+	config.EnableIndex = true
+	config.EnableUISupport = true
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	const browser = "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"
+	testCases := []struct {
+		path        string
+		accept      string
+		code        int
+		contentType string
+		contains    string
+	}{
+		{"/missing<b>", browser, http.StatusNotFound, "text/html", "/missing&lt;b&gt;"},
+		{"/api/v1/namespaces/default/pods/missing", browser, http.StatusNotFound, "text/html", "/api/v1/namespaces/default/pods/missing"},
+		{"/missing", "application/json", http.StatusNotFound, "application/json", `"paths"`},
+		{"/api/v1/namespaces/default/pods/missing", "", http.StatusNotFound, "application/json", `"kind": "Status"`},
+		{"/api", browser, http.StatusOK, "application/json", `"versions"`},
+		{faviconPath, browser, http.StatusNoContent, "", ""},
+	}
+	for _, testCase := range testCases {
+		req, err := http.NewRequest("GET", server.URL+testCase.path, nil)
+		if !assert.NoError(err) {
+			continue
+		}
+		req.Header.Set("Accept", testCase.accept)
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			continue
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(testCase.code, resp.StatusCode, testCase.path)
+		assert.True(strings.HasPrefix(resp.Header.Get("Content-Type"), testCase.contentType), "%s: %s", testCase.path, resp.Header.Get("Content-Type"))
+		assert.Contains(string(body), testCase.contains, testCase.path)
+	}
+}
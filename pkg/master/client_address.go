@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+const (
+	// forwardedForHeader lists the addresses a request was forwarded for, the
+	// client's first, then the ones of the proxies it went through but the last.
+	forwardedForHeader = "X-Forwarded-For"
+	// realIPHeader is the address of the client of a request, as set by a proxy.
+	realIPHeader = "X-Real-IP"
+	// forwardedClientPort is the port of the RemoteAddr of the forwarded requests.
+	forwardedClientPort = "0"
+)
+
+// withForwardedClientAddress wraps handler so that the RemoteAddr of the requests
+// from the trusted proxies is the address of their client, taken from their
+// X-Forwarded-For headers, or their X-Real-IP header if there is none. It keeps
+// the host:port form, with the port 0 since the port of the client isn't
+// forwarded. The headers of the requests from other peers are ignored.
+func (m *Master) withForwardedClientAddress(handler http.Handler) http.Handler {
+	if len(m.trustedProxies) == 0 {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if client := m.forwardedClientAddress(req); client != nil {
+			req.RemoteAddr = net.JoinHostPort(client.String(), forwardedClientPort)
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// forwardedClientAddress returns the address of the client of req if its peer is
+// a trusted proxy that forwarded it, nil otherwise. The addresses of
+// X-Forwarded-For are walked from the peer back to the client, so that the first
+// one that is not a trusted proxy is returned: the ones before it may have been
+// forged by the client. The X-Forwarded-For headers of a request are read as one
+// list, in order, as each proxy may add its own header rather than append to the
+// existing one.
+func (m *Master) forwardedClientAddress(req *http.Request) net.IP {
+	peer := remoteIP(req.RemoteAddr)
+	if peer == nil || !m.isTrustedProxy(peer) {
+		return nil
+	}
+	if forwardedFor := strings.Join(req.Header[forwardedForHeader], ","); len(forwardedFor) > 0 {
+		addresses := strings.Split(forwardedFor, ",")
+		var client net.IP
+		for i := len(addresses) - 1; i >= 0; i-- {
+			client = net.ParseIP(strings.TrimSpace(addresses[i]))
+			if client == nil {
+				return nil
+			}
+			if !m.isTrustedProxy(client) {
+				break
+			}
+		}
+		return client
+	}
+	return net.ParseIP(strings.TrimSpace(req.Header.Get(realIPHeader)))
+}
+
+// isTrustedProxy returns true if ip is in the network of a trusted proxy.
+func (m *Master) isTrustedProxy(ip net.IP) bool {
+	for _, network := range m.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP returns the IP address of the RemoteAddr of a request, which is a
+// host and port, or just an IP address. It returns nil if remoteAddr is neither.
+func remoteIP(remoteAddr string) net.IP {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+	return net.ParseIP(remoteAddr)
+}
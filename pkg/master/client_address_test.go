@@ -0,0 +1,81 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestWithForwardedClientAddress verifies that the client address of a request is
+// taken from its forwarding headers only if its peer is a trusted proxy, and that
+// the addresses forwarded by untrusted peers are ignored.
+func TestWithForwardedClientAddress(t *testing.T) {
+	master := &Master{}
+	for _, cidr := range []string{"10.0.0.0/8", "fd00::/8"} {
+		_, network, _ := net.ParseCIDR(cidr)
+		master.trustedProxies = append(master.trustedProxies, network)
+	}
+	var remoteAddr string
+	handler := master.withForwardedClientAddress(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		remoteAddr = req.RemoteAddr
+	}))
+
+	testCases := []struct {
+		peer         string
+		forwardedFor []string
+		realIP       string
+		expected     string
+	}{
+		// Untrusted peers.
+		{"192.168.1.1:1234", []string{"1.2.3.4"}, "", "192.168.1.1:1234"},
+		{"192.168.1.1:1234", nil, "1.2.3.4", "192.168.1.1:1234"},
+		// Trusted peers.
+		{"10.0.0.1:1234", nil, "", "10.0.0.1:1234"},
+		{"10.0.0.1:1234", []string{"1.2.3.4"}, "", "1.2.3.4:0"},
+		{"10.0.0.1:1234", nil, "1.2.3.4", "1.2.3.4:0"},
+		{"10.0.0.1:1234", []string{"1.2.3.4"}, "5.6.7.8", "1.2.3.4:0"},
+		{"[fd00::1]:1234", []string{"2001:db8::1"}, "", "[2001:db8::1]:0"},
+		// Chains of proxies: the client is the last address that is not trusted.
+		{"10.0.0.1:1234", []string{"1.2.3.4, 10.0.0.2"}, "", "1.2.3.4:0"},
+		{"10.0.0.1:1234", []string{"6.6.6.6, 1.2.3.4, 10.0.0.2"}, "", "1.2.3.4:0"},
+		{"10.0.0.1:1234", []string{"10.0.0.3, 10.0.0.2"}, "", "10.0.0.3:0"},
+		// Chains of proxies that each added a header.
+		{"10.0.0.1:1234", []string{"6.6.6.6", "1.2.3.4", "10.0.0.2"}, "", "1.2.3.4:0"},
+		{"10.0.0.1:1234", []string{"6.6.6.6, 1.2.3.4", "10.0.0.2"}, "", "1.2.3.4:0"},
+		// Invalid addresses.
+		{"10.0.0.1:1234", []string{"unknown"}, "", "10.0.0.1:1234"},
+		{"10.0.0.1:1234", []string{"1.2.3.4", "unknown"}, "", "10.0.0.1:1234"},
+		{"10.0.0.1:1234", nil, "unknown", "10.0.0.1:1234"},
+	}
+	for _, testCase := range testCases {
+		req, _ := http.NewRequest("GET", "/api", nil)
+		req.RemoteAddr = testCase.peer
+		for _, forwardedFor := range testCase.forwardedFor {
+			req.Header.Add(forwardedForHeader, forwardedFor)
+		}
+		if len(testCase.realIP) > 0 {
+			req.Header.Set(realIPHeader, testCase.realIP)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if remoteAddr != testCase.expected {
+			t.Errorf("%s forwarding %q, %q: expected %s, got %s", testCase.peer, testCase.forwardedFor, testCase.realIP, testCase.expected, remoteAddr)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// compressionThreshold is the size in bytes a response body must exceed to be compressed.
+// Compressing smaller bodies costs more CPU than it saves on the wire.
+const compressionThreshold = 1024
+
+// withCompression wraps handler so that response bodies larger than the
+// compression threshold are gzip or deflate encoded for the clients that accept
+// it, if compression is enabled. Long running requests, e.g. watches, are not
+// compressed, so that their events are not held back in a buffer.
+func (m *Master) withCompression(handler http.Handler) http.Handler {
+	if !m.enableCompression {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		encoding := negotiateEncoding(req.Header.Get("Accept-Encoding"))
+		if len(encoding) == 0 || m.isLongRunningRequest(req) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		writer := &compressingResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}, encoding: encoding}
+		defer writer.Close()
+		handler.ServeHTTP(writer, req)
+	})
+}
+
+// negotiateEncoding returns the encoding to compress a response with for the
+// given Accept-Encoding header, gzip or deflate, or "" if the client accepts neither.
+// A "*" coding stands for the codings the header does not list.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		accepted[coding] = true
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			// A quality of 0 means the coding is not acceptable.
+			if q, err := strconv.ParseFloat(param[len("q="):], 64); err == nil && q == 0 {
+				accepted[coding] = false
+			}
+		}
+	}
+	for _, encoding := range []string{"gzip", "deflate"} {
+		acceptable, listed := accepted[encoding]
+		if !listed {
+			acceptable = accepted["*"]
+		}
+		if acceptable {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// compressingResponseWriter buffers the start of a response body, and compresses
+// the body once it outgrows the compression threshold. Smaller bodies are written
+// uncompressed when the writer is closed.
+type compressingResponseWriter struct {
+	delegatingResponseWriter
+	encoding string
+
+	code        int
+	buffer      bytes.Buffer
+	compressor  io.WriteCloser
+	wroteHeader bool
+}
+
+func (w *compressingResponseWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *compressingResponseWriter) Write(data []byte) (int, error) {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	if w.compressor != nil {
+		return w.compressor.Write(data)
+	}
+	if w.wroteHeader {
+		return w.ResponseWriter.Write(data)
+	}
+	w.buffer.Write(data)
+	if w.buffer.Len() <= compressionThreshold {
+		return len(data), nil
+	}
+	if err := w.startCompression(); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// startCompression writes the header of a compressed response, followed by the
+// buffered part of the body. Responses the handler has already encoded are
+// written unchanged.
+func (w *compressingResponseWriter) startCompression() error {
+	header := w.Header()
+	if len(header.Get("Content-Encoding")) > 0 {
+		return w.writeBuffered()
+	}
+	header.Set("Content-Encoding", w.encoding)
+	header.Del("Content-Length")
+	w.ResponseWriter.WriteHeader(w.code)
+	w.wroteHeader = true
+	switch w.encoding {
+	case "gzip":
+		w.compressor = gzip.NewWriter(w.ResponseWriter)
+	default:
+		compressor, err := flate.NewWriter(w.ResponseWriter, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+		w.compressor = compressor
+	}
+	_, err := w.compressor.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
+
+// writeBuffered writes the header and the buffered part of the body uncompressed.
+func (w *compressingResponseWriter) writeBuffered() error {
+	if w.code == 0 {
+		w.code = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+	w.wroteHeader = true
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
+
+// Close flushes the compressor, or writes the response uncompressed if its body
+// did not outgrow the compression threshold.
+func (w *compressingResponseWriter) Close() error {
+	if w.compressor != nil {
+		return w.compressor.Close()
+	}
+	if !w.wroteHeader && w.code != 0 {
+		return w.writeBuffered()
+	}
+	return nil
+}
+
+// Flush writes out what has been written so far. The rest of a response that was
+// not compressed yet is written uncompressed.
+func (w *compressingResponseWriter) Flush() {
+	if w.compressor == nil && !w.wroteHeader {
+		w.writeBuffered()
+	}
+	if flusher, ok := w.compressor.(interface {
+		Flush() error
+	}); ok {
+		flusher.Flush()
+	}
+	w.delegatingResponseWriter.Flush()
+}
+
+func (w *compressingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.wroteHeader = true
+	return w.delegatingResponseWriter.Hijack()
+}
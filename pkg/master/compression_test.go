@@ -0,0 +1,114 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// TestNegotiateEncoding verifies that gzip is preferred over deflate, that
+// encodings with a quality of 0 are not used, and that "*" accepts the encodings
+// not listed.
+func TestNegotiateEncoding(t *testing.T) {
+	testCases := map[string]string{
+		"":                      "",
+		"identity":              "",
+		"gzip":                  "gzip",
+		"deflate, gzip":         "gzip",
+		"deflate":               "deflate",
+		"gzip;q=0, deflate":     "deflate",
+		"GZIP;q=0.5":            "gzip",
+		"gzip; q=0.0, br":       "",
+		"br, deflate;q=0.1, *":  "gzip",
+		"gzip;q=1.0, identity;": "gzip",
+		"*":                     "gzip",
+		"gzip;q=0, *":           "deflate",
+		"*;q=0":                 "",
+		"*;q=0, deflate":        "deflate",
+	}
+	for acceptEncoding, expected := range testCases {
+		if encoding := negotiateEncoding(acceptEncoding); encoding != expected {
+			t.Errorf("%q: expected %q, got %q", acceptEncoding, expected, encoding)
+		}
+	}
+}
+
+// TestCompressThirdPartyList verifies that a large list of third party objects
+// is gzip encoded when the client accepts it, and small responses are not.
+func TestCompressThirdPartyList(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	master.enableCompression = true
+	server := httptest.NewServer(master.withCompression(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("foo%d", i)
+		obj := Foo{
+			ObjectMeta: api.ObjectMeta{Name: name},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo"},
+			SomeField:  "a field long enough to make the list larger than the compression threshold",
+			OtherField: i,
+		}
+		if !assert.NoError(storeThirdPartyObject(master.thirdPartyStorage, "/ThirdPartyResourceData/company.com/foos/default/"+name, name, obj)) {
+			t.FailNow()
+		}
+	}
+
+	req, err := http.NewRequest("GET", server.URL+"/apis/company.com/v1/namespaces/default/foos", nil)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("gzip", resp.Header.Get("Content-Encoding"))
+	reader, err := gzip.NewReader(resp.Body)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	list := FooList{}
+	assert.NoError(json.NewDecoder(reader).Decode(&list))
+	assert.Equal(50, len(list.Items))
+
+	req, err = http.NewRequest("GET", server.URL+"/apis/company.com/v1/namespaces/default/foos/foo1", nil)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err = http.DefaultClient.Do(req)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("", resp.Header.Get("Content-Encoding"))
+}
@@ -0,0 +1,30 @@
+// +build go1.8
+
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import goruntime "runtime"
+
+// mutexProfilingSupported is true if the mutex contention can be profiled.
+const mutexProfilingSupported = true
+
+// setMutexProfileFraction samples 1/rate of the mutex contention events, none if
+// rate is zero, and returns the previous rate.
+func setMutexProfileFraction(rate int) int {
+	return goruntime.SetMutexProfileFraction(rate)
+}
@@ -0,0 +1,28 @@
+// +build !go1.8
+
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+// mutexProfilingSupported is false, as the Go releases before 1.8 can't profile
+// the mutex contention.
+const mutexProfilingSupported = false
+
+// setMutexProfileFraction does nothing, and returns 0.
+func setMutexProfileFraction(rate int) int {
+	return 0
+}
@@ -19,6 +19,7 @@ package master
 import (
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"k8s.io/kubernetes/pkg/api"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/namespace"
 	"k8s.io/kubernetes/pkg/registry/service"
 	servicecontroller "k8s.io/kubernetes/pkg/registry/service/ipallocator/controller"
+	"k8s.io/kubernetes/pkg/registry/service/portallocator"
 	portallocatorcontroller "k8s.io/kubernetes/pkg/registry/service/portallocator/controller"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/intstr"
@@ -36,6 +38,17 @@ import (
 	"github.com/golang/glog"
 )
 
+// EndpointReconciler reconciles the endpoints of the kubernetes service.
+type EndpointReconciler interface {
+	// ReconcileEndpoints sets the endpoints of the service serviceName for the
+	// apiserver at ip, exposing endpointPorts. If reconcilePorts is true, ports
+	// that are not in endpointPorts are removed.
+	ReconcileEndpoints(serviceName string, ip net.IP, endpointPorts []api.EndpointPort, reconcilePorts bool) error
+	// RemoveEndpoints removes the apiserver at ip from the endpoints of the
+	// service serviceName, unless it is the last apiserver they list.
+	RemoveEndpoints(serviceName string, ip net.IP) error
+}
+
 // Controller is the controller manager for the core bootstrap Kubernetes controller
 // loops, which manage creating the "kubernetes" service, the "default"
 // namespace, and provide the IP repair check on service IPs
@@ -48,24 +61,53 @@ type Controller struct {
 	ServiceClusterIPRegistry service.RangeRegistry
 	ServiceClusterIPInterval time.Duration
 	ServiceClusterIPRange    *net.IPNet
+	// ServiceClusterIPReserved are the IPs of ServiceClusterIPRange that are never
+	// allocated to services.
+	ServiceClusterIPReserved []net.IP
 
 	ServiceNodePortRegistry service.RangeRegistry
 	ServiceNodePortInterval time.Duration
 	ServiceNodePortRange    util.PortRange
+	// ServiceNodePortAllocator allocates the node port of the kubernetes service
+	// when it is of type NodePort but KubernetesServiceNodePort is zero.
+	ServiceNodePortAllocator portallocator.Interface
 
 	EndpointRegistry endpoint.Registry
-	EndpointInterval time.Duration
+	// EndpointReconciler, if set, reconciles the endpoints of the kubernetes
+	// service instead of the controller's own ReconcileEndpoints.
+	EndpointReconciler EndpointReconciler
+	// ReconcileInterval is the period at which the kubernetes service and its
+	// endpoints are reconciled. Zero means they are reconciled once, on Start.
+	ReconcileInterval time.Duration
 
 	PublicIP net.IP
 
-	ServiceIP                 net.IP
-	ServicePort               int
+	ServiceIP   net.IP
+	ServicePort int
+	// ExtraServicePorts and ExtraEndpointPorts must not be modified directly
+	// once the controller is started; use SetExtraPorts instead.
 	ExtraServicePorts         []api.ServicePort
 	ExtraEndpointPorts        []api.EndpointPort
 	PublicServicePort         int
 	KubernetesServiceNodePort int
+	// KubernetesServiceType is the type of the kubernetes service. It is NodePort
+	// if KubernetesServiceNodePort is set, and ClusterIP if it is empty.
+	KubernetesServiceType api.ServiceType
+	// SessionAffinity of the kubernetes service. Defaults to api.ServiceAffinityNone.
+	SessionAffinity api.ServiceAffinity
+
+	// extraPortsLock protects ExtraServicePorts and ExtraEndpointPorts.
+	extraPortsLock sync.Mutex
+
+	// readyLock protects ready.
+	readyLock sync.Mutex
+	// ready is closed once the kubernetes service and its endpoints have been
+	// reconciled successfully for the first time.
+	ready chan struct{}
 
 	runner *util.Runner
+	// running counts the loops started by Start that haven't exited yet.
+	running sync.WaitGroup
 }
 
 // Start begins the core controller loops that must exist for bootstrapping
@@ -75,7 +117,7 @@ func (c *Controller) Start() {
 		return
 	}
 
-	repairClusterIPs := servicecontroller.NewRepair(c.ServiceClusterIPInterval, c.ServiceRegistry, c.ServiceClusterIPRange, c.ServiceClusterIPRegistry)
+	repairClusterIPs := servicecontroller.NewRepair(c.ServiceClusterIPInterval, c.ServiceRegistry, c.ServiceClusterIPRange, c.ServiceClusterIPRegistry, c.ServiceClusterIPReserved)
 	repairNodePorts := portallocatorcontroller.NewRepair(c.ServiceNodePortInterval, c.ServiceRegistry, c.ServiceNodePortRange, c.ServiceNodePortRegistry)
 
 	// run all of the controllers once prior to returning from Start.
@@ -88,24 +130,107 @@ func (c *Controller) Start() {
 		glog.Fatalf("Unable to perform initial service nodePort check: %v", err)
 	}
 	// Service definition is reconciled during first run to correct port and type per expectations.
-	if err := c.UpdateKubernetesService(true); err != nil {
+	if err := c.reconcileKubernetesService(); err != nil {
 		glog.Errorf("Unable to perform initial Kubernetes service initialization: %v", err)
 	}
 
-	c.runner = util.NewRunner(c.RunKubernetesService, repairClusterIPs.RunUntil, repairNodePorts.RunUntil)
+	loops := []func(chan struct{}){repairClusterIPs.RunUntil, repairNodePorts.RunUntil}
+	if c.ReconcileInterval > 0 {
+		loops = append(loops, c.RunKubernetesService)
+	}
+	c.run(loops...)
+}
+
+// run runs loops in the background until Stop is called.
+func (c *Controller) run(loops ...func(chan struct{})) {
+	tracked := make([]func(chan struct{}), len(loops))
+	for i := range loops {
+		loop := loops[i]
+		tracked[i] = func(stop chan struct{}) {
+			defer c.running.Done()
+			loop(stop)
+		}
+	}
+	c.running.Add(len(loops))
+	c.runner = util.NewRunner(tracked...)
 	c.runner.Start()
 }
 
+// Stop terminates the loops started by Start, and waits for them to exit, so
+// that they don't write to the registries afterwards, e.g. once the endpoints of
+// the master have been removed. It is safe to call Stop more than once.
+func (c *Controller) Stop() {
+	if c.runner != nil {
+		c.runner.Stop()
+	}
+	c.running.Wait()
+}
+
+// SetExtraPorts replaces the extra ports exposed on the kubernetes service and
+// its endpoints. The change is applied on the next reconcile, and ports no
+// longer listed are removed from the service and its endpoints.
+func (c *Controller) SetExtraPorts(servicePorts []api.ServicePort, endpointPorts []api.EndpointPort) {
+	c.extraPortsLock.Lock()
+	defer c.extraPortsLock.Unlock()
+	c.ExtraServicePorts = servicePorts
+	c.ExtraEndpointPorts = endpointPorts
+}
+
 // RunKubernetesService periodically updates the kubernetes service
 func (c *Controller) RunKubernetesService(ch chan struct{}) {
 	util.Until(func() {
-		// Service definition is not reconciled after first
-		// run, ports and type will be corrected only during
-		// start.
-		if err := c.UpdateKubernetesService(false); err != nil {
+		// Ports and type are reconciled on every run so that changes
+		// made through SetExtraPorts are picked up.
+		if err := c.reconcileKubernetesService(); err != nil {
 			util.HandleError(fmt.Errorf("unable to sync kubernetes service: %v", err))
 		}
-	}, c.EndpointInterval, ch)
+	}, c.ReconcileInterval, ch)
+}
+
+// Ready returns a channel that is closed once the kubernetes service and its
+// endpoints have been reconciled successfully for the first time.
+func (c *Controller) Ready() <-chan struct{} {
+	c.readyLock.Lock()
+	defer c.readyLock.Unlock()
+	return c.readyChan()
+}
+
+// readyChan returns the ready channel, creating it if needed. readyLock must be held.
+func (c *Controller) readyChan() chan struct{} {
+	if c.ready == nil {
+		c.ready = make(chan struct{})
+	}
+	return c.ready
+}
+
+// reconcileKubernetesService reconciles the kubernetes service and its endpoints,
+// and marks the controller ready if it succeeds.
+func (c *Controller) reconcileKubernetesService() error {
+	if err := c.UpdateKubernetesService(true); err != nil {
+		return err
+	}
+	c.readyLock.Lock()
+	defer c.readyLock.Unlock()
+	ready := c.readyChan()
+	select {
+	case <-ready:
+	default:
+		close(ready)
+	}
+	return nil
+}
+
+// kubernetesServicePorts returns the ports and type the kubernetes service and
+// its endpoints are expected to have.
+func (c *Controller) kubernetesServicePorts() ([]api.ServicePort, api.ServiceType, []api.EndpointPort) {
+	c.extraPortsLock.Lock()
+	defer c.extraPortsLock.Unlock()
+	servicePorts, serviceType := createPortAndServiceSpec(c.ServicePort, c.KubernetesServiceNodePort, "https", c.ExtraServicePorts)
+	if c.KubernetesServiceType == api.ServiceTypeNodePort {
+		serviceType = api.ServiceTypeNodePort
+	}
+	endpointPorts := createEndpointPortSpec(c.PublicServicePort, "https", c.ExtraEndpointPorts)
+	return servicePorts, serviceType, endpointPorts
 }
 
 // UpdateKubernetesService attempts to update the default Kube service.
@@ -118,18 +243,35 @@ func (c *Controller) UpdateKubernetesService(reconcile bool) error {
 		return err
 	}
 	if c.ServiceIP != nil {
-		servicePorts, serviceType := createPortAndServiceSpec(c.ServicePort, c.KubernetesServiceNodePort, "https", c.ExtraServicePorts)
+		servicePorts, serviceType, endpointPorts := c.kubernetesServicePorts()
 		if err := c.CreateOrUpdateMasterServiceIfNeeded("kubernetes", c.ServiceIP, servicePorts, serviceType, reconcile); err != nil {
 			return err
 		}
-		endpointPorts := createEndpointPortSpec(c.PublicServicePort, "https", c.ExtraEndpointPorts)
-		if err := c.ReconcileEndpoints("kubernetes", c.PublicIP, endpointPorts, reconcile); err != nil {
+		if err := c.endpointReconciler().ReconcileEndpoints("kubernetes", c.PublicIP, endpointPorts, reconcile); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// endpointReconciler returns the reconciler of the kubernetes service endpoints.
+func (c *Controller) endpointReconciler() EndpointReconciler {
+	if c.EndpointReconciler != nil {
+		return c.EndpointReconciler
+	}
+	return c
+}
+
+// RemoveKubernetesEndpoints removes the apiserver from the endpoints of the
+// kubernetes service, so that clients stop connecting to it before it stops.
+// The endpoints are left untouched if it is the last apiserver they list.
+func (c *Controller) RemoveKubernetesEndpoints() error {
+	if c.ServiceIP == nil {
+		return nil
+	}
+	return c.endpointReconciler().RemoveEndpoints("kubernetes", c.PublicIP)
+}
+
 // CreateNamespaceIfNeeded will create the namespace that contains the master services if it doesn't already exist
 func (c *Controller) CreateNamespaceIfNeeded(ns string) error {
 	ctx := api.NewContext()
@@ -167,6 +309,12 @@ func createPortAndServiceSpec(servicePort int, nodePort int, servicePortName str
 	if extraServicePorts != nil {
 		servicePorts = append(servicePorts, extraServicePorts...)
 	}
+	// Node ports are only valid on NodePort services.
+	for _, port := range extraServicePorts {
+		if port.NodePort > 0 {
+			serviceType = api.ServiceTypeNodePort
+		}
+	}
 	return servicePorts, serviceType
 }
 
@@ -182,6 +330,45 @@ func createEndpointPortSpec(endpointPort int, endpointPortName string, extraEndp
 	return endpointPorts
 }
 
+// assignNodePorts sets the node ports left unset in servicePorts of a NodePort
+// service. The node ports of the ports of existing, if any, are kept, and the
+// others are allocated by ServiceNodePortAllocator, if it is set.
+func (c *Controller) assignNodePorts(servicePorts []api.ServicePort, serviceType api.ServiceType, existing *api.Service) error {
+	if serviceType != api.ServiceTypeNodePort {
+		return nil
+	}
+	for i := range servicePorts {
+		port := &servicePorts[i]
+		if port.NodePort != 0 {
+			continue
+		}
+		if existing != nil {
+			for _, existingPort := range existing.Spec.Ports {
+				if existingPort.Name == port.Name && existingPort.NodePort != 0 {
+					port.NodePort = existingPort.NodePort
+					break
+				}
+			}
+		}
+		if port.NodePort == 0 && c.ServiceNodePortAllocator != nil {
+			nodePort, err := c.ServiceNodePortAllocator.AllocateNext()
+			if err != nil {
+				return fmt.Errorf("unable to allocate a node port for the master service port %q: %v", port.Name, err)
+			}
+			port.NodePort = nodePort
+		}
+	}
+	return nil
+}
+
+// sessionAffinity returns the session affinity of the kubernetes service.
+func (c *Controller) sessionAffinity() api.ServiceAffinity {
+	if len(c.SessionAffinity) == 0 {
+		return api.ServiceAffinityNone
+	}
+	return c.SessionAffinity
+}
+
 // CreateMasterServiceIfNeeded will create the specified service if it
 // doesn't already exist.
 func (c *Controller) CreateOrUpdateMasterServiceIfNeeded(serviceName string, serviceIP net.IP, servicePorts []api.ServicePort, serviceType api.ServiceType, reconcile bool) error {
@@ -189,7 +376,10 @@ func (c *Controller) CreateOrUpdateMasterServiceIfNeeded(serviceName string, ser
 	if s, err := c.ServiceRegistry.GetService(ctx, serviceName); err == nil {
 		// The service already exists.
 		if reconcile {
-			if svc, updated := getMasterServiceUpdateIfNeeded(s, servicePorts, serviceType); updated {
+			if err := c.assignNodePorts(servicePorts, serviceType, s); err != nil {
+				return err
+			}
+			if svc, updated := getMasterServiceUpdateIfNeeded(s, servicePorts, serviceType, c.sessionAffinity()); updated {
 				glog.Warningf("Resetting master service %q to %#v", serviceName, svc)
 				_, err := c.ServiceRegistry.UpdateService(ctx, svc)
 				return err
@@ -208,10 +398,15 @@ func (c *Controller) CreateOrUpdateMasterServiceIfNeeded(serviceName string, ser
 			// maintained by this code, not by the pod selector
 			Selector:        nil,
 			ClusterIP:       serviceIP.String(),
-			SessionAffinity: api.ServiceAffinityNone,
+			SessionAffinity: c.sessionAffinity(),
 			Type:            serviceType,
 		},
 	}
+	// A node port allocated for a service that fails to be created is released by
+	// the node port repair loop.
+	if err := c.assignNodePorts(servicePorts, serviceType, nil); err != nil {
+		return err
+	}
 	if err := rest.BeforeCreate(service.Strategy, ctx, svc); err != nil {
 		return err
 	}
@@ -296,6 +491,34 @@ func (c *Controller) ReconcileEndpoints(serviceName string, ip net.IP, endpointP
 	return c.EndpointRegistry.UpdateEndpoints(ctx, e)
 }
 
+// RemoveEndpoints removes ip from the endpoints of the service serviceName, unless
+// it is their only address, so that the service is never left without endpoints.
+func (c *Controller) RemoveEndpoints(serviceName string, ip net.IP) error {
+	ctx := api.NewDefaultContext()
+	e, err := c.EndpointRegistry.GetEndpoints(ctx, serviceName)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(e.Subsets) != 1 || len(e.Subsets[0].Addresses) < 2 {
+		return nil
+	}
+	addresses := []api.EndpointAddress{}
+	for _, addr := range e.Subsets[0].Addresses {
+		if addr.IP != ip.String() {
+			addresses = append(addresses, addr)
+		}
+	}
+	if len(addresses) == len(e.Subsets[0].Addresses) {
+		return nil
+	}
+	e.Subsets[0].Addresses = addresses
+	glog.Infof("Removing %v from the endpoints of master service %q", ip, serviceName)
+	return c.EndpointRegistry.UpdateEndpoints(ctx, e)
+}
+
 // Determine if the endpoint is in the format ReconcileEndpoints expects.
 //
 // Return values:
@@ -343,23 +566,24 @@ func checkEndpointSubsetFormat(e *api.Endpoints, ip string, ports []api.Endpoint
 // * All apiservers MUST use getMasterServiceUpdateIfNeeded and only
 //     getMasterServiceUpdateIfNeeded to manage service attributes
 // * updateMasterService is called periodically from all apiservers.
-func getMasterServiceUpdateIfNeeded(svc *api.Service, servicePorts []api.ServicePort, serviceType api.ServiceType) (s *api.Service, updated bool) {
+func getMasterServiceUpdateIfNeeded(svc *api.Service, servicePorts []api.ServicePort, serviceType api.ServiceType, sessionAffinity api.ServiceAffinity) (s *api.Service, updated bool) {
 	// Determine if the service is in the format we expect
-	// (servicePorts are present and service type matches)
-	formatCorrect := checkServiceFormat(svc, servicePorts, serviceType)
+	// (servicePorts are present, service type and session affinity match)
+	formatCorrect := checkServiceFormat(svc, servicePorts, serviceType, sessionAffinity)
 	if formatCorrect {
 		return svc, false
 	}
 	svc.Spec.Ports = servicePorts
 	svc.Spec.Type = serviceType
+	svc.Spec.SessionAffinity = sessionAffinity
 	return svc, true
 }
 
 // Determine if the service is in the correct format
-// getMasterServiceUpdateIfNeeded expects (servicePorts are correct
-// and service type matches).
-func checkServiceFormat(s *api.Service, ports []api.ServicePort, serviceType api.ServiceType) (formatCorrect bool) {
-	if s.Spec.Type != serviceType {
+// getMasterServiceUpdateIfNeeded expects (servicePorts are correct,
+// service type and session affinity match).
+func checkServiceFormat(s *api.Service, ports []api.ServicePort, serviceType api.ServiceType, sessionAffinity api.ServiceAffinity) (formatCorrect bool) {
+	if s.Spec.Type != serviceType || s.Spec.SessionAffinity != sessionAffinity {
 		return false
 	}
 	if len(ports) != len(s.Spec.Ports) {
@@ -21,8 +21,10 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/registry/namespace"
 	"k8s.io/kubernetes/pkg/registry/registrytest"
 	"k8s.io/kubernetes/pkg/util/intstr"
 )
@@ -867,3 +869,435 @@ func TestCreateOrUpdateMasterService(t *testing.T) {
 		}
 	}
 }
+
+func TestSetExtraPortsReconcilesService(t *testing.T) {
+	extraPort := api.ServicePort{Name: "extra", Port: 1000, Protocol: "TCP", TargetPort: intstr.FromInt(1000)}
+	master := Controller{
+		MasterCount:       1,
+		ServicePort:       443,
+		PublicServicePort: 6443,
+		ExtraServicePorts: []api.ServicePort{extraPort},
+		ExtraEndpointPorts: []api.EndpointPort{
+			{Name: "extra", Port: 1000, Protocol: "TCP"},
+		},
+	}
+	servicePorts, serviceType, endpointPorts := master.kubernetesServicePorts()
+	if len(servicePorts) != 2 || servicePorts[1] != extraPort {
+		t.Fatalf("unexpected service ports: %v", servicePorts)
+	}
+	if len(endpointPorts) != 2 {
+		t.Fatalf("unexpected endpoint ports: %v", endpointPorts)
+	}
+	registry := &registrytest.ServiceRegistry{
+		Service: &api.Service{
+			ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault, Name: "kubernetes"},
+			Spec: api.ServiceSpec{
+				Ports:           servicePorts,
+				ClusterIP:       "1.2.3.4",
+				SessionAffinity: api.ServiceAffinityNone,
+				Type:            serviceType,
+			},
+		},
+	}
+	master.ServiceRegistry = registry
+
+	// Drop the extra port; the next reconcile must remove it from the service.
+	master.SetExtraPorts(nil, nil)
+	servicePorts, serviceType, endpointPorts = master.kubernetesServicePorts()
+	if err := master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registry.Updates) != 1 {
+		t.Fatalf("unexpected updates: %v", registry.Updates)
+	}
+	expected := []api.ServicePort{{Name: "https", Port: 443, Protocol: "TCP", TargetPort: intstr.FromInt(443)}}
+	if !reflect.DeepEqual(expected, registry.Updates[0].Spec.Ports) {
+		t.Errorf("expected ports:\n%#v\ngot:\n%#v\n", expected, registry.Updates[0].Spec.Ports)
+	}
+	if len(endpointPorts) != 1 {
+		t.Errorf("unexpected endpoint ports: %v", endpointPorts)
+	}
+}
+
+func TestKubernetesServiceSessionAffinity(t *testing.T) {
+	servicePorts := []api.ServicePort{{Name: "https", Port: 443, Protocol: "TCP", TargetPort: intstr.FromInt(443)}}
+	master := Controller{MasterCount: 1, SessionAffinity: api.ServiceAffinityClientIP}
+
+	registry := &registrytest.ServiceRegistry{Err: errors.New("unable to get svc")}
+	master.ServiceRegistry = registry
+	master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, api.ServiceTypeClusterIP, true)
+	if len(registry.List.Items) != 1 || registry.List.Items[0].Spec.SessionAffinity != api.ServiceAffinityClientIP {
+		t.Errorf("unexpected creations: %v", registry.List.Items)
+	}
+
+	// An edit of the session affinity is reverted by the next reconcile.
+	registry = &registrytest.ServiceRegistry{
+		Service: &api.Service{
+			ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault, Name: "kubernetes"},
+			Spec: api.ServiceSpec{
+				Ports:           servicePorts,
+				ClusterIP:       "1.2.3.4",
+				SessionAffinity: api.ServiceAffinityNone,
+				Type:            api.ServiceTypeClusterIP,
+			},
+		},
+	}
+	master.ServiceRegistry = registry
+	if err := master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, api.ServiceTypeClusterIP, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registry.Updates) != 1 || registry.Updates[0].Spec.SessionAffinity != api.ServiceAffinityClientIP {
+		t.Errorf("unexpected updates: %v", registry.Updates)
+	}
+}
+
+// fakePortAllocator allocates node ports sequentially, starting at next.
+type fakePortAllocator struct {
+	next      int
+	allocated []int
+}
+
+func (a *fakePortAllocator) Allocate(port int) error {
+	a.allocated = append(a.allocated, port)
+	return nil
+}
+
+func (a *fakePortAllocator) AllocateNext() (int, error) {
+	port := a.next
+	a.next++
+	a.allocated = append(a.allocated, port)
+	return port, nil
+}
+
+func (a *fakePortAllocator) Release(port int) error {
+	return nil
+}
+
+// TestKubernetesServiceNodePort verifies that a NodePort kubernetes service
+// without a configured node port is allocated one when it is created, and
+// keeps it when it is reconciled.
+func TestKubernetesServiceNodePort(t *testing.T) {
+	allocator := &fakePortAllocator{next: 30001}
+	master := Controller{
+		MasterCount:              1,
+		ServicePort:              443,
+		KubernetesServiceType:    api.ServiceTypeNodePort,
+		ServiceNodePortAllocator: allocator,
+	}
+
+	servicePorts, serviceType, _ := master.kubernetesServicePorts()
+	if serviceType != api.ServiceTypeNodePort {
+		t.Fatalf("expected a NodePort service, got %s", serviceType)
+	}
+	registry := &registrytest.ServiceRegistry{Err: errors.New("unable to get svc")}
+	master.ServiceRegistry = registry
+	master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true)
+	if len(registry.List.Items) != 1 {
+		t.Fatalf("unexpected creations: %v", registry.List.Items)
+	}
+	created := registry.List.Items[0]
+	if created.Spec.Type != api.ServiceTypeNodePort || created.Spec.Ports[0].NodePort != 30001 {
+		t.Errorf("expected a NodePort service with node port 30001, got %#v", created.Spec)
+	}
+
+	// The allocated node port is kept, so reconciling does not update the service.
+	registry = &registrytest.ServiceRegistry{Service: &created}
+	master.ServiceRegistry = registry
+	servicePorts, serviceType, _ = master.kubernetesServicePorts()
+	if err := master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registry.Updates) != 0 {
+		t.Errorf("unexpected updates: %v", registry.Updates)
+	}
+	if !reflect.DeepEqual(allocator.allocated, []int{30001}) {
+		t.Errorf("expected only one node port to be allocated, got %v", allocator.allocated)
+	}
+
+	// A service edited to ClusterIP is reverted to NodePort, with a new node port.
+	edited := created
+	edited.Spec.Type = api.ServiceTypeClusterIP
+	edited.Spec.Ports = []api.ServicePort{{Name: "https", Port: 443, Protocol: "TCP", TargetPort: intstr.FromInt(443)}}
+	registry = &registrytest.ServiceRegistry{Service: &edited}
+	master.ServiceRegistry = registry
+	servicePorts, serviceType, _ = master.kubernetesServicePorts()
+	if err := master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registry.Updates) != 1 || registry.Updates[0].Spec.Type != api.ServiceTypeNodePort || registry.Updates[0].Spec.Ports[0].NodePort != 30002 {
+		t.Errorf("unexpected updates: %v", registry.Updates)
+	}
+}
+
+// TestExtraServicePortsNodePort verifies that the node port of an extra service
+// port makes the kubernetes service a NodePort service, and that the node port
+// is restored by the next reconcile if it is edited.
+func TestExtraServicePortsNodePort(t *testing.T) {
+	extraPort := api.ServicePort{Name: "metrics", Port: 8443, Protocol: "TCP", TargetPort: intstr.FromInt(8443), NodePort: 30443}
+	master := Controller{
+		MasterCount:              1,
+		ServicePort:              443,
+		ExtraServicePorts:        []api.ServicePort{extraPort},
+		ServiceNodePortAllocator: &fakePortAllocator{next: 30001},
+	}
+
+	servicePorts, serviceType, _ := master.kubernetesServicePorts()
+	if serviceType != api.ServiceTypeNodePort {
+		t.Fatalf("expected a NodePort service, got %s", serviceType)
+	}
+	registry := &registrytest.ServiceRegistry{Err: errors.New("unable to get svc")}
+	master.ServiceRegistry = registry
+	master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true)
+	if len(registry.List.Items) != 1 {
+		t.Fatalf("unexpected creations: %v", registry.List.Items)
+	}
+	expected := []api.ServicePort{
+		{Name: "https", Port: 443, Protocol: "TCP", TargetPort: intstr.FromInt(443), NodePort: 30001},
+		extraPort,
+	}
+	created := registry.List.Items[0]
+	if !reflect.DeepEqual(expected, created.Spec.Ports) {
+		t.Errorf("expected ports:\n%#v\ngot:\n%#v\n", expected, created.Spec.Ports)
+	}
+	if !reflect.DeepEqual(master.ExtraServicePorts, []api.ServicePort{extraPort}) {
+		t.Errorf("the extra service ports were modified: %#v", master.ExtraServicePorts)
+	}
+
+	edited := created
+	edited.Spec.Ports = []api.ServicePort{expected[0], extraPort}
+	edited.Spec.Ports[1].NodePort = 30444
+	registry = &registrytest.ServiceRegistry{Service: &edited}
+	master.ServiceRegistry = registry
+	servicePorts, serviceType, _ = master.kubernetesServicePorts()
+	if err := master.CreateOrUpdateMasterServiceIfNeeded("kubernetes", net.ParseIP("1.2.3.4"), servicePorts, serviceType, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(registry.Updates) != 1 || !reflect.DeepEqual(expected, registry.Updates[0].Spec.Ports) {
+		t.Errorf("unexpected updates: %v", registry.Updates)
+	}
+}
+
+// fakeEndpointReconciler records the IPs it is asked to reconcile the endpoints
+// for, and to remove from them.
+type fakeEndpointReconciler struct {
+	ips     []string
+	removed []string
+}
+
+func (r *fakeEndpointReconciler) ReconcileEndpoints(serviceName string, ip net.IP, endpointPorts []api.EndpointPort, reconcilePorts bool) error {
+	r.ips = append(r.ips, ip.String())
+	return nil
+}
+
+func (r *fakeEndpointReconciler) RemoveEndpoints(serviceName string, ip net.IP) error {
+	r.removed = append(r.removed, ip.String())
+	return nil
+}
+
+// TestEndpointReconcilerOverride verifies that an EndpointReconciler replaces the
+// default reconciliation of the kubernetes service endpoints.
+func TestEndpointReconcilerOverride(t *testing.T) {
+	reconciler := &fakeEndpointReconciler{}
+	endpointRegistry := &registrytest.EndpointRegistry{}
+	master := Controller{
+		MasterCount:       1,
+		ServiceIP:         net.ParseIP("10.0.0.1"),
+		ServicePort:       443,
+		PublicServicePort: 6443,
+		PublicIP:          net.ParseIP("1.2.3.4"),
+		NamespaceRegistry: &fakeNamespaceRegistry{},
+		ServiceRegistry: &registrytest.ServiceRegistry{
+			Service: &api.Service{
+				ObjectMeta: api.ObjectMeta{Namespace: api.NamespaceDefault, Name: "kubernetes"},
+				Spec: api.ServiceSpec{
+					Ports:           []api.ServicePort{{Name: "https", Port: 443, Protocol: "TCP", TargetPort: intstr.FromInt(443)}},
+					ClusterIP:       "10.0.0.1",
+					SessionAffinity: api.ServiceAffinityNone,
+					Type:            api.ServiceTypeClusterIP,
+				},
+			},
+		},
+		EndpointRegistry:   endpointRegistry,
+		EndpointReconciler: reconciler,
+	}
+	if err := master.UpdateKubernetesService(true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(reconciler.ips, []string{"1.2.3.4"}) {
+		t.Errorf("expected the endpoints to be reconciled by the override, got %v", reconciler.ips)
+	}
+	if endpointRegistry.Updates != nil {
+		t.Errorf("unexpected endpoints updates: %v", endpointRegistry.Updates)
+	}
+}
+
+// TestRemoveEndpoints verifies that an apiserver is removed from the endpoints
+// of the kubernetes service, unless it is the last one they list.
+func TestRemoveEndpoints(t *testing.T) {
+	ns := api.NamespaceDefault
+	om := func(name string) api.ObjectMeta {
+		return api.ObjectMeta{Namespace: ns, Name: name}
+	}
+	ports := []api.EndpointPort{{Name: "foo", Port: 8080, Protocol: "TCP"}}
+	testCases := []struct {
+		testName     string
+		ip           string
+		endpoints    *api.EndpointsList
+		expectUpdate *api.Endpoints // nil means none expected
+	}{
+		{
+			testName: "one of several masters",
+			ip:       "1.2.3.4",
+			endpoints: &api.EndpointsList{
+				Items: []api.Endpoints{{
+					ObjectMeta: om("foo"),
+					Subsets: []api.EndpointSubset{{
+						Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}, {IP: "4.3.2.1"}, {IP: "5.6.7.8"}},
+						Ports:     ports,
+					}},
+				}},
+			},
+			expectUpdate: &api.Endpoints{
+				ObjectMeta: om("foo"),
+				Subsets: []api.EndpointSubset{{
+					Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}, {IP: "5.6.7.8"}},
+					Ports:     ports,
+				}},
+			},
+		},
+		{
+			testName: "last master",
+			ip:       "1.2.3.4",
+			endpoints: &api.EndpointsList{
+				Items: []api.Endpoints{{
+					ObjectMeta: om("foo"),
+					Subsets: []api.EndpointSubset{{
+						Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+						Ports:     ports,
+					}},
+				}},
+			},
+		},
+		{
+			testName: "master not listed",
+			ip:       "1.2.3.4",
+			endpoints: &api.EndpointsList{
+				Items: []api.Endpoints{{
+					ObjectMeta: om("foo"),
+					Subsets: []api.EndpointSubset{{
+						Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}, {IP: "5.6.7.8"}},
+						Ports:     ports,
+					}},
+				}},
+			},
+		},
+		{
+			testName: "no endpoints",
+			ip:       "1.2.3.4",
+		},
+	}
+	for _, test := range testCases {
+		registry := &registrytest.EndpointRegistry{Endpoints: test.endpoints}
+		master := Controller{EndpointRegistry: registry}
+		if err := master.RemoveEndpoints("foo", net.ParseIP(test.ip)); err != nil {
+			t.Errorf("case %q: unexpected error: %v", test.testName, err)
+		}
+		if test.expectUpdate == nil {
+			if len(registry.Updates) != 0 {
+				t.Errorf("case %q: no update expected, got %v", test.testName, registry.Updates)
+			}
+			continue
+		}
+		if len(registry.Updates) != 1 {
+			t.Errorf("case %q: unexpected updates: %v", test.testName, registry.Updates)
+		} else if e, a := test.expectUpdate, &registry.Updates[0]; !reflect.DeepEqual(e, a) {
+			t.Errorf("case %q: expected update:\n%#v\ngot:\n%#v\n", test.testName, e, a)
+		}
+	}
+}
+
+// TestRemoveKubernetesEndpoints verifies that the apiserver is removed from the
+// kubernetes service endpoints by the configured EndpointReconciler.
+func TestRemoveKubernetesEndpoints(t *testing.T) {
+	reconciler := &fakeEndpointReconciler{}
+	master := Controller{
+		ServiceIP:          net.ParseIP("10.0.0.1"),
+		PublicIP:           net.ParseIP("1.2.3.4"),
+		EndpointReconciler: reconciler,
+	}
+	if err := master.RemoveKubernetesEndpoints(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(reconciler.removed, []string{"1.2.3.4"}) {
+		t.Errorf("expected 1.2.3.4 to be removed, got %v", reconciler.removed)
+	}
+}
+
+// fakeNamespaceRegistry serves GetNamespace and CreateNamespace, failing with err if it is set.
+type fakeNamespaceRegistry struct {
+	namespace.Registry
+	err error
+}
+
+func (r *fakeNamespaceRegistry) GetNamespace(ctx api.Context, name string) (*api.Namespace, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &api.Namespace{ObjectMeta: api.ObjectMeta{Name: name}}, nil
+}
+
+func (r *fakeNamespaceRegistry) CreateNamespace(ctx api.Context, namespace *api.Namespace) error {
+	return r.err
+}
+
+func TestReadyAfterFirstReconcile(t *testing.T) {
+	registry := &fakeNamespaceRegistry{err: errors.New("etcd is down")}
+	controller := &Controller{NamespaceRegistry: registry}
+	master := &Master{bootstrapController: controller}
+
+	if err := controller.reconcileKubernetesService(); err == nil {
+		t.Fatalf("expected an error")
+	}
+	select {
+	case <-controller.Ready():
+		t.Fatalf("controller should not be ready after a failed reconcile")
+	default:
+	}
+	if err := master.IsBootstrapControllerReady(nil); err == nil {
+		t.Errorf("master should not be ready after a failed reconcile")
+	}
+
+	registry.err = nil
+	for i := 0; i < 2; i++ {
+		if err := controller.reconcileKubernetesService(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	select {
+	case <-controller.Ready():
+	default:
+		t.Fatalf("controller should be ready after a successful reconcile")
+	}
+	if err := master.IsBootstrapControllerReady(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestStopWaitsForLoops verifies that Stop returns once the loops of the
+// controller have exited, and can be called again.
+func TestStopWaitsForLoops(t *testing.T) {
+	controller := &Controller{}
+	exited := make(chan struct{})
+	controller.run(func(stop chan struct{}) {
+		<-stop
+		time.Sleep(10 * time.Millisecond)
+		close(exited)
+	})
+	controller.Stop()
+	select {
+	case <-exited:
+	default:
+		t.Errorf("expected the loop to have exited")
+	}
+	controller.Stop()
+}
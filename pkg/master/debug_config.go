@@ -0,0 +1,194 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+)
+
+// configPath is the debug endpoint that serves the effective configuration of
+// the master.
+const configPath = "/debug/config"
+
+// EffectiveConfig is a view of the configuration a master runs with, after its
+// defaults are applied. It leaves out secrets and TLS material, like the TLS
+// configuration of the proxy transport, and the authenticator and authorizer,
+// which may hold some.
+type EffectiveConfig struct {
+	EnableCoreControllers bool   `json:"enableCoreControllers"`
+	EnableLogsSupport     bool   `json:"enableLogsSupport"`
+	EnableUISupport       bool   `json:"enableUISupport"`
+	EnableSwaggerSupport  bool   `json:"enableSwaggerSupport"`
+	EnableOpenAPISupport  bool   `json:"enableOpenAPISupport"`
+	EnableIndex           bool   `json:"enableIndex"`
+	EnableProfiling       bool   `json:"enableProfiling"`
+	EnableWatchCache      bool   `json:"enableWatchCache"`
+	APIPrefix             string `json:"apiPrefix"`
+	APIGroupPrefix        string `json:"apiGroupPrefix"`
+	// The group versions served, including the third party ones.
+	APIGroupVersions      []string          `json:"apiGroupVersions"`
+	StorageVersions       map[string]string `json:"storageVersions"`
+	FeatureGates          map[string]bool   `json:"featureGates,omitempty"`
+	AdmissionPlugins      []string          `json:"admissionPlugins,omitempty"`
+	CorsAllowedOriginList []string          `json:"corsAllowedOriginList,omitempty"`
+	TrustedProxyCIDRs     []string          `json:"trustedProxyCIDRs,omitempty"`
+	DisallowedMethods     []string          `json:"disallowedMethods,omitempty"`
+
+	MasterCount           int      `json:"masterCount"`
+	ExternalHost          string   `json:"externalHost"`
+	PublicAddress         string   `json:"publicAddress"`
+	ReadWritePort         int      `json:"readWritePort"`
+	ServiceReadWriteIP    string   `json:"serviceReadWriteIP"`
+	ServiceReadWritePort  int      `json:"serviceReadWritePort"`
+	ServiceClusterIPRange string   `json:"serviceClusterIPRange"`
+	ReservedServiceIPs    []string `json:"reservedServiceIPs,omitempty"`
+	ServiceNodePortRange  string   `json:"serviceNodePortRange"`
+	KubernetesServiceType string   `json:"kubernetesServiceType"`
+	ReconcileInterval     string   `json:"reconcileInterval"`
+
+	MaxRequestBodyBytes int64  `json:"maxRequestBodyBytes"`
+	MinRequestTimeout   string `json:"minRequestTimeout"`
+	MaxRequestTimeout   string `json:"maxRequestTimeout"`
+	MaxReadOnlyInflight int    `json:"maxReadOnlyInflight"`
+	MaxMutatingInflight int    `json:"maxMutatingInflight"`
+	MaxInflightWatches  int    `json:"maxInflightWatches"`
+	ShutdownDelay       string `json:"shutdownDelay"`
+
+	WatchCacheResources     []string          `json:"watchCacheResources,omitempty"`
+	DefaultWatchCacheSize   int               `json:"defaultWatchCacheSize"`
+	WatchCacheSizes         map[string]int    `json:"watchCacheSizes,omitempty"`
+	WatchCacheResyncPeriod  string            `json:"watchCacheResyncPeriod"`
+	WatchCacheResyncPeriods map[string]string `json:"watchCacheResyncPeriods,omitempty"`
+	// The storage rate limits of the groups that have one, the legacy group
+	// being "".
+	StorageRateLimits     map[string]EffectiveStorageRateLimit `json:"storageRateLimits,omitempty"`
+	NamespaceObjectLimits map[string]int                       `json:"namespaceObjectLimits,omitempty"`
+}
+
+// EffectiveStorageRateLimit is a StorageRateLimit in an EffectiveConfig.
+type EffectiveStorageRateLimit struct {
+	QPS     float32 `json:"qps"`
+	Burst   int     `json:"burst"`
+	MaxWait string  `json:"maxWait"`
+}
+
+// currentExternalHost returns the external host of the master, which can be
+// changed by RefreshExternalAddress.
+func (m *Master) currentExternalHost() string {
+	m.swaggerLock.RLock()
+	defer m.swaggerLock.RUnlock()
+	return m.externalHost
+}
+
+// EffectiveConfig returns the configuration the master runs with.
+func (m *Master) EffectiveConfig() EffectiveConfig {
+	config := EffectiveConfig{
+		EnableCoreControllers: m.enableCoreControllers,
+		EnableLogsSupport:     m.enableLogsSupport,
+		EnableUISupport:       m.enableUISupport,
+		EnableSwaggerSupport:  m.enableSwaggerSupport,
+		EnableOpenAPISupport:  m.enableOpenAPISupport,
+		EnableIndex:           m.enableIndex,
+		EnableProfiling:       m.enableProfiling,
+		EnableWatchCache:      m.enableWatchCache,
+		APIPrefix:             m.apiPrefix,
+		APIGroupPrefix:        m.apiGroupPrefix,
+		APIGroupVersions:      []string{},
+		StorageVersions:       map[string]string{},
+		FeatureGates:          m.featureGates,
+		AdmissionPlugins:      m.AdmissionPlugins(),
+		CorsAllowedOriginList: m.corsAllowedOriginList,
+
+		MasterCount:           m.masterCount,
+		ExternalHost:          m.currentExternalHost(),
+		ReadWritePort:         m.publicReadWritePort,
+		ServiceReadWritePort:  m.serviceReadWritePort,
+		ServiceNodePortRange:  m.serviceNodePortRange.String(),
+		KubernetesServiceType: string(m.kubernetesServiceType),
+		ReconcileInterval:     m.reconcileInterval.String(),
+
+		MaxRequestBodyBytes: m.maxRequestBodyBytes,
+		MinRequestTimeout:   m.minRequestTimeout.String(),
+		MaxRequestTimeout:   m.maxRequestTimeout.String(),
+		MaxReadOnlyInflight: m.maxReadOnlyInflight,
+		MaxMutatingInflight: m.maxMutatingInflight,
+		MaxInflightWatches:  m.maxInflightWatches,
+		ShutdownDelay:       m.shutdownDelay.String(),
+
+		WatchCacheResources:    m.watchCacheResources,
+		DefaultWatchCacheSize:  m.defaultWatchCacheSize,
+		WatchCacheSizes:        m.watchCacheSizes,
+		WatchCacheResyncPeriod: m.watchCacheResyncPeriod.String(),
+		NamespaceObjectLimits:  m.namespaceObjectLimits,
+	}
+	for resource, period := range m.watchCacheResyncPeriods {
+		if config.WatchCacheResyncPeriods == nil {
+			config.WatchCacheResyncPeriods = map[string]string{}
+		}
+		config.WatchCacheResyncPeriods[resource] = period.String()
+	}
+	for group, destinations := range m.storageDestinations.APIGroups {
+		if limit := destinations.RateLimit; limit != nil {
+			if config.StorageRateLimits == nil {
+				config.StorageRateLimits = map[string]EffectiveStorageRateLimit{}
+			}
+			config.StorageRateLimits[group] = EffectiveStorageRateLimit{QPS: limit.QPS, Burst: limit.Burst, MaxWait: limit.MaxWait.String()}
+		}
+	}
+	for _, version := range m.APIGroupVersions() {
+		config.APIGroupVersions = append(config.APIGroupVersions, version.String())
+	}
+	m.storageVersionsLock.RLock()
+	for group, version := range m.storageVersions {
+		config.StorageVersions[group] = version
+	}
+	m.storageVersionsLock.RUnlock()
+	for _, cidr := range m.trustedProxies {
+		config.TrustedProxyCIDRs = append(config.TrustedProxyCIDRs, cidr.String())
+	}
+	if m.disallowedMethods.Len() > 0 {
+		config.DisallowedMethods = m.disallowedMethods.List()
+	}
+	if m.clusterIP != nil {
+		config.PublicAddress = m.clusterIP.String()
+	}
+	if m.serviceReadWriteIP != nil {
+		config.ServiceReadWriteIP = m.serviceReadWriteIP.String()
+	}
+	if m.serviceClusterIPRange != nil {
+		config.ServiceClusterIPRange = m.serviceClusterIPRange.String()
+	}
+	for _, ip := range m.reservedServiceIPs {
+		config.ReservedServiceIPs = append(config.ReservedServiceIPs, ip.String())
+	}
+	return config
+}
+
+// serveEffectiveConfig writes the EffectiveConfig of the master as a JSON object.
+func (m *Master) serveEffectiveConfig(w http.ResponseWriter, req *http.Request) {
+	data, err := json.Marshal(m.EffectiveConfig())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", restful.MIME_JSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/testapi"
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+)
+
+// TestEffectiveConfig verifies that the effective configuration of the master,
+// its defaults included, is served at the config debug endpoint only when
+// profiling is enabled.
+func TestEffectiveConfig(t *testing.T) {
+	for _, profiling := range []bool{false, true} {
+		_, etcdserver, config, assert := setUp(t)
+		config.KubeletClient = kubeletclient.FakeKubeletClient{}
+		config.EnableProfiling = profiling
+		config.MasterCount = 3
+		config.TrustedProxyCIDRs = []string{"10.0.0.0/8"}
+		config.WatchCacheResources = []string{"pods"}
+		config.WatchCacheSizes = map[string]int{"pods": 500}
+		config.WatchCacheResyncPeriods = map[string]time.Duration{"pods": time.Hour}
+		config.StorageDestinations.SetAPIGroupRateLimit("", StorageRateLimit{QPS: 100, Burst: 200, MaxWait: time.Second})
+		config.NamespaceObjectLimits = map[string]int{"pods": 1000}
+
+		master, err := New(&config)
+		if !assert.NoError(err) {
+			etcdserver.Terminate(t)
+			t.FailNow()
+		}
+		effective := master.EffectiveConfig()
+		assert.Equal(3, effective.MasterCount)
+		assert.Equal(profiling, effective.EnableProfiling)
+		assert.Equal("192.168.10.4", effective.PublicAddress)
+		assert.Equal(DefaultReconcileInterval.String(), effective.ReconcileInterval)
+		assert.Equal(int64(DefaultMaxRequestBodyBytes), effective.MaxRequestBodyBytes)
+		assert.Equal([]string{"10.0.0.0/8"}, effective.TrustedProxyCIDRs)
+		assert.Equal([]string{"pods"}, effective.WatchCacheResources)
+		assert.Equal(map[string]int{"pods": 500}, effective.WatchCacheSizes)
+		assert.Equal("0s", effective.WatchCacheResyncPeriod)
+		assert.Equal(map[string]string{"pods": "1h0m0s"}, effective.WatchCacheResyncPeriods)
+		assert.Equal(map[string]EffectiveStorageRateLimit{"": {QPS: 100, Burst: 200, MaxWait: "1s"}}, effective.StorageRateLimits)
+		assert.Equal(map[string]int{"pods": 1000}, effective.NamespaceObjectLimits)
+		assert.Contains(effective.APIGroupVersions, testapi.Default.GroupVersion().String())
+		assert.Contains(effective.APIGroupVersions, testapi.Extensions.GroupVersion().String())
+
+		server := httptest.NewServer(master.InsecureHandler)
+		resp, err := http.Get(server.URL + configPath)
+		if assert.NoError(err) {
+			if !profiling {
+				resp.Body.Close()
+				assert.Equal(http.StatusNotFound, resp.StatusCode)
+			} else {
+				assert.Equal(http.StatusOK, resp.StatusCode)
+				served := EffectiveConfig{}
+				assert.NoError(decodeResponse(resp, &served))
+				assert.Equal(effective, served)
+			}
+		}
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// httpMethodRE matches the HTTP methods of Config.DisallowedMethods.
+var httpMethodRE = regexp.MustCompile("^[A-Za-z]+$")
+
+// apiMethods are the HTTP methods the API is served with.
+var apiMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+// withDisallowedMethods wraps handler so that the requests with a disallowed
+// method are rejected with 405 Method Not Allowed, whatever their path. The
+// Allow header lists the methods the API is still served with.
+func (m *Master) withDisallowedMethods(handler http.Handler) http.Handler {
+	if m.disallowedMethods.Len() == 0 {
+		return handler
+	}
+	allowed := []string{}
+	for _, method := range apiMethods {
+		if !m.disallowedMethods.Has(method) {
+			allowed = append(allowed, method)
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !m.disallowedMethods.Has(req.Method) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeStatusError(w, &apierrors.StatusError{ErrStatus: unversioned.Status{
+			Status:  unversioned.StatusFailure,
+			Code:    http.StatusMethodNotAllowed,
+			Reason:  unversioned.StatusReasonMethodNotAllowed,
+			Message: fmt.Sprintf("the %s method is not allowed by this server", req.Method),
+		}})
+	})
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+)
+
+// TestDisallowedMethods verifies that the disallowed methods are rejected on every
+// path, third party resources included, and that the other methods are served.
+func TestDisallowedMethods(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	config.KubeletClient = kubeletclient.FakeKubeletClient{}
+	config.DisallowedMethods = []string{"delete", "PATCH"}
+
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{Name: "v1"}},
+	})) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	testCases := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{"DELETE", "/api/v1/namespaces/default/pods/foo", http.StatusMethodNotAllowed},
+		{"PATCH", "/api/v1/namespaces/default/pods/foo", http.StatusMethodNotAllowed},
+		{"DELETE", "/apis/company.com/v1/namespaces/default/foos/bar", http.StatusMethodNotAllowed},
+		{"DELETE", "/does/not/exist", http.StatusMethodNotAllowed},
+		{"GET", "/api/v1/namespaces/default/pods", http.StatusOK},
+		{"GET", "/apis/company.com/v1/namespaces/default/foos", http.StatusOK},
+	}
+	for _, testCase := range testCases {
+		req, err := http.NewRequest(testCase.method, server.URL+testCase.path, nil)
+		if !assert.NoError(err) {
+			continue
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != testCase.status {
+			t.Errorf("%s %s: expected status %d, got %d", testCase.method, testCase.path, testCase.status, resp.StatusCode)
+		}
+		if testCase.status == http.StatusMethodNotAllowed {
+			assert.Equal("GET, HEAD, POST, PUT, OPTIONS", resp.Header.Get("Allow"))
+		}
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apiserver"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// closeSignal is a channel that can be closed more than once. The zero value is
+// ready to use.
+type closeSignal struct {
+	lock   sync.Mutex
+	ch     chan struct{}
+	closed bool
+}
+
+// channel returns the channel that is closed by close.
+func (s *closeSignal) channel() <-chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// close closes the channel, if it isn't already.
+func (s *closeSignal) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	if !s.closed {
+		close(s.ch)
+		s.closed = true
+	}
+}
+
+// isClosed returns true once close has been called.
+func (s *closeSignal) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.closed
+}
+
+// Drain takes the master out of rotation before it shuts down: /readyz fails right
+// away, so that load balancers stop sending requests to the master, and the
+// watches it serves are ended, so that their clients reconnect to other masters.
+// The master keeps serving the requests it still receives for ShutdownDelay, but
+// the new watches, which are refused with 503 Service Unavailable, then shuts
+// down with Shutdown, which waits for the requests in flight until ctx is done.
+func (m *Master) Drain(ctx context.Context) error {
+	glog.Infof("Draining the master for %v before it shuts down", m.shutdownDelay)
+	m.draining.close()
+	select {
+	case <-time.After(m.shutdownDelay):
+	case <-ctx.Done():
+	}
+	return m.Shutdown(ctx)
+}
+
+// IsNotDraining returns an error once the master has started to drain, so that it
+// is reported as not ready.
+func (m *Master) IsNotDraining(req *http.Request) error {
+	if m.draining.isClosed() {
+		return errors.New("the master is shutting down")
+	}
+	return nil
+}
+
+// withWatchesEndedOnDrain wraps handler so that the watches it serves end once the
+// master drains. The watches started afterwards are refused with 503 Service
+// Unavailable and a Retry-After header, so that their clients retry, hopefully
+// against another master, rather than watch again right away.
+func (m *Master) withWatchesEndedOnDrain(handler http.Handler) http.Handler {
+	resolver := m.newRequestInfoResolver()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := resolver.GetRequestInfo(req)
+		if err != nil || (info.Verb != "watch" && req.URL.Query().Get("watch") != "true") {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if m.draining.isClosed() {
+			w.Header().Set("Retry-After", apiserver.RetryAfter)
+			writeStatusError(w, apierrors.NewServiceUnavailable("the master is shutting down"))
+			return
+		}
+		done := make(chan struct{})
+		defer close(done)
+		handler.ServeHTTP(&drainingResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}, draining: m.draining.channel(), done: done}, req)
+	})
+}
+
+// drainingResponseWriter is an http.ResponseWriter whose CloseNotify also fires
+// when the master drains, which ends the watch it is written by. The disconnect
+// of the client is only notified once, so it is waited for once, and every call
+// of CloseNotify returns the same channel, which is closed then.
+type drainingResponseWriter struct {
+	delegatingResponseWriter
+	draining <-chan struct{}
+	// done is closed when the request has been served.
+	done <-chan struct{}
+
+	once   sync.Once
+	closed chan bool
+}
+
+func (w *drainingResponseWriter) CloseNotify() <-chan bool {
+	w.once.Do(func() {
+		var disconnected <-chan bool
+		if notifier, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+			disconnected = notifier.CloseNotify()
+		}
+		w.closed = make(chan bool)
+		go func() {
+			select {
+			case <-disconnected:
+				close(w.closed)
+			case <-w.draining:
+				close(w.closed)
+			case <-w.done:
+			}
+		}()
+	})
+	return w.closed
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
+	"k8s.io/kubernetes/pkg/util"
+
+	"golang.org/x/net/context"
+)
+
+// TestDrain verifies that a draining master fails /readyz and ends its watches
+// while it keeps serving requests for the shutdown delay, then shuts down.
+func TestDrain(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	config.KubeletClient = kubeletclient.FakeKubeletClient{}
+	shutdownDelay := time.Second
+	config.ShutdownDelay = &shutdownDelay
+
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/readyz")
+	if assert.NoError(err) {
+		resp.Body.Close()
+		assert.Equal(http.StatusOK, resp.StatusCode)
+	}
+	watch, err := http.Get(server.URL + "/api/v1/namespaces/default/pods?watch=true")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer watch.Body.Close()
+	assert.Equal(http.StatusOK, watch.StatusCode)
+
+	drained := make(chan error)
+	go func() {
+		drained <- master.Drain(context.Background())
+	}()
+
+	// The watch ends cleanly.
+	_, err = ioutil.ReadAll(watch.Body)
+	assert.NoError(err)
+
+	resp, err = http.Get(server.URL + "/readyz")
+	if assert.NoError(err) {
+		resp.Body.Close()
+		assert.Equal(http.StatusInternalServerError, resp.StatusCode)
+	}
+	resp, err = http.Get(server.URL + "/api/v1/namespaces/default/pods")
+	if assert.NoError(err) {
+		resp.Body.Close()
+		assert.Equal(http.StatusOK, resp.StatusCode)
+	}
+	// The new watches are refused, and retried later.
+	resp, err = http.Get(server.URL + "/api/v1/namespaces/default/pods?watch=true")
+	if assert.NoError(err) {
+		resp.Body.Close()
+		assert.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		assert.NotEmpty(resp.Header.Get("Retry-After"))
+	}
+
+	select {
+	case err := <-drained:
+		assert.NoError(err)
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("the master didn't shut down")
+	}
+	resp, err = http.Get(server.URL + "/api/v1/namespaces/default/pods")
+	if assert.NoError(err) {
+		resp.Body.Close()
+		assert.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	}
+}
+
+// TestDrainWithoutDelay verifies that a master drained with a zero shutdown delay
+// shuts down right away.
+func TestDrainWithoutDelay(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	config.KubeletClient = kubeletclient.FakeKubeletClient{}
+	shutdownDelay := time.Duration(0)
+	config.ShutdownDelay = &shutdownDelay
+
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(time.Duration(0), master.shutdownDelay)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := time.Now()
+	assert.NoError(master.Drain(ctx))
+	assert.True(time.Since(start) < DefaultShutdownDelay, "the master waited %v to shut down", time.Since(start))
+}
+
+// singleCloseNotifier is a response writer whose client disconnect is notified
+// with a single value, like the ones of net/http.
+type singleCloseNotifier struct {
+	*httptest.ResponseRecorder
+	closed chan bool
+}
+
+func (w *singleCloseNotifier) CloseNotify() <-chan bool {
+	return w.closed
+}
+
+// TestDrainingResponseWriterCloseNotify verifies that every call of CloseNotify,
+// like the one a watch makes between two events, is notified of the disconnect.
+func TestDrainingResponseWriterCloseNotify(t *testing.T) {
+	underlying := &singleCloseNotifier{ResponseRecorder: httptest.NewRecorder(), closed: make(chan bool, 1)}
+	done := make(chan struct{})
+	defer close(done)
+	w := &drainingResponseWriter{delegatingResponseWriter: delegatingResponseWriter{underlying}, draining: make(chan struct{}), done: done}
+
+	first, second := w.CloseNotify(), w.CloseNotify()
+	underlying.closed <- true
+	for i, closed := range []<-chan bool{first, second, w.CloseNotify()} {
+		select {
+		case <-closed:
+		case <-time.After(util.ForeverTestTimeout):
+			t.Errorf("%d: expected the disconnect to be notified", i)
+		}
+	}
+}
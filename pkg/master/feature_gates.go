@@ -0,0 +1,89 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+)
+
+// Feature is the name of an optional behavior of the master that can be turned
+// on or off by Config.FeatureGates. The optional behaviors that have a field of
+// their own in Config, e.g. EnableCompression or EnableOpenAPISupport, have no
+// gate, they are only turned on or off by their field.
+type Feature string
+
+const (
+	// AggregatedDiscoveryFeature serves the discovery document of every API group.
+	// Defaults to on.
+	AggregatedDiscoveryFeature Feature = "AggregatedDiscovery"
+	// ProblemDetailsFeature renders error statuses as problem details for the
+	// clients that ask for them. Defaults to on.
+	ProblemDetailsFeature Feature = "ProblemDetails"
+	// ThirdPartyWatchFeature serves the watches of third party objects. Defaults
+	// to on.
+	ThirdPartyWatchFeature Feature = "ThirdPartyWatch"
+)
+
+// featureDefaults are whether each known feature is on when its gate is not set.
+var featureDefaults = map[Feature]bool{
+	AggregatedDiscoveryFeature: true,
+	ProblemDetailsFeature:      true,
+	ThirdPartyWatchFeature:     true,
+}
+
+// knownFeatures returns the sorted names of the known features.
+func knownFeatures() []string {
+	names := make([]string, 0, len(featureDefaults))
+	for feature := range featureDefaults {
+		names = append(names, string(feature))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// featureEnabled returns whether feature is on, as set by its gate or by default.
+func (m *Master) featureEnabled(feature Feature) bool {
+	if enabled, ok := m.featureGates[string(feature)]; ok {
+		return enabled
+	}
+	return featureDefaults[feature]
+}
+
+// thirdPartyWatches wraps handler so that the watches of third party objects are
+// refused with 405 Method Not Allowed unless ThirdPartyWatchFeature is on.
+func (m *Master) thirdPartyWatches(handler http.Handler) http.Handler {
+	if m.featureEnabled(ThirdPartyWatchFeature) {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		watch, _ := strconv.ParseBool(req.URL.Query().Get("watch"))
+		if info.Verb != "watch" && !(info.Verb == "list" && watch) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		writeStatusError(w, apierrors.NewMethodNotSupported(info.Resource, "watch"))
+	})
+}
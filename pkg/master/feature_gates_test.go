@@ -0,0 +1,89 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+// TestFeatureEnabled verifies that feature gates override the defaults of the
+// features, and that the unknown features are off.
+func TestFeatureEnabled(t *testing.T) {
+	testCases := []struct {
+		gates    map[string]bool
+		feature  Feature
+		expected bool
+	}{
+		{nil, ThirdPartyWatchFeature, true},
+		{map[string]bool{"ThirdPartyWatch": false}, ThirdPartyWatchFeature, false},
+		{map[string]bool{"ThirdPartyWatch": false}, ProblemDetailsFeature, true},
+		{nil, Feature("Teleportation"), false},
+		{map[string]bool{"Teleportation": true}, Feature("Teleportation"), true},
+	}
+	for i, testCase := range testCases {
+		m := &Master{featureGates: testCase.gates}
+		if enabled := m.featureEnabled(testCase.feature); enabled != testCase.expected {
+			t.Errorf("%d: expected %s to be enabled %v, got %v", i, testCase.feature, testCase.expected, enabled)
+		}
+	}
+}
+
+// TestFeatureGatesInit verifies that the optional handlers whose feature is off
+// are not installed by init.
+func TestFeatureGatesInit(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		master, etcdserver, config, assert := setUpForInit(t)
+		master.apiGroupPrefix = "/apis"
+		master.featureGates = map[string]bool{
+			string(AggregatedDiscoveryFeature): enabled,
+			string(ThirdPartyWatchFeature):     enabled,
+		}
+
+		master.init(&config)
+		if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+			ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+			Versions:   []extensions.APIVersion{{Name: "v1"}},
+		})) {
+			t.FailNow()
+		}
+		server := httptest.NewServer(master.InsecureHandler)
+
+		status := func(path string) int {
+			resp, err := http.Get(server.URL + path)
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+		expected := map[bool]int{true: http.StatusOK, false: http.StatusNotFound}[enabled]
+		assert.Equal(expected, status(aggregatedDiscoveryPath), "enabled %v", enabled)
+		// The watches are closed by their timeout.
+		expected = map[bool]int{true: http.StatusOK, false: http.StatusMethodNotAllowed}[enabled]
+		assert.Equal(expected, status("/apis/company.com/v1/watch/namespaces/default/foos?timeoutSeconds=1"), "enabled %v", enabled)
+		assert.Equal(expected, status("/apis/company.com/v1/namespaces/default/foos?watch=true&timeoutSeconds=1"), "enabled %v", enabled)
+		assert.Equal(http.StatusOK, status("/apis/company.com/v1/namespaces/default/foos"), "enabled %v", enabled)
+
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// impersonateUserHeader is the header naming the user a request is made as.
+	impersonateUserHeader = "Impersonate-User"
+	// impersonateGroupHeader is the header naming a group of the user a request
+	// is made as. It may be repeated.
+	impersonateGroupHeader = "Impersonate-Group"
+	// impersonateUIDHeader is the header naming the UID of the user a request is
+	// made as.
+	impersonateUIDHeader = "Impersonate-Uid"
+)
+
+// withImpersonation wraps handler so that the requests with an Impersonate-User
+// header are made as the user it names, in the groups named by their
+// Impersonate-Group headers and with the UID named by their Impersonate-Uid
+// header, if the authenticated user is authorized to impersonate them: to do the
+// impersonate verb on the users, groups and uids resources. The impersonated user replaces the authenticated one in the request
+// context, and the authenticated user is kept there as the impersonator, so that
+// both are logged and audited. It must be installed inside the authentication
+// and outside the authorization check.
+func (m *Master) withImpersonation(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name := req.Header.Get(impersonateUserHeader)
+		groups := req.Header[impersonateGroupHeader]
+		uid := req.Header.Get(impersonateUIDHeader)
+		if len(name) == 0 && len(groups) == 0 && len(uid) == 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if len(name) == 0 {
+			writeStatusError(w, apierrors.NewBadRequest(fmt.Sprintf("the %s and %s headers require the %s header", impersonateGroupHeader, impersonateUIDHeader, impersonateUserHeader)))
+			return
+		}
+		ctx, ok := m.requestContextMapper.Get(req)
+		if !ok {
+			writeStatusError(w, apierrors.NewInternalError(fmt.Errorf("no context found for request")))
+			return
+		}
+		requester, ok := api.UserFrom(ctx)
+		if !ok {
+			writeStatusError(w, apierrors.NewForbidden("users", name, fmt.Errorf("impersonation requires an authenticated user")))
+			return
+		}
+		if err := m.authorizeImpersonation(requester, "users", name, req); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		for _, group := range groups {
+			if err := m.authorizeImpersonation(requester, "groups", group, req); err != nil {
+				writeStatusError(w, err)
+				return
+			}
+		}
+		if len(uid) > 0 {
+			if err := m.authorizeImpersonation(requester, "uids", uid, req); err != nil {
+				writeStatusError(w, err)
+				return
+			}
+		}
+
+		impersonated := &user.DefaultInfo{Name: name, UID: uid, Groups: groups}
+		if requestID, ok := requestIDFrom(ctx); ok {
+			glog.V(2).Infof("Request %s: %s impersonates %s", requestID, requester.GetName(), name)
+		}
+		m.requestContextMapper.Update(req, api.WithImpersonator(api.WithUser(ctx, impersonated), requester))
+		// The headers are not passed on, e.g. to the proxied nodes and services.
+		req.Header.Del(impersonateUserHeader)
+		req.Header.Del(impersonateGroupHeader)
+		req.Header.Del(impersonateUIDHeader)
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// authorizeImpersonation returns a Forbidden error unless requester is authorized
+// to impersonate the user, group or UID name, i.e. to do the impersonate verb on
+// it, as a resource of the legacy API group. The path of the attributes is the one
+// of name in the legacy API, e.g. /api/v1/users/bob, so that the authorizer can
+// read the name from its request info, and allow some users to be impersonated
+// but not others, e.g. not the privileged system: users and groups. A name that
+// can't be a path segment is rejected.
+func (m *Master) authorizeImpersonation(requester user.Info, resource, name string, req *http.Request) error {
+	if ok, msg := validation.IsValidPathSegmentName(name); !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid %s to impersonate %q: %s", resource, name, msg))
+	}
+	attributes := authorizer.AttributesRecord{
+		User:            requester,
+		Verb:            "impersonate",
+		Resource:        resource,
+		ResourceRequest: true,
+		Path:            path.Join(m.apiPrefix, v1.SchemeGroupVersion.Version, resource, name),
+	}
+	if err := m.authorizer.Authorize(attributes); err != nil {
+		return apierrors.NewForbidden(resource, name, fmt.Errorf("%s is not allowed to impersonate %s %q: %v", requester.GetName(), resource, name, err))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/authorizer"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// TestWithImpersonation verifies that the requests impersonating a user are made
+// as that user, with the authenticated user as impersonator, only if the
+// authenticated user is authorized to impersonate the user, its groups and UID.
+func TestWithImpersonation(t *testing.T) {
+	master := &Master{
+		apiPrefix:            "/api",
+		requestContextMapper: api.NewRequestContextMapper(),
+	}
+	resolver := master.newRequestInfoResolver()
+	master.authorizer = authorizer.AuthorizerFunc(func(a authorizer.Attributes) error {
+		req, _ := http.NewRequest("GET", a.GetPath(), nil)
+		info, err := resolver.GetRequestInfo(req)
+		if err != nil {
+			return err
+		}
+		// alice may impersonate users, groups and UIDs, dave only users, and
+		// erin only bob.
+		switch {
+		case a.GetVerb() != "impersonate" || info.Resource != a.GetResource():
+		case a.GetUserName() == "alice", a.GetUserName() == "dave" && a.GetResource() == "users":
+			return nil
+		case a.GetUserName() == "erin" && a.GetResource() == "users" && info.Name == "bob":
+			return nil
+		}
+		return errors.New("denied")
+	})
+	var served http.Header
+	var servedUser, servedImpersonator user.Info
+	inner := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		served = req.Header
+		ctx, _ := master.requestContextMapper.Get(req)
+		servedUser, _ = api.UserFrom(ctx)
+		servedImpersonator, _ = api.ImpersonatorFrom(ctx)
+	})
+	withUser := func(name string) http.Handler {
+		handler := master.withImpersonation(inner)
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if ctx, ok := master.requestContextMapper.Get(req); ok && len(name) > 0 {
+				master.requestContextMapper.Update(req, api.WithUser(ctx, &user.DefaultInfo{Name: name}))
+			}
+			handler.ServeHTTP(w, req)
+		})
+	}
+
+	testCases := []struct {
+		requester    string
+		user         string
+		groups       []string
+		uid          string
+		code         int
+		expectedUser user.Info
+	}{
+		{"alice", "", nil, "", http.StatusOK, &user.DefaultInfo{Name: "alice"}},
+		{"alice", "bob", nil, "", http.StatusOK, &user.DefaultInfo{Name: "bob"}},
+		{"alice", "bob", []string{"ops", "dev"}, "", http.StatusOK, &user.DefaultInfo{Name: "bob", Groups: []string{"ops", "dev"}}},
+		{"alice", "", []string{"ops"}, "", http.StatusBadRequest, nil},
+		{"dave", "bob", nil, "", http.StatusOK, &user.DefaultInfo{Name: "bob"}},
+		{"dave", "bob", []string{"ops"}, "", http.StatusForbidden, nil},
+		{"carol", "bob", nil, "", http.StatusForbidden, nil},
+		{"erin", "bob", nil, "", http.StatusOK, &user.DefaultInfo{Name: "bob"}},
+		{"erin", "system:admin", nil, "", http.StatusForbidden, nil},
+		{"erin", "bob", []string{"system:masters"}, "", http.StatusForbidden, nil},
+		{"alice", "bob", nil, "1234", http.StatusOK, &user.DefaultInfo{Name: "bob", UID: "1234"}},
+		{"alice", "", nil, "1234", http.StatusBadRequest, nil},
+		{"dave", "bob", nil, "1234", http.StatusForbidden, nil},
+		{"alice", "bob/status", nil, "", http.StatusBadRequest, nil},
+		{"", "bob", nil, "", http.StatusForbidden, nil},
+	}
+	for i, testCase := range testCases {
+		handler, err := api.NewRequestContextFilter(master.requestContextMapper, withUser(testCase.requester))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		served, servedUser, servedImpersonator = nil, nil, nil
+		req, _ := http.NewRequest("GET", "/api/v1/namespaces/default/pods", nil)
+		if len(testCase.user) > 0 {
+			req.Header.Set(impersonateUserHeader, testCase.user)
+		}
+		for _, group := range testCase.groups {
+			req.Header.Add(impersonateGroupHeader, group)
+		}
+		if len(testCase.uid) > 0 {
+			req.Header.Set(impersonateUIDHeader, testCase.uid)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		if w.Code != testCase.code {
+			t.Errorf("%d: expected status %d, got %d: %s", i, testCase.code, w.Code, w.Body.String())
+			continue
+		}
+		if testCase.code != http.StatusOK {
+			if served != nil {
+				t.Errorf("%d: expected the request not to be served", i)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(servedUser, testCase.expectedUser) {
+			t.Errorf("%d: expected user %v, got %v", i, testCase.expectedUser, servedUser)
+		}
+		if len(testCase.user) == 0 {
+			if servedImpersonator != nil {
+				t.Errorf("%d: expected no impersonator, got %v", i, servedImpersonator)
+			}
+			continue
+		}
+		if servedImpersonator == nil || servedImpersonator.GetName() != testCase.requester {
+			t.Errorf("%d: expected impersonator %s, got %v", i, testCase.requester, servedImpersonator)
+		}
+		if len(served.Get(impersonateUserHeader)) > 0 || len(served[impersonateGroupHeader]) > 0 || len(served.Get(impersonateUIDHeader)) > 0 {
+			t.Errorf("%d: expected the impersonation headers to be removed, got %v", i, served)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/apiserver"
+)
+
+// withInflightLimit wraps handler so that at most maxReadOnlyInflight read-only
+// requests, maxMutatingInflight mutating requests and maxInflightWatches watches
+// are served concurrently. Requests over their limit are answered with 429 Too Many
+// Requests. Long running requests other than watches, e.g. exec or proxy, are not
+// limited. A limit of zero or less disables it.
+func (m *Master) withInflightLimit(handler http.Handler) http.Handler {
+	if m.maxReadOnlyInflight <= 0 && m.maxMutatingInflight <= 0 && m.maxInflightWatches <= 0 {
+		return handler
+	}
+	readOnly := newInflightSemaphore(m.maxReadOnlyInflight)
+	mutating := newInflightSemaphore(m.maxMutatingInflight)
+	watches := newInflightSemaphore(m.maxInflightWatches)
+	resolver := m.newRequestInfoResolver()
+	return apiserver.MaxInFlightLimitFunc(func(req *http.Request) chan bool {
+		switch {
+		case isWatchRequest(resolver, req):
+			return watches
+		case m.isLongRunningRequest(req):
+			return nil
+		case isMutatingRequest(req):
+			return mutating
+		}
+		return readOnly
+	}, handler)
+}
+
+// newInflightSemaphore returns a channel with room for limit requests, or nil if
+// limit disables the limit.
+func newInflightSemaphore(limit int) chan bool {
+	if limit <= 0 {
+		return nil
+	}
+	return make(chan bool, limit)
+}
+
+// isMutatingRequest returns true if req may change the state of the cluster, i.e.
+// it is not a get, list or watch. The classification only depends on the HTTP
+// method, so it applies to third party resources as well.
+func isMutatingRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	return true
+}
+
+// isWatchRequest returns true if req watches resources, either through the watch
+// prefix or the watch parameter.
+func isWatchRequest(resolver *apiserver.RequestInfoResolver, req *http.Request) bool {
+	if req.URL.Query().Get("watch") == "true" {
+		return true
+	}
+	info, err := resolver.GetRequestInfo(req)
+	return err == nil && info.Verb == "watch"
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestInflightLimit verifies that read-only requests, mutating requests and watches
+// over their separate inflight limits are rejected with 429, and that long running
+// requests are not limited.
+func TestInflightLimit(t *testing.T) {
+	master := &Master{
+		apiPrefix:            "/api",
+		maxReadOnlyInflight:  1,
+		maxMutatingInflight:  1,
+		maxInflightWatches:   2,
+		longRunningRequestRE: regexp.MustCompile("/exec$"),
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := master.withInflightLimit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+	wg := sync.WaitGroup{}
+	block := func(method, path string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serve(method, path)
+		}()
+		<-started
+	}
+
+	block("GET", "/api/v1/namespaces/default/pods")
+	block("GET", "/api/v1/watch/namespaces/default/pods")
+	block("GET", "/api/v1/namespaces/default/pods?watch=true")
+	block("POST", "/api/v1/namespaces/default/pods/foo/exec")
+	// The mutating requests have room while the read-only ones are at their limit.
+	block("POST", "/apis/company.com/v1/namespaces/default/foos")
+
+	w := serve("GET", "/api/v1/namespaces/default/services")
+	assert.Equal(t, errors.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, errors.StatusTooManyRequests, serve("GET", "/apis/company.com/v1/namespaces/default/foos").Code)
+	assert.Equal(t, errors.StatusTooManyRequests, serve("DELETE", "/apis/company.com/v1/namespaces/default/foos/bar").Code)
+	assert.Equal(t, errors.StatusTooManyRequests, serve("PUT", "/api/v1/namespaces/default/services/bar").Code)
+	assert.Equal(t, errors.StatusTooManyRequests, serve("GET", "/api/v1/watch/namespaces/default/services").Code)
+
+	close(release)
+	wg.Wait()
+
+	go func() { <-started }()
+	assert.Equal(t, http.StatusOK, serve("GET", "/api/v1/namespaces/default/services").Code)
+}
+
+// TestInflightLimitReadFlood verifies that a mutating request goes through while
+// as many slow read-only requests as the default limit on all requests are in
+// flight, once the read-only and mutating requests have separate limits.
+func TestInflightLimitReadFlood(t *testing.T) {
+	const reads = 400
+	master := &Master{
+		apiPrefix:           "/api",
+		maxReadOnlyInflight: reads,
+		maxMutatingInflight: reads,
+	}
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := master.withInflightLimit(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == "GET" {
+			started <- struct{}{}
+			<-release
+		}
+	}))
+	serve := func(method string) int {
+		req, err := http.NewRequest(method, "/api/v1/namespaces/default/pods", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < reads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serve("GET")
+		}()
+		<-started
+	}
+	assert.Equal(t, errors.StatusTooManyRequests, serve("GET"))
+	assert.Equal(t, http.StatusOK, serve("POST"))
+	close(release)
+	wg.Wait()
+}
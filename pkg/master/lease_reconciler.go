@@ -0,0 +1,186 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net"
+	"path"
+	"reflect"
+	"sort"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/registry/endpoint"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// masterLeasesKey is the storage key under which the apiservers hold their leases.
+const masterLeasesKey = "/masterleases/"
+
+// defaultMasterLeaseTTL returns the lifetime of the leases of apiservers that
+// renew them every reconcileInterval, leaving them gracePeriod to do so.
+func defaultMasterLeaseTTL(reconcileInterval, gracePeriod time.Duration) time.Duration {
+	return reconcileInterval + gracePeriod
+}
+
+// masterLeases records the IPs of the running apiservers as storage keys that
+// expire unless they are renewed.
+type masterLeases struct {
+	storage storage.Interface
+	baseKey string
+	// ttl is the lifetime of a lease, in seconds.
+	ttl uint64
+}
+
+// listLeases returns the IPs of the apiservers holding an unexpired lease.
+func (l *masterLeases) listLeases() ([]string, error) {
+	list := &api.EndpointsList{}
+	if err := l.storage.List(context.TODO(), l.baseKey, "", storage.Everything, list); err != nil {
+		return nil, err
+	}
+	ips := make([]string, 0, len(list.Items))
+	for _, e := range list.Items {
+		if len(e.Subsets) == 1 && len(e.Subsets[0].Addresses) == 1 {
+			ips = append(ips, e.Subsets[0].Addresses[0].IP)
+		}
+	}
+	return ips, nil
+}
+
+// updateLease acquires or renews the lease of the apiserver at ip.
+func (l *masterLeases) updateLease(ip string) error {
+	key := path.Join(l.baseKey, ip)
+	return l.storage.GuaranteedUpdate(context.TODO(), key, &api.Endpoints{}, true, func(input runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+		e := input.(*api.Endpoints)
+		e.Name = ip
+		e.Subsets = []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: ip}}}}
+		// The TTL is only renewed if the object changes.
+		e.Generation++
+		return e, &l.ttl, nil
+	})
+}
+
+// removeLease releases the lease of the apiserver at ip.
+func (l *masterLeases) removeLease(ip string) error {
+	err := l.storage.Delete(context.TODO(), path.Join(l.baseKey, ip), &api.Endpoints{})
+	if storage.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// leaseEndpointReconciler is an EndpointReconciler that publishes the IPs of
+// the apiservers holding a lease, so that it does not depend on MasterCount.
+type leaseEndpointReconciler struct {
+	endpointRegistry endpoint.Registry
+	leases           *masterLeases
+}
+
+// newLeaseEndpointReconciler returns an EndpointReconciler that keeps the leases
+// in leaseStorage, each of them expiring after leaseTTL unless it is renewed.
+func newLeaseEndpointReconciler(endpointRegistry endpoint.Registry, leaseStorage storage.Interface, leaseTTL time.Duration) EndpointReconciler {
+	return &leaseEndpointReconciler{
+		endpointRegistry: endpointRegistry,
+		leases: &masterLeases{
+			storage: leaseStorage,
+			baseKey: masterLeasesKey,
+			ttl:     uint64(leaseTTL.Seconds()),
+		},
+	}
+}
+
+// ReconcileEndpoints renews the lease of the apiserver at ip, and sets the
+// addresses of the endpoints to the IPs of the apiservers holding a lease.
+func (r *leaseEndpointReconciler) ReconcileEndpoints(serviceName string, ip net.IP, endpointPorts []api.EndpointPort, reconcilePorts bool) error {
+	if err := r.leases.updateLease(ip.String()); err != nil {
+		return err
+	}
+	addresses, err := r.leaseAddresses()
+	if err != nil {
+		return err
+	}
+
+	ctx := api.NewDefaultContext()
+	e, err := r.endpointRegistry.GetEndpoints(ctx, serviceName)
+	if err != nil {
+		e = &api.Endpoints{
+			ObjectMeta: api.ObjectMeta{
+				Name:      serviceName,
+				Namespace: api.NamespaceDefault,
+			},
+		}
+	}
+	ports := endpointPorts
+	if !reconcilePorts && len(e.Subsets) == 1 && len(e.Subsets[0].Ports) != 0 {
+		ports = e.Subsets[0].Ports
+	}
+	if len(e.Subsets) == 1 && reflect.DeepEqual(e.Subsets[0].Addresses, addresses) && reflect.DeepEqual(e.Subsets[0].Ports, ports) {
+		return nil
+	}
+	e.Subsets = []api.EndpointSubset{{Addresses: addresses, Ports: ports}}
+	glog.Warningf("Resetting endpoints for master service %q to %v", serviceName, e)
+	return r.endpointRegistry.UpdateEndpoints(ctx, e)
+}
+
+// RemoveEndpoints releases the lease of the apiserver at ip, and sets the
+// addresses of the endpoints to the IPs of the apiservers still holding a lease.
+// The endpoints are left untouched if no apiserver holds a lease any more.
+func (r *leaseEndpointReconciler) RemoveEndpoints(serviceName string, ip net.IP) error {
+	if err := r.leases.removeLease(ip.String()); err != nil {
+		return err
+	}
+	addresses, err := r.leaseAddresses()
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	ctx := api.NewDefaultContext()
+	e, err := r.endpointRegistry.GetEndpoints(ctx, serviceName)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(e.Subsets) != 1 || reflect.DeepEqual(e.Subsets[0].Addresses, addresses) {
+		return nil
+	}
+	e.Subsets[0].Addresses = addresses
+	glog.Infof("Removing %v from the endpoints of master service %q", ip, serviceName)
+	return r.endpointRegistry.UpdateEndpoints(ctx, e)
+}
+
+// leaseAddresses returns the sorted addresses of the apiservers holding a lease.
+func (r *leaseEndpointReconciler) leaseAddresses() ([]api.EndpointAddress, error) {
+	ips, err := r.leases.listLeases()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(ips)
+	addresses := make([]api.EndpointAddress, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, api.EndpointAddress{IP: ip})
+	}
+	return addresses, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/kubelet/client"
+	"k8s.io/kubernetes/pkg/registry/registrytest"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+
+	"golang.org/x/net/context"
+)
+
+// TestLeaseEndpointReconciler verifies that the endpoints of the kubernetes
+// service list the apiservers holding an unexpired lease, whatever their number.
+func TestLeaseEndpointReconciler(t *testing.T) {
+	server := etcdtesting.NewEtcdTestClientServer(t)
+	defer server.Terminate(t)
+	leaseStorage := etcdstorage.NewEtcdStorage(server.Client, testapi.Default.Codec(), etcdtest.PathPrefix())
+
+	endpointRegistry := &registrytest.EndpointRegistry{}
+	reconciler := newLeaseEndpointReconciler(endpointRegistry, leaseStorage, time.Minute)
+	ports := []api.EndpointPort{{Name: "https", Port: 443, Protocol: "TCP"}}
+	for _, ip := range []string{"4.3.2.1", "1.2.3.4", "4.3.2.1"} {
+		if err := reconciler.ReconcileEndpoints("kubernetes", net.ParseIP(ip), ports, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	expected := []api.EndpointSubset{{
+		Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}, {IP: "4.3.2.1"}},
+		Ports:     ports,
+	}}
+	if len(endpointRegistry.Updates) != 2 {
+		t.Fatalf("expected the endpoints to be updated for each new lease, got %v", endpointRegistry.Updates)
+	}
+	if !reflect.DeepEqual(expected, endpointRegistry.Updates[1].Subsets) {
+		t.Errorf("expected subsets:\n%#v\ngot:\n%#v\n", expected, endpointRegistry.Updates[1].Subsets)
+	}
+
+	resp, err := server.Client.Get(path.Join(etcdtest.PathPrefix(), masterLeasesKey, "1.2.3.4"), false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Node.TTL <= 0 || resp.Node.TTL > 60 {
+		t.Errorf("expected the lease to expire within a minute, got a TTL of %d", resp.Node.TTL)
+	}
+
+	// Expire the lease of 1.2.3.4; it is dropped by the next reconcile.
+	if err := leaseStorage.Delete(context.TODO(), path.Join(masterLeasesKey, "1.2.3.4"), &api.Endpoints{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := reconciler.ReconcileEndpoints("kubernetes", net.ParseIP("4.3.2.1"), ports, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected[0].Addresses = []api.EndpointAddress{{IP: "4.3.2.1"}}
+	if len(endpointRegistry.Updates) != 3 || !reflect.DeepEqual(expected, endpointRegistry.Updates[2].Subsets) {
+		t.Errorf("unexpected updates: %v", endpointRegistry.Updates)
+	}
+}
+
+// TestLeaseEndpointReconcilerRemoveEndpoints verifies that an apiserver releases
+// its lease and leaves the endpoints of the kubernetes service, unless no other
+// apiserver holds a lease.
+func TestLeaseEndpointReconcilerRemoveEndpoints(t *testing.T) {
+	server := etcdtesting.NewEtcdTestClientServer(t)
+	defer server.Terminate(t)
+	leaseStorage := etcdstorage.NewEtcdStorage(server.Client, testapi.Default.Codec(), etcdtest.PathPrefix())
+
+	endpointRegistry := &registrytest.EndpointRegistry{}
+	reconciler := newLeaseEndpointReconciler(endpointRegistry, leaseStorage, time.Minute)
+	ports := []api.EndpointPort{{Name: "https", Port: 443, Protocol: "TCP"}}
+	for _, ip := range []string{"1.2.3.4", "4.3.2.1"} {
+		if err := reconciler.ReconcileEndpoints("kubernetes", net.ParseIP(ip), ports, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := reconciler.RemoveEndpoints("kubernetes", net.ParseIP("1.2.3.4")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []api.EndpointSubset{{
+		Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}},
+		Ports:     ports,
+	}}
+	if len(endpointRegistry.Updates) != 3 || !reflect.DeepEqual(expected, endpointRegistry.Updates[2].Subsets) {
+		t.Fatalf("unexpected updates: %v", endpointRegistry.Updates)
+	}
+
+	// The last apiserver leaves the endpoints untouched.
+	if err := reconciler.RemoveEndpoints("kubernetes", net.ParseIP("4.3.2.1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(endpointRegistry.Updates) != 3 {
+		t.Errorf("unexpected updates: %v", endpointRegistry.Updates[3:])
+	}
+	if _, err := server.Client.Get(path.Join(etcdtest.PathPrefix(), masterLeasesKey, "4.3.2.1"), false, false); err == nil {
+		t.Errorf("expected the lease of 4.3.2.1 to be released")
+	}
+}
+
+// TestNewLeaseEndpointReconcilerType verifies that a master configured with
+// LeaseEndpointReconcilerType reconciles the kubernetes service endpoints from leases.
+func TestNewLeaseEndpointReconcilerType(t *testing.T) {
+	_, etcdserver, config, _ := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	config.KubeletClient = client.FakeKubeletClient{}
+	config.EndpointReconcilerType = LeaseEndpointReconcilerType
+	master, err := New(&config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := master.endpointReconciler.(*leaseEndpointReconciler); !ok {
+		t.Errorf("expected a lease endpoint reconciler, got %#v", master.endpointReconciler)
+	}
+	if controller := master.NewBootstrapController(); controller.EndpointReconciler != master.endpointReconciler {
+		t.Errorf("expected the bootstrap controller to use the lease endpoint reconciler")
+	}
+}
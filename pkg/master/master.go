@@ -23,20 +23,25 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"net/url"
+	"regexp"
+	goruntime "runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/admission/webhook"
 	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/latest"
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
-	apiutil "k8s.io/kubernetes/pkg/api/util"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/apiserver/metrics"
 	"k8s.io/kubernetes/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/authorizer"
 	"k8s.io/kubernetes/pkg/auth/handlers"
@@ -51,7 +56,6 @@ import (
 	eventetcd "k8s.io/kubernetes/pkg/registry/event/etcd"
 	expcontrolleretcd "k8s.io/kubernetes/pkg/registry/experimental/controller/etcd"
 	"k8s.io/kubernetes/pkg/registry/generic"
-	genericetcd "k8s.io/kubernetes/pkg/registry/generic/etcd"
 	ingressetcd "k8s.io/kubernetes/pkg/registry/ingress/etcd"
 	jobetcd "k8s.io/kubernetes/pkg/registry/job/etcd"
 	limitrangeetcd "k8s.io/kubernetes/pkg/registry/limitrange/etcd"
@@ -73,6 +77,7 @@ import (
 	thirdpartyresourceetcd "k8s.io/kubernetes/pkg/registry/thirdpartyresource/etcd"
 	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
 	thirdpartyresourcedataetcd "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
 	etcdutil "k8s.io/kubernetes/pkg/storage/etcd/util"
 	"k8s.io/kubernetes/pkg/ui"
@@ -85,7 +90,6 @@ import (
 	"github.com/emicklei/go-restful"
 	"github.com/emicklei/go-restful/swagger"
 	"github.com/golang/glog"
-	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
 	"k8s.io/kubernetes/pkg/registry/service/allocator"
 	"k8s.io/kubernetes/pkg/registry/service/portallocator"
@@ -93,17 +97,106 @@ import (
 
 const (
 	DefaultEtcdPathPrefix = "/registry"
+	// DefaultReconcileInterval is the default period at which the kubernetes
+	// service and its endpoints are reconciled.
+	DefaultReconcileInterval = 10 * time.Second
+	// DefaultMaxRequestBodyBytes is the default limit on the size of request bodies.
+	DefaultMaxRequestBodyBytes = 10 * 1024 * 1024
+	// DefaultMaxThirdPartyObjectBytes is the default limit on the size of third
+	// party objects.
+	DefaultMaxThirdPartyObjectBytes = 1024 * 1024
+	// DefaultThirdPartyDrainTimeout is the default time the removal of a third
+	// party resource waits for its in-flight requests to complete.
+	DefaultThirdPartyDrainTimeout = 30 * time.Second
+	// DefaultShutdownDelay is the default time a draining master keeps serving
+	// requests before it shuts down.
+	DefaultShutdownDelay = 10 * time.Second
+	// DefaultHealthzRetryBackoff is the default delay before the first retry of
+	// a failed component health check.
+	DefaultHealthzRetryBackoff = 100 * time.Millisecond
+	// DefaultTunnelSyncHealthThreshold is the default time since the last
+	// successful sync of the SSH tunnels after which they are reported unhealthy.
+	DefaultTunnelSyncHealthThreshold = 600 * time.Second
+	// NodeAddressSelectionFirst always tunnels to the first external address of a node.
+	NodeAddressSelectionFirst NodeAddressSelection = "first"
+	// NodeAddressSelectionFailover keeps tunneling to the same external address
+	// of a node until the tunneler fails to open a tunnel to it, e.g. because it
+	// is unreachable, and then moves on to the node's next address.
+	NodeAddressSelectionFailover NodeAddressSelection = "failover"
+	// DefaultMasterLeaseGracePeriod is the default time by which the lease of an
+	// apiserver outlives the reconcile interval at which it is renewed.
+	DefaultMasterLeaseGracePeriod = 5 * time.Second
+	// DefaultMasterLeaseTTL is the default lifetime of the lease of an apiserver
+	// when the endpoints of the kubernetes service are reconciled from leases.
+	DefaultMasterLeaseTTL = DefaultReconcileInterval + DefaultMasterLeaseGracePeriod
+	// MasterCountEndpointReconcilerType publishes the IPs of at most MasterCount
+	// apiservers in the endpoints of the kubernetes service.
+	MasterCountEndpointReconcilerType EndpointReconcilerType = "master-count"
+	// LeaseEndpointReconcilerType publishes the IPs of the apiservers holding a
+	// lease in storage, which each apiserver renews whenever it reconciles the
+	// endpoints of the kubernetes service.
+	LeaseEndpointReconcilerType EndpointReconcilerType = "lease"
+	// DefaultLongRunningRequestRE matches the paths of the requests that are
+	// expected to stay open for a long time, e.g. watches and exec sessions.
+	// TODO: This can be tightened up. It still matches objects named watch or proxy.
+	DefaultLongRunningRequestRE = "(/|^)((watch|proxy)(/|$)|(logs?|portforward|exec|attach)/?$)"
 )
 
+// EndpointReconcilerType selects how the endpoints of the kubernetes service
+// are reconciled.
+type EndpointReconcilerType string
+
+// NodeAddressSelection is the strategy used to pick one of the external
+// addresses of a node to tunnel to.
+type NodeAddressSelection string
+
 // StorageDestinations is a mapping from API group & resource to
 // the underlying storage interfaces.
 type StorageDestinations struct {
 	APIGroups map[string]*StorageDestinationsForAPIGroup
+	// Default is used for any group or resource that has no destination of its own.
+	Default storage.Interface
 }
 
 type StorageDestinationsForAPIGroup struct {
 	Default   storage.Interface
 	Overrides map[string]storage.Interface
+	// Codec is the codec objects of the group are encoded with in storage. While the
+	// group is migrated between storage versions, its storage should be built with
+	// the codec returned by storage.NewMigrationCodec for the group's storage and read
+	// versions, so that Codec always encodes in the storage version. Storage built
+	// with storage.NewStorageVersionCodec can change its version at runtime, see
+	// Master.SetStorageVersion.
+	Codec runtime.Codec
+	// Timeout bounds every storage operation on the group's resources, including
+	// those stored in overrides. Zero means no timeout.
+	Timeout time.Duration
+	// Prefix is prepended to the keys of the group's resources, including those
+	// stored in overrides, so that several logical clusters can share one etcd.
+	// It is relative to the path prefix of the destination's own storage.
+	Prefix string
+	// RateLimit, if set, limits the rate of the storage operations that the
+	// requests of API clients make on the group's resources, including those stored
+	// in overrides, which share it. The operations of the apiserver's own
+	// controllers and watch caches aren't limited. It is set by
+	// SetAPIGroupRateLimit.
+	RateLimit *StorageRateLimit
+
+	rateLimiter *storage.RateLimiter
+}
+
+// StorageRateLimit is a token bucket limiting the rate of storage operations, so
+// that a burst of requests, e.g. of lists from a misbehaving controller, can't
+// overwhelm etcd.
+type StorageRateLimit struct {
+	// The average number of operations started per second. Must be positive.
+	QPS float32
+	// The number of operations that can start at once. Must be positive.
+	Burst int
+	// How long an operation waits for the limiter before the request it is made
+	// for fails with a 429 Too Many Requests. Zero fails the operations that can't
+	// start right away instead of making them wait.
+	MaxWait time.Duration
 }
 
 func NewStorageDestinations() StorageDestinations {
@@ -112,41 +205,127 @@ func NewStorageDestinations() StorageDestinations {
 	}
 }
 
+// AddAPIGroup sets the default destination of the given group, and records
+// the codec of defaultStorage as the group's storage codec.
 func (s *StorageDestinations) AddAPIGroup(group string, defaultStorage storage.Interface) {
+	var codec runtime.Codec
+	if defaultStorage != nil {
+		codec = defaultStorage.Codec()
+	}
+	s.AddAPIGroupWithCodec(group, defaultStorage, codec)
+}
+
+// AddAPIGroupWithPrefix sets the default destination of the given group like
+// AddAPIGroup, and stores the keys of all of the group's resources, third party
+// objects included, under prefix.
+func (s *StorageDestinations) AddAPIGroupWithPrefix(group string, defaultStorage storage.Interface, prefix string) {
+	s.AddAPIGroup(group, defaultStorage)
+	s.APIGroups[group].Prefix = prefix
+}
+
+// AddAPIGroupWithCodec sets the default destination of the given group and
+// records codec as the codec the group's objects are encoded with in storage.
+func (s *StorageDestinations) AddAPIGroupWithCodec(group string, defaultStorage storage.Interface, codec runtime.Codec) {
 	s.APIGroups[group] = &StorageDestinationsForAPIGroup{
 		Default:   defaultStorage,
 		Overrides: map[string]storage.Interface{},
+		Codec:     codec,
 	}
 }
 
-func (s *StorageDestinations) AddStorageOverride(group, resource string, override storage.Interface) {
+// AddAPIResource stores the given resource of the group in override instead of
+// the group's default destination. Resource names are matched case-insensitively,
+// so "replicationControllers" and "replicationcontrollers" refer to the same resource.
+func (s *StorageDestinations) AddAPIResource(group, resource string, override storage.Interface) {
 	if _, ok := s.APIGroups[group]; !ok {
 		s.AddAPIGroup(group, nil)
 	}
 	if s.APIGroups[group].Overrides == nil {
 		s.APIGroups[group].Overrides = map[string]storage.Interface{}
 	}
-	s.APIGroups[group].Overrides[resource] = override
+	s.APIGroups[group].Overrides[strings.ToLower(resource)] = override
+}
+
+// SetAPIGroupTimeout makes the storage operations on the resources of the given
+// group that take longer than timeout fail with a timeout error. A group without
+// a destination of its own keeps using s.Default, with the timeout applied.
+func (s *StorageDestinations) SetAPIGroupTimeout(group string, timeout time.Duration) {
+	if _, ok := s.APIGroups[group]; !ok {
+		s.AddAPIGroup(group, nil)
+	}
+	s.APIGroups[group].Timeout = timeout
 }
 
-func (s *StorageDestinations) get(group, resource string) storage.Interface {
+// SetAPIGroupRateLimit limits the rate of the storage operations on the resources
+// of the given group to limit. A group without a destination of its own keeps
+// using s.Default, with the limit applied.
+func (s *StorageDestinations) SetAPIGroupRateLimit(group string, limit StorageRateLimit) {
+	if _, ok := s.APIGroups[group]; !ok {
+		s.AddAPIGroup(group, nil)
+	}
+	s.APIGroups[group].RateLimit = &limit
+	s.APIGroups[group].rateLimiter = nil
+	// An invalid limit is rejected by validateConfig.
+	if limit.QPS > 0 && limit.Burst > 0 && limit.MaxWait >= 0 {
+		s.APIGroups[group].rateLimiter = storage.NewRateLimiter(float64(limit.QPS), limit.Burst, limit.MaxWait)
+	}
+}
+
+// AddStorageOverride is an alias of AddAPIResource.
+// TODO: remove once downstream consumers have switched to AddAPIResource.
+func (s *StorageDestinations) AddStorageOverride(group, resource string, override storage.Interface) {
+	s.AddAPIResource(group, resource, override)
+}
+
+// Get returns the storage destination for the given resource. A resource-level
+// override takes precedence over the group's default, and the group's default
+// takes precedence over s.Default. Returns nil if none of them is set. The
+// returned destination enforces the group's timeout and rate limit and stores its
+// keys under the group's prefix, if it has them.
+func (s *StorageDestinations) Get(group, resource string) storage.Interface {
 	apigroup, ok := s.APIGroups[group]
 	if !ok {
-		glog.Errorf("No storage defined for API group: '%s'", apigroup)
+		if s.Default == nil {
+			glog.Errorf("No storage defined for API group: '%s'", group)
+		}
+		return s.Default
+	}
+	destination := apigroup.Default
+	if client, exists := apigroup.Overrides[strings.ToLower(resource)]; exists {
+		destination = client
+	} else if destination == nil {
+		destination = s.Default
+	}
+	if destination == nil {
 		return nil
 	}
-	if apigroup.Overrides != nil {
-		if client, exists := apigroup.Overrides[resource]; exists {
-			return client
-		}
+	// The time an operation waits for the rate limiter counts against the timeout.
+	destination = storage.NewRateLimitedStorage(storage.NewPrefixStorage(destination, apigroup.Prefix), apigroup.rateLimiter)
+	return storage.NewTimeoutStorage(destination, apigroup.Timeout)
+}
+
+// Codec returns the codec the objects of the given group are encoded with in
+// storage. Groups without a codec of their own use the codec of s.Default.
+// Returns nil if neither is set.
+func (s *StorageDestinations) Codec(group string) runtime.Codec {
+	if apigroup, ok := s.APIGroups[group]; ok && apigroup.Codec != nil {
+		return apigroup.Codec
+	}
+	if s.Default != nil {
+		return s.Default.Codec()
 	}
-	return apigroup.Default
+	return nil
 }
 
 // Get all backends for all registered storage destinations.
 // Used for getting all instances for health validations.
 func (s *StorageDestinations) backends() []string {
 	backends := sets.String{}
+	if s.Default != nil {
+		for _, backend := range s.Default.Backends(context.TODO()) {
+			backends.Insert(backend)
+		}
+	}
 	for _, group := range s.APIGroups {
 		if group.Default != nil {
 			for _, backend := range group.Default.Backends(context.TODO()) {
@@ -178,30 +357,177 @@ type Config struct {
 	StorageDestinations StorageDestinations
 	// StorageVersions is a map between groups and their storage versions
 	StorageVersions map[string]string
-	EventTTL        time.Duration
-	KubeletClient   kubeletclient.KubeletClient
+	// StorageReadVersions is a map between groups and the versions, besides their
+	// storage versions, that their objects may still be stored in during a migration.
+	// Objects are only ever written in the storage version, and are not rewritten
+	// when they are read: an object stays in its read version until it is written
+	// again. Every group with read versions must have a storage version.
+	StorageReadVersions map[string][]string
+	EventTTL            time.Duration
+	KubeletClient       kubeletclient.KubeletClient
 	// allow downstream consumers to disable the core controller loops
 	EnableCoreControllers bool
 	EnableLogsSupport     bool
 	EnableUISupport       bool
+	// DisableCoreAPI, if set, doesn't install the legacy API group at APIPrefix,
+	// not even its discovery. The core controller loops, which reconcile objects of
+	// that group, are not started either.
+	DisableCoreAPI bool
+	// If set along with EnableUISupport, the files in this directory are served at
+	// /ui/ instead of redirecting to the built-in dashboard. It must be a directory.
+	UIAssetPath string
+	// If set along with EnableIndex, this file is served at /favicon.ico. Otherwise
+	// /favicon.ico is answered with 204 No Content, rather than a 404 logged for
+	// every browser that opens the master.
+	FaviconPath string
 	// allow downstream consumers to disable swagger
 	EnableSwaggerSupport bool
+	// allow downstream consumers to enable the Swagger 2.0 spec at /swagger.json
+	EnableOpenAPISupport bool
+	// FeatureGates turns optional features of the master, named by the Feature
+	// constants, on or off. The features without a gate keep their default. The
+	// optional features with a field of their own, e.g. EnableCompression, are
+	// turned on or off by their field only.
+	FeatureGates map[string]bool
 	// Allows api group versions or specific resources to be conditionally enabled/disabled.
 	APIGroupVersionOverrides map[string]APIGroupVersionOverride
+	// If true, API groups without any installed resources are not listed at /apis.
+	HideEmptyAPIGroups bool
+	// If specified, receives an event for every create, update and delete of
+	// third party resource objects.
+	ThirdPartyAuditSink ThirdPartyAuditSink
+	// Third party resources installed by init before the master serves traffic, so
+	// that their APIs are available without ThirdPartyResource objects in etcd.
+	// They are not removed by the third party resource sync, only by
+	// RemoveThirdPartyResource. Requires extensions/v1beta1 to be enabled.
+	PreinstalledThirdPartyResources []extensions.ThirdPartyResource
+	// Maps the names of third party resources, e.g. foo.company.com, to the
+	// namespace their objects are created in and read from when their URL has no
+	// namespace, e.g. /apis/company.com/v1/foos/bar. This is a compatibility shim
+	// for legacy clients; the third party resources not listed only have
+	// namespaced URLs for single objects.
+	ThirdPartyDefaultNamespaces map[string]string
+	// If true, the creations and updates of namespaced objects, third party ones
+	// included, fail unless their namespace exists, and the creations fail if it
+	// is terminating, whatever the admission control.
+	RequireNamespaceExists bool
+	// Map from resources, e.g. "pods" or "company.com/foos", to the number of
+	// their objects a namespace can have. The creations over the limit are refused
+	// with 403 Forbidden by the NamespaceObjectLimits admission plugin, which runs
+	// after the admission control.
+	NamespaceObjectLimits map[string]int
+	// If true, the creations and updates of objects fail with a 422 if their body
+	// has fields that their versioned type doesn't have.
+	StrictDecoding bool
+	// If true, the creations and updates of third party objects fail with a 422 if
+	// their metadata has fields that object metadata doesn't have. Only the metadata
+	// is checked: a ThirdPartyResource can't declare a schema, so the rest of a third
+	// party object is opaque and stored as is, unknown fields included.
+	StrictThirdPartyDecoding bool
+	// Maps the built-in group versions that are deprecated, e.g. extensions/v1beta1,
+	// to the message sent in a Warning header with every response of their
+	// handlers, e.g. to announce when they will be removed.
+	DeprecatedAPIGroupVersions map[string]string
+	// The content type of the built-in objects returned to the clients that don't
+	// prefer one in their Accept header, apiserver.JSONContentType if empty. The
+	// API types have no protobuf encoding yet, so apiserver.ProtobufContentType is
+	// refused, and the clients that ask for protobuf get JSON. Third party objects
+	// are only ever JSON.
+	DefaultContentType string
+	// If set, the self links of the objects returned, third party ones included,
+	// are rebased onto this prefix instead of the path of the request, so that they
+	// are the URLs clients reach the API at behind a gateway that rewrites paths.
+	// It is a path, like /k8s, or a URL, like https://gateway.example.com/k8s.
+	SelfLinkPrefix string
+	// The CIDRs, like 10.0.0.0/8, of the proxies trusted to forward requests. The
+	// client address of a request from one of them is taken from its X-Forwarded-For
+	// or X-Real-IP header, and logged and audited rather than the proxy's address.
+	// The headers of the requests from other peers are ignored, as they may be forged.
+	TrustedProxyCIDRs []string
+	// If true, response bodies larger than 1KB are gzip or deflate encoded for the
+	// clients that accept it. Watches and other long running requests are not compressed.
+	EnableCompression bool
+	// If true along with EnableProfiling, block and mutex contention is sampled and
+	// served at /debug/pprof/block and /debug/pprof/mutex. The mutex contention is
+	// only profiled by the builds with Go 1.8 or later. This slows down every
+	// contended lock, so it is off by default.
+	EnableContentionProfiling bool
+	// If true, the metrics registered against MetricsRegistry are served at /metrics.
+	EnableMetrics bool
+	// The registry the metrics owned by the master are registered against, so that
+	// several masters can run in one process. Defaults to DefaultMetricsRegistry.
+	MetricsRegistry MetricsRegistry
+	// If set, every request is traced by a span of Tracer, and the storage calls
+	// made for it, including those of third party resources, by child spans.
+	Tracer Tracer
 	// allow downstream consumers to disable the index route
-	EnableIndex           bool
-	EnableProfiling       bool
-	EnableWatchCache      bool
+	EnableIndex     bool
+	EnableProfiling bool
+	// If true, the storage of every resource is served from a watch cache, an
+	// in-memory copy of its objects kept up to date by watching etcd, which serves
+	// the lists and watches without reading etcd.
+	EnableWatchCache bool
+	// The resources served from a watch cache if EnableWatchCache is false, in the
+	// form of the keys of WatchCacheResyncPeriods. The others are served from etcd
+	// directly.
+	WatchCacheResources []string
+	// The number of events the watch cache of each resource keeps, in place of the
+	// capacity its registry asks for, 100 or 1000 for the busiest resources, if
+	// positive. The watches served from a cache can start from, or resume after a
+	// disconnection at, the resource version of any event it keeps; the older
+	// ones fail with a "too old resource version" error, after which the clients
+	// must relist. A cache keeps the events of the last capacity/rate seconds of a
+	// resource changed at a given rate of events per second, so a resource with a
+	// high churn, like pods or events, needs a larger cache for the same window.
+	// The apiserver_watch_cache_fullness and apiserver_watch_cache_evictions_total
+	// metrics show how full each cache is and the rate at which its oldest events
+	// are evicted.
+	DefaultWatchCacheSize int
+	// Overrides DefaultWatchCacheSize for some resources, in the form of the keys
+	// of WatchCacheResyncPeriods.
+	WatchCacheSizes map[string]int
+	// If positive, the period at which the watch caches relist their objects from
+	// etcd, so that they don't serve a change they missed for longer. A relist is
+	// costly: it reads every object of the resource from etcd, and ends every watch
+	// served from the cache, so that all their clients list and watch again at
+	// once. Zero, the default, means the caches only relist when they fall behind
+	// etcd.
+	WatchCacheResyncPeriod time.Duration
+	// Overrides WatchCacheResyncPeriod for some resources, e.g. a period for pods
+	// only, or none for events. A resource is "<resource>" in the legacy group and
+	// "<group>/<resource>" in other groups.
+	WatchCacheResyncPeriods map[string]time.Duration
+
 	APIPrefix             string
 	APIGroupPrefix        string
 	CorsAllowedOriginList []string
 	Authenticator         authenticator.Request
+	// If true, the requests that the Authenticator can't identify, or all the
+	// requests if there is none, are made as the system:anonymous user, in the
+	// system:unauthenticated group, and left to the Authorizer. Otherwise they are
+	// rejected with a 401 Unauthorized if there is an Authenticator.
+	AnonymousAuthEnabled bool
 	// TODO(roberthbailey): Remove once the server no longer supports http basic auth.
 	SupportsBasicAuth      bool
 	Authorizer             authorizer.Authorizer
 	AdmissionControl       admission.Interface
 	MasterServiceNamespace string
 
+	// If set, an external HTTPS service admits, denies or patches the creations and
+	// updates of core, extensions and third party objects, after AdmissionControl.
+	// The webhook is sent the objects, except those of Secrets, of which it is only
+	// sent the kind, namespace and name.
+	AdmissionWebhook *webhook.Config
+	// Maps the names of admission plugins, those of AdmissionControl or "Webhook",
+	// to the only resources they admit the requests for, so that expensive plugins
+	// don't run for the resources they don't care about, e.g. {"ResourceQuota":
+	// ["pods", "extensions/jobs"]}. A resource is "<resource>" in the legacy group
+	// and "<group>/<resource>" in other groups, third party ones included, where
+	// <resource> may be "*" for all the resources of the group. The plugins not listed
+	// admit the requests for all resources. Requires AdmissionControl to be created
+	// by admission.NewFromPlugins.
+	AdmissionPluginResources map[string][]string
+
 	// Map requests to contexts. Exported so downstream consumers can provider their own mappers
 	RequestContextMapper api.RequestContextMapper
 
@@ -212,6 +538,55 @@ type Config struct {
 	// Note that it is up to the request handlers to ignore or honor this timeout. In seconds.
 	MinRequestTimeout int
 
+	// The largest request body, in bytes, the API handlers will read. Larger requests
+	// are rejected with 413 Request Entity Too Large. Defaults to DefaultMaxRequestBodyBytes
+	// if zero, a negative value disables the limit. The long running requests matching
+	// LongRunningRequestRE, e.g. proxy, exec and attach, are exempt.
+	MaxRequestBodyBytes int64
+	// The largest third party object, in bytes, that may be created or updated.
+	// Larger objects are rejected with 413 Request Entity Too Large before they
+	// are stored. Defaults to DefaultMaxThirdPartyObjectBytes if zero, a negative
+	// value disables the limit.
+	MaxThirdPartyObjectBytes int64
+	// How long RemoveThirdPartyResource waits for the in-flight requests of a third
+	// party resource, watches included, to complete before removing its storage and
+	// handlers. New requests for the resource are refused with 503 Service Unavailable
+	// meanwhile. Defaults to DefaultThirdPartyDrainTimeout if zero.
+	ThirdPartyDrainTimeout time.Duration
+	// How long Drain keeps serving requests, once /readyz fails and the watches are
+	// ended, before it shuts the master down, so that the load balancers stop
+	// sending requests to the master and the requests in flight complete. Defaults
+	// to DefaultShutdownDelay if nil. A zero delay shuts the master down right away.
+	ShutdownDelay *time.Duration
+
+	// The longest time a request may run before its context is cancelled and it is
+	// answered with 504 Gateway Timeout. Watches and the requests matching
+	// LongRunningRequestRE are exempt. Zero disables the limit.
+	MaxRequestTimeout time.Duration
+	// Matches the paths of long running requests. Defaults to DefaultLongRunningRequestRE.
+	LongRunningRequestRE *regexp.Regexp
+
+	// The number of read-only (get and list) and mutating (create, update, patch
+	// and delete) requests Handler serves concurrently, limited separately so that
+	// a flood of one kind can't starve the other. Requests over a limit are rejected
+	// with 429 Too Many Requests. Watches and long running requests are not counted.
+	// Zero disables a limit. InsecureHandler is not limited.
+	MaxReadOnlyInflight int
+	MaxMutatingInflight int
+	// The number of watches Handler serves concurrently, limited separately from the
+	// other read-only requests since they stay open. Zero disables the limit.
+	MaxInflightWatches int
+	// The HTTP methods, e.g. DELETE, that Handler and InsecureHandler reject with
+	// 405 Method Not Allowed on every path, third party resources included, before
+	// the requests are authenticated or routed.
+	DisallowedMethods []string
+
+	// The number of times a failed component health check is retried before the
+	// component is reported unhealthy, with a delay of HealthzRetryBackoff before
+	// the first retry, doubling with every subsequent one. Zero disables retries.
+	HealthzRetries      int
+	HealthzRetryBackoff time.Duration
+
 	// Number of masters running; all masters must be started with the
 	// same value for this field. (Numbers > 1 currently untested.)
 	MasterCount int
@@ -228,8 +603,9 @@ type Config struct {
 	// If nil or 0.0.0.0, the host's default interface will be used.
 	PublicAddress net.IP
 
-	// Control the interval that pod, node IP, and node heath status caches
-	// expire.
+	// CacheTimeout has no effect: the pod, node IP and node health status caches
+	// it used to expire are gone. The watch caches are relisted every
+	// WatchCacheResyncPeriod, or WatchCacheResyncPeriods for some resources.
 	CacheTimeout time.Duration
 
 	// The range of IPs to be assigned to services with type=ClusterIP or greater
@@ -238,15 +614,40 @@ type Config struct {
 	// The IP address for the master service (must be inside ServiceClusterIPRange
 	ServiceReadWriteIP net.IP
 
+	// IPs inside ServiceClusterIPRange that are never assigned to services, e.g.
+	// because they are used by infrastructure outside of the cluster. Requires
+	// ServiceClusterIPRange to be set, and must not include ServiceReadWriteIP.
+	ReservedServiceIPs []net.IP
+
 	// The range of ports to be assigned to services with type=NodePort or greater
 	ServiceNodePortRange util.PortRange
 
 	// Used to customize default proxy dial/tls options
 	ProxyDialer          apiserver.ProxyDialerFunc
 	ProxyTLSClientConfig *tls.Config
+	// The minimum TLS version the master proxies to nodes, pods and services with.
+	// The proxy uses a copy of ProxyTLSClientConfig whose MinVersion is raised to
+	// it if lower, ProxyTLSClientConfig itself is not changed.
+	// Defaults to TLS 1.2 if zero.
+	ProxyMinTLSVersion uint16
+	// The maximum number of idle connections the proxy transport keeps open,
+	// in total and to each host. Zero means the http.Transport defaults. The total
+	// is only limited by the builds with Go 1.7 or later.
+	ProxyMaxIdleConns        int
+	ProxyMaxIdleConnsPerHost int
 
 	// Used to start and monitor tunneling
 	Tunneler Tunneler
+	// If specified, used to find the address the tunneler connects to for each
+	// node, instead of the node's ExternalIP or LegacyHostIP.
+	TunnelerAddressResolver func(*api.Node) (string, error)
+	// How the tunneler picks among the external addresses of a node when it has
+	// more than one. Defaults to NodeAddressSelectionFirst. Ignored if
+	// TunnelerAddressResolver is specified.
+	NodeAddressSelection NodeAddressSelection
+	// The time since the last successful sync of the SSH tunnels after which
+	// /healthz/ssh-tunnels fails. Defaults to DefaultTunnelSyncHealthThreshold.
+	TunnelSyncHealthThreshold time.Duration
 
 	// Additional ports to be exposed on the master service
 	// extraServicePorts is injectable in the event that more ports
@@ -255,19 +656,61 @@ type Config struct {
 	// service because this pkg is linked by out-of-tree projects
 	// like openshift which want to use the master but also do
 	// more stuff.
+	// A port with a NodePort, which must be in ServiceNodePortRange, makes the
+	// master service a NodePort service; its other ports are then allocated a
+	// node port unless they set one.
 	ExtraServicePorts []api.ServicePort
 	// Additional ports to be exposed on the master endpoints
 	// Port names should align with ports defined in ExtraServicePorts
 	ExtraEndpointPorts []api.EndpointPort
 
 	KubernetesServiceNodePort int
+	// EndpointReconcilerOverride, if set, replaces the default reconciliation of
+	// the kubernetes service endpoints, which adds the IP of each apiserver and
+	// keeps at most MasterCount of them. It allows e.g. a single load balancer
+	// VIP to be published instead.
+	EndpointReconcilerOverride EndpointReconciler
+	// EndpointReconcilerType selects the reconciliation of the kubernetes service
+	// endpoints when EndpointReconcilerOverride is not set. Defaults to
+	// MasterCountEndpointReconcilerType.
+	EndpointReconcilerType EndpointReconcilerType
+	// The lifetime of the lease of an apiserver with LeaseEndpointReconcilerType,
+	// which is how long the address of an apiserver that died stays in the
+	// endpoints. It must be longer than ReconcileInterval by at least
+	// MasterLeaseGracePeriod. Defaults to ReconcileInterval plus
+	// MasterLeaseGracePeriod.
+	MasterLeaseTTL time.Duration
+	// The time by which the lease of an apiserver must outlive the reconcile that
+	// renews it, so that a renewal delayed by a slow storage write doesn't let it
+	// expire. Defaults to DefaultMasterLeaseGracePeriod.
+	MasterLeaseGracePeriod time.Duration
+	// The type of the kubernetes service, either api.ServiceTypeClusterIP or
+	// api.ServiceTypeNodePort. If empty, the service is of type NodePort only if
+	// KubernetesServiceNodePort is set. A NodePort service without a
+	// KubernetesServiceNodePort is allocated a port of ServiceNodePortRange.
+	KubernetesServiceType api.ServiceType
+	// The session affinity of the kubernetes service, either api.ServiceAffinityNone
+	// or api.ServiceAffinityClientIP. Defaults to api.ServiceAffinityNone.
+	KubernetesServiceSessionAffinity api.ServiceAffinity
+
+	// The interval at which the kubernetes service and its endpoints are
+	// reconciled. Defaults to DefaultReconcileInterval if nil. A zero
+	// interval means they are reconciled only once, when the master starts.
+	ReconcileInterval *time.Duration
 }
 
-func (c *Config) storageDecorator() generic.StorageDecorator {
-	if c.EnableWatchCache {
-		return genericetcd.StorageWithCacher
+// storageDecorator returns the decorator of the storage of the resource of group.
+// Its watch cache, if any, is added to caches unless it is nil.
+func (c *Config) storageDecorator(group, resource string, caches *watchCacheCollector) generic.StorageDecorator {
+	decorator := generic.UndecoratedStorage
+	if c.watchCacheEnabled(group, resource) {
+		decorator = c.watchCacheDecorator(group, resource, caches)
+	}
+	return func(s storage.Interface, capacity int, objectType runtime.Object, resourcePrefix string, namespaceScoped bool, newListFunc func() runtime.Object) storage.Interface {
+		s = decorator(s, capacity, objectType, resourcePrefix, namespaceScoped, newListFunc)
+		resource := strings.TrimPrefix(resourcePrefix, "/")
+		return newTracedStorage(newRequestLoggedStorage(s, resource), c.Tracer, resource)
 	}
-	return generic.UndecoratedStorage
 }
 
 type InstallSSHKey func(user string, data []byte) error
@@ -276,20 +719,44 @@ type InstallSSHKey func(user string, data []byte) error
 type Master struct {
 	// "Inputs", Copied from Config
 	serviceClusterIPRange *net.IPNet
+	reservedServiceIPs    []net.IP
 	serviceNodePortRange  util.PortRange
 	cacheTimeout          time.Duration
 	minRequestTimeout     time.Duration
+	storageDestinations   StorageDestinations
+	storageVersions       map[string]string
+	storageReadVersions   map[string][]string
+	maxRequestBodyBytes   int64
+	maxRequestTimeout     time.Duration
+	longRunningRequestRE  *regexp.Regexp
+	maxReadOnlyInflight   int
+	maxMutatingInflight   int
+	maxInflightWatches    int
+	disallowedMethods     sets.String
+	// storageVersionsLock guards storageVersions and storageReadVersions, which
+	// SetStorageVersion changes at runtime.
+	storageVersionsLock sync.RWMutex
 
 	mux                      apiserver.Mux
 	muxHelper                *apiserver.MuxHelper
 	handlerContainer         *restful.Container
 	rootWebService           *restful.WebService
 	enableCoreControllers    bool
+	disableCoreAPI           bool
 	enableLogsSupport        bool
+	enableIndex              bool
 	enableUISupport          bool
 	enableSwaggerSupport     bool
+	enableOpenAPISupport     bool
+	featureGates             map[string]bool
 	enableProfiling          bool
 	enableWatchCache         bool
+	watchCacheResources      []string
+	defaultWatchCacheSize    int
+	watchCacheSizes          map[string]int
+	watchCacheResyncPeriod   time.Duration
+	watchCacheResyncPeriods  map[string]time.Duration
+	namespaceObjectLimits    map[string]int
 	apiPrefix                string
 	apiGroupPrefix           string
 	corsAllowedOriginList    []string
@@ -302,6 +769,8 @@ type Master struct {
 
 	// External host is the name that should be used in external (public internet) URLs for this master
 	externalHost string
+	// whether externalHost was set by the config, and so is kept by RefreshExternalAddress
+	externalHostConfigured bool
 	// clusterIP is the IP address of the master within the cluster.
 	clusterIP            net.IP
 	publicReadWritePort  int
@@ -310,9 +779,12 @@ type Master struct {
 	masterServices       *util.Runner
 	extraServicePorts    []api.ServicePort
 	extraEndpointPorts   []api.EndpointPort
+	reconcileInterval    time.Duration
 
 	// storage contains the RESTful endpoints exposed by this master
 	storage map[string]rest.Storage
+	// the RESTful endpoints of the extensions group
+	extensionsStorage map[string]rest.Storage
 
 	// registries are internal client APIs for accessing the storage layer
 	// TODO: define the internal typed interface in a way that clients can
@@ -323,6 +795,8 @@ type Master struct {
 	endpointRegistry          endpoint.Registry
 	serviceClusterIPAllocator service.RangeRegistry
 	serviceNodePortAllocator  service.RangeRegistry
+	// allocates the node ports of services, backed by serviceNodePortAllocator
+	serviceNodePorts portallocator.Interface
 
 	// "Outputs"
 	Handler         http.Handler
@@ -332,17 +806,164 @@ type Master struct {
 	proxyTransport http.RoundTripper
 
 	// Used to start and monitor tunneling
-	tunneler Tunneler
+	tunneler                  Tunneler
+	tunnelerAddressResolver   func(*api.Node) (string, error)
+	nodeAddressSelection      NodeAddressSelection
+	tunnelSyncHealthThreshold time.Duration
+
+	// nodeAddressesLock protects nodeAddresses.
+	nodeAddressesLock sync.Mutex
+	// nodeAddresses is the address selected for each node, with
+	// NodeAddressSelectionFailover.
+	nodeAddresses map[string]string
 
 	// storage for third party objects
 	thirdPartyStorage storage.Interface
 	// map from api path to storage for those objects
 	thirdPartyResources map[string]*thirdpartyresourcedataetcd.REST
+	// map from api path to the requests in flight for those objects
+	thirdPartyInflight map[string]*inflightRequests
+	// map from api path to the kind of those objects and the version it is served in
+	thirdPartyKinds map[string]unversioned.GroupVersionKind
+	// serializes the installations and removals of third party resources, so that
+	// concurrent installations of a resource can't both succeed
+	thirdPartyInstallLock sync.Mutex
 	// protects the map
 	thirdPartyResourcesLock   sync.RWMutex
 	KubernetesServiceNodePort int
+	// reconciles the kubernetes service endpoints; if nil, the bootstrap
+	// controller reconciles them from masterCount
+	endpointReconciler     EndpointReconciler
+	endpointReconcilerType EndpointReconcilerType
+	masterLeaseTTL         time.Duration
+	// the type of the kubernetes service
+	kubernetesServiceType api.ServiceType
+	// the session affinity of the kubernetes service
+	kubernetesServiceSessionAffinity api.ServiceAffinity
+
+	// receives an event for every mutation of a third party resource
+	thirdPartyAuditSink ThirdPartyAuditSink
+	// the registry of the master's metrics, and the metrics of the API requests and third
+	// party resource requests
+	metricsRegistry   MetricsRegistry
+	requestMetrics    *metrics.RequestMetrics
+	thirdPartyMetrics *thirdPartyMetrics
+	// the metrics of the watch caches of the storage of the resources
+	watchCaches *watchCacheCollector
+	// traces requests and their storage calls
+	tracer Tracer
+	// compress large responses
+	enableCompression bool
+	// third party resources installed by init
+	preinstalledThirdPartyResources []extensions.ThirdPartyResource
+	// map from the group and resource of a third party resource, e.g.
+	// company.com/foos, to the namespace of its URLs without one
+	thirdPartyDefaultNamespaces map[string]string
+	// fail the writes to namespaces that don't exist
+	requireNamespaceExists bool
+	// fail the writes of objects with unknown fields
+	strictDecoding           bool
+	strictThirdPartyDecoding bool
+	// the limit on the size of third party objects, or a negative value for none
+	maxThirdPartyObjectBytes int64
+	// how long the removal of a third party resource waits for its requests
+	thirdPartyDrainTimeout time.Duration
+	shutdownDelay          time.Duration
+	// map from the deprecated group versions to their deprecation message
+	deprecatedAPIGroupVersions map[string]string
+	// the content type of the responses to the clients without a preference
+	defaultContentType string
+	// the prefix the self links of the objects returned are rebased onto
+	selfLinkPrefix string
+	// the file served at /favicon.ico, if any
+	faviconPath string
+	// the networks of the proxies whose forwarded client addresses are trusted
+	trustedProxies []*net.IPNet
+
+	// bootstrapController is the controller started by init if core controllers are enabled.
+	bootstrapController *Controller
+	// stopCh is closed by Shutdown to terminate the master's background loops.
+	stopCh chan struct{}
+	// shutdownOnce guards the teardown performed by Shutdown.
+	shutdownOnce sync.Once
+	// inflight tracks the requests being served by Handler and InsecureHandler.
+	inflight inflightRequests
+	// draining is closed by Drain and Shutdown to end the watches being served.
+	draining closeSignal
+
+	// swaggerConfig is the configuration InstallSwaggerAPI was called with, nil if
+	// swagger is not installed.
+	swaggerConfig *swagger.Config
+	// swaggerContainer serves the swagger API and UI. It is rebuilt whenever
+	// third party resources are installed or removed.
+	swaggerContainer *restful.Container
+	// protects externalHost, swaggerConfig and swaggerContainer
+	swaggerLock sync.RWMutex
+}
+
+// inflightRequests counts the requests currently being served so that they
+// can be drained on shutdown, or before a third party resource is removed. The
+// zero value is ready to use.
+type inflightRequests struct {
+	lock     sync.Mutex
+	count    int
+	draining bool
+	drained  chan struct{}
+}
+
+// start registers a new request. It returns false if the master is draining,
+// in which case the request must be rejected.
+func (r *inflightRequests) start() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.draining {
+		return false
+	}
+	r.count++
+	return true
+}
+
+// done unregisters a request previously registered with start.
+func (r *inflightRequests) done() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.count--
+	if r.draining && r.count == 0 {
+		close(r.drained)
+	}
+}
+
+// drain stops accepting new requests and returns a channel that is closed
+// once all in-flight requests have completed.
+func (r *inflightRequests) drain() <-chan struct{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !r.draining {
+		r.draining = true
+		r.drained = make(chan struct{})
+		if r.count == 0 {
+			close(r.drained)
+		}
+	}
+	return r.drained
+}
+
+// track wraps handler so that requests it serves are counted, and rejects
+// new requests once draining has started.
+func (r *inflightRequests) track(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !r.start() {
+			http.Error(w, "apiserver is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer r.done()
+		handler.ServeHTTP(w, req)
+	})
 }
 
+// defaultServiceNodePortRange is the ServiceNodePortRange of configs that do not set one.
+var defaultServiceNodePortRange = util.PortRange{Base: 30000, Size: 2768}
+
 // setDefaults fills in any fields not set that are required to have valid data.
 func setDefaults(c *Config) {
 	if c.ServiceClusterIPRange == nil {
@@ -371,7 +992,6 @@ func setDefaults(c *Config) {
 		// We should probably allow this for clouds that don't require NodePort to do load-balancing (GCE)
 		// but then that breaks the strict nestedness of ServiceType.
 		// Review post-v1
-		defaultServiceNodePortRange := util.PortRange{Base: 30000, Size: 2768}
 		c.ServiceNodePortRange = defaultServiceNodePortRange
 		glog.Infof("Node port range unspecified. Defaulting to %v.", c.ServiceNodePortRange)
 	}
@@ -388,6 +1008,68 @@ func setDefaults(c *Config) {
 	if c.RequestContextMapper == nil {
 		c.RequestContextMapper = api.NewRequestContextMapper()
 	}
+	if c.ProxyMinTLSVersion == 0 {
+		c.ProxyMinTLSVersion = tls.VersionTLS12
+	}
+	if c.TunnelSyncHealthThreshold == 0 {
+		c.TunnelSyncHealthThreshold = DefaultTunnelSyncHealthThreshold
+	}
+	if len(c.NodeAddressSelection) == 0 {
+		c.NodeAddressSelection = NodeAddressSelectionFirst
+	}
+	if c.HealthzRetryBackoff == 0 {
+		c.HealthzRetryBackoff = DefaultHealthzRetryBackoff
+	}
+	if c.MaxRequestBodyBytes == 0 {
+		c.MaxRequestBodyBytes = DefaultMaxRequestBodyBytes
+	}
+	if c.MaxThirdPartyObjectBytes == 0 {
+		c.MaxThirdPartyObjectBytes = DefaultMaxThirdPartyObjectBytes
+	}
+	if c.ThirdPartyDrainTimeout == 0 {
+		c.ThirdPartyDrainTimeout = DefaultThirdPartyDrainTimeout
+	}
+	if c.ShutdownDelay == nil {
+		shutdownDelay := DefaultShutdownDelay
+		c.ShutdownDelay = &shutdownDelay
+	}
+	if c.LongRunningRequestRE == nil {
+		c.LongRunningRequestRE = regexp.MustCompile(DefaultLongRunningRequestRE)
+	}
+	if c.EndpointReconcilerType == "" {
+		c.EndpointReconcilerType = MasterCountEndpointReconcilerType
+	}
+	if c.ReconcileInterval == nil {
+		reconcileInterval := DefaultReconcileInterval
+		c.ReconcileInterval = &reconcileInterval
+	}
+	if c.MasterLeaseGracePeriod == 0 {
+		c.MasterLeaseGracePeriod = DefaultMasterLeaseGracePeriod
+	}
+	if c.MasterLeaseTTL == 0 {
+		c.MasterLeaseTTL = defaultMasterLeaseTTL(*c.ReconcileInterval, c.MasterLeaseGracePeriod)
+	}
+	c.CorsAllowedOriginList = anchorCORSOrigins(c.CorsAllowedOriginList)
+}
+
+// anchorCORSOrigins returns the given CORS allowed origin patterns with every
+// pattern anchored to match the whole origin. Unanchored patterns match any origin
+// containing them (e.g. "http://example\.com" matches "http://example.com.attacker.net"),
+// which is almost never what was intended, so they are anchored, with a warning.
+// Every pattern is wrapped in a group, even one that starts with ^ and ends with $:
+// the anchors of "^a\.com|b\.com$" only apply to one alternative each.
+func anchorCORSOrigins(origins []string) []string {
+	if len(origins) == 0 {
+		return origins
+	}
+	anchored := make([]string, 0, len(origins))
+	for _, origin := range origins {
+		if !strings.HasPrefix(origin, "^") || !strings.HasSuffix(origin, "$") {
+			glog.Warningf("CORS allowed origin %q is not anchored, only origins matching it entirely will be allowed.", origin)
+		}
+		anchored = append(anchored, "^(?:"+origin+")$")
+	}
+	return anchored
 }
 
 // New returns a new instance of Master from the given config.
@@ -397,9 +1079,13 @@ func setDefaults(c *Config) {
 //   ServiceNodePortRange
 //   MasterCount
 //   ReadWritePort
-//   PublicAddress
 // Certain config fields must be specified, including:
 //   KubeletClient
+//   PublicAddress
+//   StorageDestinations
+// APIPrefix and APIGroupPrefix are normalized to a single leading slash and
+// no trailing slash. An *InvalidConfigError is returned if the config can't
+// be used to create a master.
 // Public fields:
 //   Handler -- The returned master has a field TopHandler which is an
 //   http.Handler which handles all the endpoints provided by the master,
@@ -414,22 +1100,33 @@ func setDefaults(c *Config) {
 //   If the caller wants to add additional endpoints not using the master's
 //   auth, then the caller should create a handler for those endpoints, which delegates the
 //   any unhandled paths to "Handler".
-func New(c *Config) *Master {
-	setDefaults(c)
-	if c.KubeletClient == nil {
-		glog.Fatalf("master.New() called with config.KubeletClient == nil")
+func New(c *Config) (*Master, error) {
+	if err := validateConfig(c); err != nil {
+		return nil, err
 	}
+	setDefaults(c)
 
 	m := &Master{
 		serviceClusterIPRange:    c.ServiceClusterIPRange,
+		reservedServiceIPs:       c.ReservedServiceIPs,
 		serviceNodePortRange:     c.ServiceNodePortRange,
 		rootWebService:           new(restful.WebService),
-		enableCoreControllers:    c.EnableCoreControllers,
+		enableCoreControllers:    c.EnableCoreControllers && !c.DisableCoreAPI,
+		disableCoreAPI:           c.DisableCoreAPI,
 		enableLogsSupport:        c.EnableLogsSupport,
+		enableIndex:              c.EnableIndex,
 		enableUISupport:          c.EnableUISupport,
 		enableSwaggerSupport:     c.EnableSwaggerSupport,
+		enableOpenAPISupport:     c.EnableOpenAPISupport,
+		featureGates:             c.FeatureGates,
 		enableProfiling:          c.EnableProfiling,
 		enableWatchCache:         c.EnableWatchCache,
+		watchCacheResources:      c.WatchCacheResources,
+		defaultWatchCacheSize:    c.DefaultWatchCacheSize,
+		watchCacheSizes:          c.WatchCacheSizes,
+		watchCacheResyncPeriod:   c.WatchCacheResyncPeriod,
+		watchCacheResyncPeriods:  c.WatchCacheResyncPeriods,
+		namespaceObjectLimits:    c.NamespaceObjectLimits,
 		apiPrefix:                c.APIPrefix,
 		apiGroupPrefix:           c.APIGroupPrefix,
 		corsAllowedOriginList:    c.CorsAllowedOriginList,
@@ -438,23 +1135,93 @@ func New(c *Config) *Master {
 		admissionControl:         c.AdmissionControl,
 		apiGroupVersionOverrides: c.APIGroupVersionOverrides,
 		requestContextMapper:     c.RequestContextMapper,
+		thirdPartyAuditSink:      c.ThirdPartyAuditSink,
+		metricsRegistry:          c.MetricsRegistry,
+		tracer:                   c.Tracer,
+		enableCompression:        c.EnableCompression,
+
+		preinstalledThirdPartyResources: c.PreinstalledThirdPartyResources,
+		requireNamespaceExists:          c.RequireNamespaceExists,
+		strictDecoding:                  c.StrictDecoding,
+		strictThirdPartyDecoding:        c.StrictThirdPartyDecoding,
+		deprecatedAPIGroupVersions:      c.DeprecatedAPIGroupVersions,
+		defaultContentType:              c.DefaultContentType,
+		selfLinkPrefix:                  c.SelfLinkPrefix,
+		faviconPath:                     c.FaviconPath,
+		maxThirdPartyObjectBytes:        c.MaxThirdPartyObjectBytes,
+		thirdPartyDrainTimeout:          c.ThirdPartyDrainTimeout,
+		shutdownDelay:                   *c.ShutdownDelay,
 
 		cacheTimeout:      c.CacheTimeout,
 		minRequestTimeout: time.Duration(c.MinRequestTimeout) * time.Second,
 
-		masterCount:         c.MasterCount,
-		externalHost:        c.ExternalHost,
-		clusterIP:           c.PublicAddress,
-		publicReadWritePort: c.ReadWritePort,
-		serviceReadWriteIP:  c.ServiceReadWriteIP,
+		maxRequestBodyBytes:  c.MaxRequestBodyBytes,
+		maxRequestTimeout:    c.MaxRequestTimeout,
+		longRunningRequestRE: c.LongRunningRequestRE,
+		maxReadOnlyInflight:  c.MaxReadOnlyInflight,
+		maxMutatingInflight:  c.MaxMutatingInflight,
+		maxInflightWatches:   c.MaxInflightWatches,
+		storageDestinations:  c.StorageDestinations,
+		storageVersions:      c.StorageVersions,
+		storageReadVersions:  c.StorageReadVersions,
+
+		masterCount:            c.MasterCount,
+		externalHost:           c.ExternalHost,
+		externalHostConfigured: len(c.ExternalHost) > 0,
+		clusterIP:              c.PublicAddress,
+		publicReadWritePort:    c.ReadWritePort,
+		serviceReadWriteIP:     c.ServiceReadWriteIP,
 		// TODO: serviceReadWritePort should be passed in as an argument, it may not always be 443
 		serviceReadWritePort: 443,
 		extraServicePorts:    c.ExtraServicePorts,
 		extraEndpointPorts:   c.ExtraEndpointPorts,
+		reconcileInterval:    *c.ReconcileInterval,
 
-		tunneler: c.Tunneler,
+		tunneler:                  c.Tunneler,
+		tunnelerAddressResolver:   c.TunnelerAddressResolver,
+		nodeAddressSelection:      c.NodeAddressSelection,
+		tunnelSyncHealthThreshold: c.TunnelSyncHealthThreshold,
 
-		KubernetesServiceNodePort: c.KubernetesServiceNodePort,
+		KubernetesServiceNodePort:        c.KubernetesServiceNodePort,
+		kubernetesServiceType:            c.KubernetesServiceType,
+		endpointReconciler:               c.EndpointReconcilerOverride,
+		endpointReconcilerType:           c.EndpointReconcilerType,
+		masterLeaseTTL:                   c.MasterLeaseTTL,
+		kubernetesServiceSessionAffinity: c.KubernetesServiceSessionAffinity,
+
+		stopCh: make(chan struct{}),
+	}
+	if c.AdmissionWebhook != nil {
+		hook, err := webhook.NewWebhook(*c.AdmissionWebhook, m.admissionCodec)
+		if err != nil {
+			return nil, &InvalidConfigError{"AdmissionWebhook", err}
+		}
+		m.admissionControl = admission.AppendPlugin(m.admissionControl, webhookPluginName, hook)
+	}
+	if len(c.AdmissionPluginResources) > 0 {
+		// The plugins and resources have been checked by validateConfig.
+		resources, _ := parseAdmissionPluginResources(c.AdmissionPluginResources)
+		m.admissionControl, _ = admission.ScopePlugins(m.admissionControl, resources)
+	}
+	if len(c.NamespaceObjectLimits) > 0 {
+		m.admissionControl = admission.AppendPlugin(m.admissionControl, namespaceObjectLimitsPluginName, newNamespaceObjectLimits(c.NamespaceObjectLimits, m.namespaceObjectLister))
+	}
+	for _, cidr := range c.TrustedProxyCIDRs {
+		// The CIDRs have been checked by validateConfig.
+		_, network, _ := net.ParseCIDR(cidr)
+		m.trustedProxies = append(m.trustedProxies, network)
+	}
+	m.disallowedMethods = sets.NewString()
+	for _, method := range c.DisallowedMethods {
+		m.disallowedMethods.Insert(strings.ToUpper(method))
+	}
+	if len(c.ThirdPartyDefaultNamespaces) > 0 {
+		m.thirdPartyDefaultNamespaces = map[string]string{}
+		for name, namespace := range c.ThirdPartyDefaultNamespaces {
+			// The names have been checked by validateConfig.
+			key, _ := thirdPartyResourceKey(name)
+			m.thirdPartyDefaultNamespaces[key] = namespace
+		}
 	}
 
 	var handlerContainer *restful.Container
@@ -468,14 +1235,19 @@ func New(c *Config) *Master {
 	}
 	m.handlerContainer = handlerContainer
 	// Use CurlyRouter to be able to use regular expressions in paths. Regular expressions are required in paths for example for proxy (where the path is proxy/{kind}/{name}/{*})
-	m.handlerContainer.Router(restful.CurlyRouter{})
+	// The clients that accept none of the content types of a route get JSON.
+	m.handlerContainer.Router(apiserver.JSONFallbackRouter(restful.CurlyRouter{}))
 	m.muxHelper = &apiserver.MuxHelper{Mux: m.mux, RegisteredPaths: []string{}}
 
 	m.init(c)
 
-	return m
+	return m, nil
 }
 
+// aggregatedDiscoveryPath serves every API group, with the resources of each of
+// its versions, in a single response.
+const aggregatedDiscoveryPath = "/discovery"
+
 // HandleWithAuth adds an http.Handler for pattern to an http.ServeMux
 // Applies the same authentication and authorization (if any is configured)
 // to the request is used for the master's built-in endpoints.
@@ -505,47 +1277,79 @@ func NewHandlerContainer(mux *http.ServeMux) *restful.Container {
 
 // init initializes master.
 func (m *Master) init(c *Config) {
+	if m.metricsRegistry == nil {
+		m.metricsRegistry = DefaultMetricsRegistry
+	}
+	// The watch caches of the last master created against a registry shared by
+	// several masters replace the ones of the others in its metrics.
+	watchCaches, err := m.metricsRegistry.RegisterOrGet(newWatchCacheCollector())
+	if err != nil {
+		glog.Fatalf("Unable to register the watch cache metrics: %v", err)
+	}
+	m.watchCaches = watchCaches.(*watchCacheCollector)
+	requestMetrics, err := m.metricsRegistry.RegisterOrGet(metrics.NewRequestMetrics())
+	if err != nil {
+		glog.Fatalf("Unable to register the request metrics: %v", err)
+	}
+	m.requestMetrics = requestMetrics.(*metrics.RequestMetrics)
 
-	if c.ProxyDialer != nil || c.ProxyTLSClientConfig != nil {
-		m.proxyTransport = util.SetTransportDefaults(&http.Transport{
-			Dial:            c.ProxyDialer,
-			TLSClientConfig: c.ProxyTLSClientConfig,
-		})
+	// The proxy transport is always built, even without a custom dialer or TLS
+	// config, so that the minimum TLS version applies to it. The caller's TLS
+	// config is left as is, it may be shared.
+	tlsConfig := &tls.Config{}
+	if c.ProxyTLSClientConfig != nil {
+		tlsConfig = cloneTLSConfig(c.ProxyTLSClientConfig)
+	}
+	if tlsConfig.MinVersion < c.ProxyMinTLSVersion {
+		tlsConfig.MinVersion = c.ProxyMinTLSVersion
 	}
+	transport := &http.Transport{
+		Dial:                c.ProxyDialer,
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: c.ProxyMaxIdleConnsPerHost,
+	}
+	setMaxIdleConns(transport, c.ProxyMaxIdleConns)
+	m.proxyTransport = util.SetTransportDefaults(transport)
 
 	healthzChecks := []healthz.HealthzChecker{}
 
-	storageDecorator := c.storageDecorator()
-	dbClient := func(resource string) storage.Interface { return c.StorageDestinations.get("", resource) }
+	storageDecorator := func(resource string) generic.StorageDecorator {
+		return c.storageDecorator("", resource, m.watchCaches)
+	}
+	dbClient := func(resource string) storage.Interface { return c.StorageDestinations.Get("", resource) }
 
-	podTemplateStorage := podtemplateetcd.NewREST(dbClient("podTemplates"), storageDecorator)
+	podTemplateStorage := podtemplateetcd.NewREST(dbClient("podTemplates"), storageDecorator("podtemplates"))
 
-	eventStorage := eventetcd.NewREST(dbClient("events"), storageDecorator, uint64(c.EventTTL.Seconds()))
-	limitRangeStorage := limitrangeetcd.NewREST(dbClient("limitRanges"), storageDecorator)
+	eventStorage := eventetcd.NewREST(dbClient("events"), storageDecorator("events"), uint64(c.EventTTL.Seconds()))
+	limitRangeStorage := limitrangeetcd.NewREST(dbClient("limitRanges"), storageDecorator("limitranges"))
 
-	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewREST(dbClient("resourceQuotas"), storageDecorator)
-	secretStorage := secretetcd.NewREST(dbClient("secrets"), storageDecorator)
-	serviceAccountStorage := serviceaccountetcd.NewREST(dbClient("serviceAccounts"), storageDecorator)
-	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewREST(dbClient("persistentVolumes"), storageDecorator)
-	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewREST(dbClient("persistentVolumeClaims"), storageDecorator)
+	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewREST(dbClient("resourceQuotas"), storageDecorator("resourcequotas"))
+	secretStorage := secretetcd.NewREST(dbClient("secrets"), storageDecorator("secrets"))
+	serviceAccountStorage := serviceaccountetcd.NewREST(dbClient("serviceAccounts"), storageDecorator("serviceaccounts"))
+	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewREST(dbClient("persistentVolumes"), storageDecorator("persistentvolumes"))
+	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewREST(dbClient("persistentVolumeClaims"), storageDecorator("persistentvolumeclaims"))
 
-	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewREST(dbClient("namespaces"), storageDecorator)
+	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewREST(dbClient("namespaces"), storageDecorator("namespaces"))
+	namespaceStorage.AfterDelete = m.deleteThirdPartyNamespaceData
 	m.namespaceRegistry = namespace.NewRegistry(namespaceStorage)
 
-	endpointsStorage := endpointsetcd.NewREST(dbClient("endpoints"), storageDecorator)
+	endpointsStorage := endpointsetcd.NewREST(dbClient("endpoints"), storageDecorator("endpoints"))
 	m.endpointRegistry = endpoint.NewRegistry(endpointsStorage)
+	if m.endpointReconciler == nil && m.endpointReconcilerType == LeaseEndpointReconcilerType {
+		m.endpointReconciler = newLeaseEndpointReconciler(m.endpointRegistry, dbClient("endpoints"), m.masterLeaseTTL)
+	}
 
-	nodeStorage, nodeStatusStorage := nodeetcd.NewREST(dbClient("nodes"), storageDecorator, c.KubeletClient, m.proxyTransport)
+	nodeStorage, nodeStatusStorage := nodeetcd.NewREST(dbClient("nodes"), storageDecorator("nodes"), c.KubeletClient, m.proxyTransport)
 	m.nodeRegistry = node.NewRegistry(nodeStorage)
 
 	podStorage := podetcd.NewStorage(
 		dbClient("pods"),
-		storageDecorator,
+		storageDecorator("pods"),
 		kubeletclient.ConnectionInfoGetter(nodeStorage),
 		m.proxyTransport,
 	)
 
-	serviceStorage := serviceetcd.NewREST(dbClient("services"), storageDecorator)
+	serviceStorage := serviceetcd.NewREST(dbClient("services"), storageDecorator("services"))
 	m.serviceRegistry = service.NewRegistry(serviceStorage)
 
 	var serviceClusterIPRegistry service.RangeRegistry
@@ -565,8 +1369,9 @@ func (m *Master) init(c *Config) {
 		return etcd
 	})
 	m.serviceNodePortAllocator = serviceNodePortRegistry
+	m.serviceNodePorts = serviceNodePortAllocator
 
-	controllerStorage, controllerStatusStorage := controlleretcd.NewREST(dbClient("replicationControllers"), storageDecorator)
+	controllerStorage, controllerStatusStorage := controlleretcd.NewREST(dbClient("replicationControllers"), storageDecorator("replicationcontrollers"))
 
 	// TODO: Factor out the core API registration
 	m.storage = map[string]rest.Storage{
@@ -608,87 +1413,151 @@ func (m *Master) init(c *Config) {
 
 	if m.tunneler != nil {
 		m.tunneler.Run(m.getNodeAddresses)
-		healthzChecks = append(healthzChecks, healthz.NamedCheck("SSH Tunnel Check", m.IsTunnelSyncHealthy))
-		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
-			Name: "apiserver_proxy_tunnel_sync_latency_secs",
-			Help: "The time since the last successful synchronization of the SSH tunnels for proxy requests.",
-		}, func() float64 { return float64(m.tunneler.SecondsSinceSync()) })
+		healthzChecks = append(healthzChecks, healthz.NamedCheck("ssh-tunnels", m.IsTunnelSyncHealthy))
+		tunnelSync, err := m.metricsRegistry.RegisterOrGet(&tunnelSyncCollector{})
+		if err != nil {
+			glog.Fatalf("Unable to register the tunnel sync metric: %v", err)
+		}
+		tunnelSync.(*tunnelSyncCollector).set(m.tunneler)
 	}
 
 	apiVersions := []string{}
 	// Install v1 unless disabled.
-	if !m.apiGroupVersionOverrides["api/v1"].Disable {
+	if !m.disableCoreAPI && !m.apiGroupVersionOverrides["api/v1"].Disable {
 		if err := m.api_v1().InstallREST(m.handlerContainer); err != nil {
 			glog.Fatalf("Unable to setup API v1: %v", err)
 		}
 		apiVersions = append(apiVersions, "v1")
 	}
 
-	apiserver.InstallSupport(m.muxHelper, m.rootWebService, c.EnableProfiling, healthzChecks...)
-	apiserver.AddApiWebService(m.handlerContainer, c.APIPrefix, apiVersions)
+	healthzChecks = append(healthzChecks, healthz.NamedCheck("thirdparty-storage", m.IsThirdPartyStorageHealthy))
+	// The metrics reset at /resetMetrics are the master's, rather than the global ones.
+	apiserver.InstallSupport(m.muxHelper, m.rootWebService, false, healthzChecks...)
+	if c.EnableProfiling {
+		m.muxHelper.HandleFunc("/resetMetrics", m.requestMetrics.ServeReset)
+	}
+	if c.EnableMetrics {
+		m.muxHelper.Handle("/metrics", m.metricsRegistry)
+	}
+	thirdPartyMetrics, err := newThirdPartyMetrics(m.metricsRegistry)
+	if err != nil {
+		glog.Fatalf("Unable to register the third party resource metrics: %v", err)
+	}
+	m.thirdPartyMetrics = thirdPartyMetrics
+	// Readiness is served apart from /healthz, so that a master that can't reconcile
+	// the kubernetes service is kept out of rotation rather than restarted.
+	healthz.InstallPathHandler(m.muxHelper, "/readyz",
+		healthz.NamedCheck("bootstrap-controller", m.IsBootstrapControllerReady),
+		healthz.NamedCheck("draining", m.IsNotDraining))
+	m.rootWebService.Route(
+		m.rootWebService.GET("/storage").To(m.handleStorageVersions).
+			Doc("get the storage version of each API group").
+			Operation("getStorageVersions").
+			Produces(restful.MIME_JSON).
+			Consumes(restful.MIME_JSON))
+	if !m.disableCoreAPI {
+		apiserver.AddApiWebService(m.handlerContainer, c.APIPrefix, apiVersions)
+	}
 	apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), apiVersions)
 
 	// allGroups records all supported groups at /apis
 	allGroups := []unversioned.APIGroup{}
-	// Install extensions unless disabled.
+	// Install extensions/v1beta1 unless disabled. The extensions group itself is
+	// listed at /apis even if its versions are disabled, only the disabled
+	// versions stop being served, unless HideEmptyAPIGroups is set.
+	expAPIVersions := []unversioned.GroupVersionForDiscovery{}
+	expResources := 0
 	if !m.apiGroupVersionOverrides["extensions/v1beta1"].Disable {
-		m.thirdPartyStorage = c.StorageDestinations.APIGroups[extensions.GroupName].Default
+		thirdPartyStorage := newRequestLoggedStorage(c.StorageDestinations.Get(extensions.GroupName, "thirdpartyresourcedata"), "thirdpartyresourcedata")
+		m.thirdPartyStorage = newTracedStorage(thirdPartyStorage, m.tracer, "thirdpartyresourcedata")
 		m.thirdPartyResources = map[string]*thirdpartyresourcedataetcd.REST{}
 
 		expVersion := m.experimental(c)
+		m.extensionsStorage = expVersion.Storage
 
 		if err := expVersion.InstallREST(m.handlerContainer); err != nil {
 			glog.Fatalf("Unable to setup experimental api: %v", err)
 		}
-		g, err := latest.Group(extensions.GroupName)
-		if err != nil {
-			glog.Fatalf("Unable to setup experimental api: %v", err)
+		if _, found := c.StorageVersions[expVersion.GroupVersion.Group]; !found {
+			glog.Fatalf("Couldn't find storage version of group %v", expVersion.GroupVersion.Group)
 		}
-		expAPIVersions := []unversioned.GroupVersionForDiscovery{
-			{
-				GroupVersion: expVersion.GroupVersion.String(),
-				Version:      expVersion.GroupVersion.Version,
-			},
+		expAPIVersions = append(expAPIVersions, unversioned.GroupVersionForDiscovery{
+			GroupVersion: expVersion.GroupVersion.String(),
+			Version:      expVersion.GroupVersion.Version,
+		})
+		expResources += len(expVersion.Storage)
+		apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{expVersion.GroupVersion.String()})
+
+		for ix := range m.preinstalledThirdPartyResources {
+			if err := m.InstallThirdPartyResource(&m.preinstalledThirdPartyResources[ix]); err != nil {
+				glog.Fatalf("Unable to install third party resource %q: %v", m.preinstalledThirdPartyResources[ix].Name, err)
+			}
 		}
-		storageVersion, found := c.StorageVersions[g.GroupVersion.Group]
-		if !found {
-			glog.Fatalf("Couldn't find storage version of group %v", g.GroupVersion.Group)
+	}
+	if g, err := latest.Group(extensions.GroupName); err != nil {
+		if len(expAPIVersions) > 0 {
+			glog.Fatalf("Unable to setup experimental api: %v", err)
 		}
+	} else if c.HideEmptyAPIGroups && expResources == 0 {
+		glog.V(2).Infof("Not listing API group %q at /apis, it has no resources installed", g.GroupVersion.Group)
+	} else {
 		group := unversioned.APIGroup{
 			Name:             g.GroupVersion.Group,
 			Versions:         expAPIVersions,
-			PreferredVersion: unversioned.GroupVersionForDiscovery{GroupVersion: storageVersion, Version: apiutil.GetVersion(storageVersion)},
+			PreferredVersion: preferredVersionForDiscovery(c.StorageVersions[g.GroupVersion.Group], expAPIVersions),
 		}
-		apiserver.AddGroupWebService(m.handlerContainer, c.APIGroupPrefix+"/"+latest.GroupOrDie(extensions.GroupName).GroupVersion.Group, group)
+		apiserver.AddGroupWebService(m.handlerContainer, c.APIGroupPrefix+"/"+g.GroupVersion.Group, group)
 		allGroups = append(allGroups, group)
-		apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{expVersion.GroupVersion.String()})
 	}
 
 	// This should be done after all groups are registered
 	// TODO: replace the hardcoded "apis".
 	apiserver.AddApisWebService(m.handlerContainer, "/apis", allGroups)
+	// The aggregated discovery document is assembled on every request, so that it
+	// follows the third party resources that are added and removed.
+	if m.featureEnabled(AggregatedDiscoveryFeature) {
+		m.muxHelper.Handle(aggregatedDiscoveryPath, apiserver.AggregatedDiscoveryHandler(m.handlerContainer))
+	}
 
 	// Register root handler.
 	// We do not register this using restful Webservice since we do not want to surface this in api docs.
 	// Allow master to be embedded in contexts which already have something registered at the root
 	if c.EnableIndex {
-		m.mux.HandleFunc("/", apiserver.IndexHandler(m.handlerContainer, m.muxHelper))
+		m.mux.HandleFunc("/", apiserver.IndexHandlerForPaths(m.RegisteredPaths))
+		m.mux.HandleFunc(faviconPath, m.serveFavicon)
 	}
 
 	if c.EnableLogsSupport {
 		apiserver.InstallLogsSupport(m.muxHelper)
 	}
 	if c.EnableUISupport {
-		ui.InstallSupport(m.muxHelper, m.enableSwaggerSupport)
+		ui.InstallSupport(m.muxHelper, m.enableSwaggerSupport, c.UIAssetPath)
 	}
 
 	if c.EnableProfiling {
 		m.mux.HandleFunc("/debug/pprof/", pprof.Index)
 		m.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
 		m.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		m.mux.HandleFunc(admissionPluginsPath, m.serveAdmissionPlugins)
+		m.mux.HandleFunc(configPath, m.serveEffectiveConfig)
+		if c.EnableContentionProfiling {
+			goruntime.SetBlockProfileRate(1)
+			m.mux.Handle("/debug/pprof/block", pprof.Handler("block"))
+			if mutexProfilingSupported {
+				setMutexProfileFraction(1)
+				m.mux.Handle("/debug/pprof/mutex", pprof.Handler("mutex"))
+			}
+		}
 	}
 
-	handler := http.Handler(m.mux.(*http.ServeMux))
+	// Third party resource mutations are audited inside the authentication and
+	// authorization checks, so that the user making them is known.
+	handler := m.trackThirdPartyRequests(m.auditThirdPartyResources(m.instrumentThirdPartyResources(m.thirdPartyContentTypes(m.thirdPartyTables(m.thirdPartyETags(m.thirdPartyStrictDecoding(m.thirdPartyWatches(m.thirdPartyListPages(m.mux.(*http.ServeMux))))))))))
+	// Writes to missing namespaces are refused inside the authorization check, so
+	// that unauthorized users can't probe which namespaces exist.
+	if m.requireNamespaceExists {
+		handler = m.withNamespaceExists(handler)
+	}
 	insecureHandler := handler
 
 	// TODO: handle CORS and auth using go-restful
@@ -697,10 +1566,17 @@ func (m *Master) init(c *Config) {
 
 	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, m.newRequestInfoResolver())
 	handler = apiserver.WithAuthorizationCheck(handler, attributeGetter, m.authorizer)
+	// Requests are made as the user they impersonate once the impersonation is
+	// authorized, so that they are authorized, admitted and stored as that user.
+	handler = m.withImpersonation(handler)
 
 	// Install Authenticator
-	if c.Authenticator != nil {
-		authenticatedHandler, err := handlers.NewRequestAuthenticator(m.requestContextMapper, c.Authenticator, handlers.Unauthorized(c.SupportsBasicAuth), handler)
+	requestAuthenticator := c.Authenticator
+	if c.AnonymousAuthEnabled {
+		requestAuthenticator = anonymousAuthenticator(requestAuthenticator)
+	}
+	if requestAuthenticator != nil {
+		authenticatedHandler, err := handlers.NewRequestAuthenticator(m.requestContextMapper, requestAuthenticator, handlers.Unauthorized(c.SupportsBasicAuth), handler)
 		if err != nil {
 			glog.Fatalf("Could not initialize authenticator: %v", err)
 		}
@@ -729,6 +1605,37 @@ func (m *Master) init(c *Config) {
 	if m.enableSwaggerSupport {
 		m.InstallSwaggerAPI()
 	}
+	if m.enableOpenAPISupport {
+		m.InstallOpenAPI()
+	}
+
+	// Serve the namespace-less third party URLs in their default namespace. This
+	// is installed outside of the authorization check, so that it sees the namespace.
+	m.Handler = m.withThirdPartyDefaultNamespaces(m.Handler)
+	m.InsecureHandler = m.withThirdPartyDefaultNamespaces(m.InsecureHandler)
+
+	// Resolve the request info once for the filters above, which read it from the
+	// request context.
+	m.Handler = m.withRequestInfo(m.Handler)
+	m.InsecureHandler = m.withRequestInfo(m.InsecureHandler)
+
+	// Bound the time requests may run. This needs the request context, so it is
+	// installed inside the context filter.
+	m.Handler = m.withRequestTimeout(m.Handler)
+	m.InsecureHandler = m.withRequestTimeout(m.InsecureHandler)
+
+	// Cancel the storage calls of the requests whose client went away.
+	m.Handler = m.withCancelOnDisconnect(m.Handler)
+	m.InsecureHandler = m.withCancelOnDisconnect(m.InsecureHandler)
+
+	// Trace requests from the context filter on, so that their span is in their context.
+	m.Handler = m.withTracing(m.Handler)
+	m.InsecureHandler = m.withTracing(m.InsecureHandler)
+
+	// Put the ID of requests in their context first thing in the context filter,
+	// so that it is in their span and in their storage logs.
+	m.Handler = m.withRequestIDContext(m.Handler)
+	m.InsecureHandler = m.withRequestIDContext(m.InsecureHandler)
 
 	// After all wrapping is done, put a context filter around both handlers
 	if handler, err := api.NewRequestContextFilter(m.requestContextMapper, m.Handler); err != nil {
@@ -743,9 +1650,84 @@ func (m *Master) init(c *Config) {
 		m.InsecureHandler = handler
 	}
 
-	// TODO: Attempt clean shutdown?
+	// Bound the size of the request bodies read by every API handler, but the long
+	// running ones.
+	m.Handler = apiserver.MaxRequestBodyBytes(m.maxRequestBodyBytes, m.longRunningRequestRE, m.Handler)
+	m.InsecureHandler = apiserver.MaxRequestBodyBytes(m.maxRequestBodyBytes, m.longRunningRequestRE, m.InsecureHandler)
+
+	// Render error statuses as problem details for the clients that ask for them,
+	// inside the compression, which must see the rendered bodies.
+	m.Handler = m.withProblemDetails(m.Handler)
+	m.InsecureHandler = m.withProblemDetails(m.InsecureHandler)
+
+	// Render 404s as a page for browsers, inside the compression too.
+	m.Handler = m.withNotFoundPage(m.Handler)
+	m.InsecureHandler = m.withNotFoundPage(m.InsecureHandler)
+
+	// Compress large responses for the clients that accept it.
+	m.Handler = m.withCompression(m.Handler)
+	m.InsecureHandler = m.withCompression(m.InsecureHandler)
+
+	// Reject the disallowed methods before the requests do any work.
+	m.Handler = m.withDisallowedMethods(m.Handler)
+	m.InsecureHandler = m.withDisallowedMethods(m.InsecureHandler)
+
+	// Reject the requests over the inflight limits before they do any work.
+	m.Handler = m.withInflightLimit(m.Handler)
+
+	// End the watches when the master drains, so that their clients reconnect to
+	// another master.
+	m.Handler = m.withWatchesEndedOnDrain(m.Handler)
+	m.InsecureHandler = m.withWatchesEndedOnDrain(m.InsecureHandler)
+
+	// Count in-flight requests so that Shutdown can drain them.
+	m.Handler = m.inflight.track(m.Handler)
+	m.InsecureHandler = m.inflight.track(m.InsecureHandler)
+
+	// Take the client address of the requests forwarded by trusted proxies first
+	// thing, so that every handler and the request log see it.
+	m.Handler = m.withForwardedClientAddress(m.Handler)
+	m.InsecureHandler = m.withForwardedClientAddress(m.InsecureHandler)
+
+	// Identify requests outside of every other handler, so that all their
+	// responses carry their ID, the refusals included.
+	m.Handler = withRequestID(m.Handler)
+	m.InsecureHandler = withRequestID(m.InsecureHandler)
+
 	if m.enableCoreControllers {
-		m.NewBootstrapController().Start()
+		m.bootstrapController = m.NewBootstrapController()
+		m.bootstrapController.Start()
+	}
+}
+
+// Shutdown stops the bootstrap controller, the tunneler and the other background
+// loops of the master, removes the master from the kubernetes service endpoints
+// once the loops of the bootstrap controller have exited, unless it is the last
+// master they list, ends the watches being served, then waits for in-flight
+// requests to complete. New requests are rejected with 503 once Shutdown has
+// been called. If ctx is done before all requests have drained, ctx.Err() is
+// returned. Shutdown may be called more than once.
+func (m *Master) Shutdown(ctx context.Context) error {
+	m.draining.close()
+	m.shutdownOnce.Do(func() {
+		if m.stopCh != nil {
+			close(m.stopCh)
+		}
+		if m.bootstrapController != nil {
+			m.bootstrapController.Stop()
+			if err := m.bootstrapController.RemoveKubernetesEndpoints(); err != nil {
+				glog.Errorf("Unable to remove the master from the kubernetes service endpoints: %v", err)
+			}
+		}
+		if m.tunneler != nil {
+			m.tunneler.Stop()
+		}
+	})
+	select {
+	case <-m.inflight.drain():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -756,16 +1738,19 @@ func (m *Master) NewBootstrapController() *Controller {
 		ServiceRegistry:   m.serviceRegistry,
 		MasterCount:       m.masterCount,
 
-		EndpointRegistry: m.endpointRegistry,
-		EndpointInterval: 10 * time.Second,
+		EndpointRegistry:   m.endpointRegistry,
+		EndpointReconciler: m.endpointReconciler,
+		ReconcileInterval:  m.reconcileInterval,
 
 		ServiceClusterIPRegistry: m.serviceClusterIPAllocator,
 		ServiceClusterIPRange:    m.serviceClusterIPRange,
 		ServiceClusterIPInterval: 3 * time.Minute,
+		ServiceClusterIPReserved: m.reservedServiceIPs,
 
-		ServiceNodePortRegistry: m.serviceNodePortAllocator,
-		ServiceNodePortRange:    m.serviceNodePortRange,
-		ServiceNodePortInterval: 3 * time.Minute,
+		ServiceNodePortRegistry:  m.serviceNodePortAllocator,
+		ServiceNodePortRange:     m.serviceNodePortRange,
+		ServiceNodePortInterval:  3 * time.Minute,
+		ServiceNodePortAllocator: m.serviceNodePorts,
 
 		PublicIP: m.clusterIP,
 
@@ -775,6 +1760,8 @@ func (m *Master) NewBootstrapController() *Controller {
 		ExtraEndpointPorts:        m.extraEndpointPorts,
 		PublicServicePort:         m.publicReadWritePort,
 		KubernetesServiceNodePort: m.KubernetesServiceNodePort,
+		KubernetesServiceType:     m.kubernetesServiceType,
+		SessionAffinity:           m.kubernetesServiceSessionAffinity,
 	}
 }
 
@@ -782,7 +1769,49 @@ func (m *Master) NewBootstrapController() *Controller {
 // and traversal.  It is optional to allow consumers of the Kubernetes master to
 // register their own web services into the Kubernetes mux prior to initialization
 // of swagger, so that other resource types show up in the documentation.
+// Calling it again once swagger is installed only regenerates the listing.
 func (m *Master) InstallSwaggerAPI() {
+	m.swaggerLock.Lock()
+	// Enable swagger UI and discovery API
+	swaggerConfig := swagger.Config{
+		WebServicesUrl:  m.webServicesURL(),
+		ApiPath:         "/swaggerapi/",
+		SwaggerPath:     "/swaggerui/",
+		SwaggerFilePath: "/swagger-ui/",
+	}
+	m.swaggerConfig = &swaggerConfig
+	m.swaggerLock.Unlock()
+
+	services := m.handlerContainer.RegisteredWebServices()
+	for ix := range services {
+		if services[ix].RootPath() == swaggerConfig.ApiPath {
+			m.updateSwaggerAPI()
+			return
+		}
+	}
+
+	// The swagger listing is computed once, when it is registered. Serve it from
+	// a separate container so that it can be regenerated when third party
+	// resources are added or removed after this point.
+	ws := new(restful.WebService)
+	ws.Path(swaggerConfig.ApiPath)
+	ws.Produces(restful.MIME_JSON)
+	subPath := ""
+	ws.Route(ws.GET("/").To(m.serveSwagger))
+	for _, param := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		subPath += "/{" + param + "}"
+		ws.Route(ws.GET(subPath).To(m.serveSwagger))
+	}
+	m.handlerContainer.Add(ws)
+	m.handlerContainer.Handle(swaggerConfig.SwaggerPath, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		m.currentSwaggerContainer().ServeHTTP(w, req)
+	}))
+	m.updateSwaggerAPI()
+}
+
+// webServicesURL returns the URL the web services of the master are reached at
+// from outside the cluster. The caller must hold swaggerLock.
+func (m *Master) webServicesURL() string {
 	hostAndPort := m.externalHost
 	protocol := "https://"
 
@@ -794,17 +1823,183 @@ func (m *Master) InstallSwaggerAPI() {
 			hostAndPort = net.JoinHostPort(host, strconv.Itoa(m.publicReadWritePort))
 		}
 	}
-	webServicesUrl := protocol + hostAndPort
+	return protocol + hostAndPort
+}
 
-	// Enable swagger UI and discovery API
-	swaggerConfig := swagger.Config{
-		WebServicesUrl:  webServicesUrl,
-		WebServices:     m.handlerContainer.RegisteredWebServices(),
-		ApiPath:         "/swaggerapi/",
-		SwaggerPath:     "/swaggerui/",
-		SwaggerFilePath: "/swagger-ui/",
+// RefreshExternalAddress sets the external host of the master to the external
+// address of the first schedulable node in the node registry, and regenerates
+// the swagger listing, whose URLs are derived from it. It returns an error and
+// keeps the current external host if no schedulable node has an external address.
+// An external host set by the config is always kept.
+func (m *Master) RefreshExternalAddress() error {
+	if m.externalHostConfigured {
+		return nil
+	}
+	nodes, err := m.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
+	if err != nil {
+		return err
 	}
-	swagger.RegisterSwaggerService(swaggerConfig, m.handlerContainer)
+	externalHost := ""
+	for ix := range nodes.Items {
+		node := &nodes.Items[ix]
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if addr, err := findExternalAddress(node); err == nil {
+			externalHost = addr
+			break
+		}
+	}
+	if len(externalHost) == 0 {
+		return fmt.Errorf("no schedulable node has an external address")
+	}
+
+	m.swaggerLock.Lock()
+	m.externalHost = externalHost
+	if m.swaggerConfig != nil {
+		m.swaggerConfig.WebServicesUrl = m.webServicesURL()
+	}
+	m.swaggerLock.Unlock()
+	m.updateSwaggerAPI()
+	return nil
+}
+
+// updateSwaggerAPI regenerates the swagger listing from the currently registered
+// web services. It is a no-op if InstallSwaggerAPI has not been called.
+func (m *Master) updateSwaggerAPI() {
+	m.swaggerLock.Lock()
+	defer m.swaggerLock.Unlock()
+	if m.swaggerConfig == nil {
+		return
+	}
+	swaggerConfig := *m.swaggerConfig
+	swaggerConfig.WebServices = m.handlerContainer.RegisteredWebServices()
+	container := restful.NewContainer()
+	swagger.RegisterSwaggerService(swaggerConfig, container)
+	m.swaggerContainer = container
+}
+
+func (m *Master) currentSwaggerContainer() *restful.Container {
+	m.swaggerLock.RLock()
+	defer m.swaggerLock.RUnlock()
+	return m.swaggerContainer
+}
+
+func (m *Master) serveSwagger(req *restful.Request, resp *restful.Response) {
+	m.currentSwaggerContainer().ServeHTTP(resp.ResponseWriter, req.Request)
+}
+
+// preferredVersionForDiscovery returns the version of a group advertised as
+// preferred in discovery: the storage version of the group if it is served,
+// otherwise the first served version, if any.
+func preferredVersionForDiscovery(storageVersion string, versions []unversioned.GroupVersionForDiscovery) unversioned.GroupVersionForDiscovery {
+	for _, version := range versions {
+		if version.GroupVersion == storageVersion {
+			return version
+		}
+	}
+	if len(versions) > 0 {
+		return versions[0]
+	}
+	return unversioned.GroupVersionForDiscovery{}
+}
+
+// StorageVersions reports the version objects of each API group are stored in.
+// The legacy API group is keyed by the empty string.
+type StorageVersions struct {
+	StorageVersions map[string]string `json:"storageVersions"`
+	// ReadVersions are the versions, besides the storage version, objects of each
+	// API group may still be read in while they are migrated.
+	ReadVersions map[string][]string `json:"readVersions,omitempty"`
+}
+
+// APIGroupVersions returns the group versions served by the master, including
+// the installed third party resource groups, sorted by their string form.
+func (m *Master) APIGroupVersions() []unversioned.GroupVersion {
+	roots := m.APIGroupVersionRoots()
+	versions := make([]unversioned.GroupVersion, 0, len(roots))
+	for version := range roots {
+		versions = append(versions, version)
+	}
+	sort.Sort(groupVersionsByString(versions))
+	return versions
+}
+
+// APIGroupVersionRoots returns the path each group version served by the master
+// is served at, e.g. /api/v1 for v1 and /apis/extensions/v1beta1 for
+// extensions/v1beta1. It is computed from the installed web services.
+func (m *Master) APIGroupVersionRoots() map[unversioned.GroupVersion]string {
+	groupPrefixes := sets.NewString(thirdpartyprefix)
+	if len(m.apiGroupPrefix) > 0 {
+		groupPrefixes.Insert(m.apiGroupPrefix)
+	}
+	roots := map[unversioned.GroupVersion]string{}
+	for _, ws := range m.handlerContainer.RegisteredWebServices() {
+		root := ws.RootPath()
+		if len(m.apiPrefix) > 0 && strings.HasPrefix(root, m.apiPrefix+"/") {
+			if version := strings.TrimPrefix(root, m.apiPrefix+"/"); !strings.Contains(version, "/") {
+				roots[unversioned.GroupVersion{Version: version}] = root
+			}
+			continue
+		}
+		for prefix := range groupPrefixes {
+			if !strings.HasPrefix(root, prefix+"/") {
+				continue
+			}
+			// The web services at /apis/<group> only serve the group discovery.
+			if parts := strings.Split(strings.TrimPrefix(root, prefix+"/"), "/"); len(parts) == 2 {
+				roots[unversioned.GroupVersion{Group: parts[0], Version: parts[1]}] = root
+			}
+		}
+	}
+	return roots
+}
+
+// groupVersionsByString sorts group versions by their string form.
+type groupVersionsByString []unversioned.GroupVersion
+
+func (s groupVersionsByString) Len() int           { return len(s) }
+func (s groupVersionsByString) Less(i, j int) bool { return s[i].String() < s[j].String() }
+func (s groupVersionsByString) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// RegisteredPaths returns the sorted top-level paths currently served by the
+// master: the root paths of its web services, including the installed third party
+// resource groups, and the paths of the handlers registered on its mux.
+func (m *Master) RegisteredPaths() []string {
+	paths := sets.String{}
+	for _, ws := range m.handlerContainer.RegisteredWebServices() {
+		paths.Insert(ws.RootPath())
+	}
+	if m.muxHelper != nil {
+		paths.Insert(m.muxHelper.RegisteredPaths...)
+	}
+	m.swaggerLock.RLock()
+	if m.swaggerConfig != nil {
+		paths.Insert(m.swaggerConfig.SwaggerPath)
+	}
+	m.swaggerLock.RUnlock()
+	if m.enableOpenAPISupport {
+		paths.Insert(openAPIPath)
+	}
+	return paths.List()
+}
+
+// handleStorageVersions writes the storage version of each API group, so that
+// clients can verify all the masters of a cluster agree on them.
+func (m *Master) handleStorageVersions(req *restful.Request, resp *restful.Response) {
+	m.storageVersionsLock.RLock()
+	defer m.storageVersionsLock.RUnlock()
+	versions := StorageVersions{StorageVersions: map[string]string{}}
+	for group, version := range m.storageVersions {
+		versions.StorageVersions[group] = version
+	}
+	for group, readVersions := range m.storageReadVersions {
+		if versions.ReadVersions == nil {
+			versions.ReadVersions = map[string][]string{}
+		}
+		versions.ReadVersions[group] = append([]string{}, readVersions...)
+	}
+	resp.WriteAsJson(versions)
 }
 
 func (m *Master) getServersToValidate(c *Config) map[string]apiserver.Server {
@@ -836,6 +2031,11 @@ func (m *Master) getServersToValidate(c *Config) map[string]apiserver.Server {
 		// TODO: etcd health checking should be abstracted in the storage tier
 		serversToValidate[fmt.Sprintf("etcd-%d", ix)] = apiserver.Server{Addr: addr, Port: port, Path: "/health", Validate: etcdutil.EtcdHealthCheck}
 	}
+	for name, server := range serversToValidate {
+		server.Retries = c.HealthzRetries
+		server.RetryBackoff = c.HealthzRetryBackoff
+		serversToValidate[name] = server
+	}
 	return serversToValidate
 }
 
@@ -861,7 +2061,11 @@ func (m *Master) defaultAPIGroupVersion() *apiserver.APIGroupVersion {
 		Admit:   m.admissionControl,
 		Context: m.requestContextMapper,
 
-		MinRequestTimeout: m.minRequestTimeout,
+		MinRequestTimeout:  m.minRequestTimeout,
+		StrictDecoding:     m.strictDecoding,
+		DefaultContentType: m.defaultContentType,
+		SelfLinkPrefix:     m.selfLinkPrefix,
+		RequestMetrics:     m.requestMetrics,
 	}
 }
 
@@ -875,6 +2079,10 @@ func (m *Master) api_v1() *apiserver.APIGroupVersion {
 	version.Storage = storage
 	version.GroupVersion = unversioned.GroupVersion{Version: "v1"}
 	version.Codec = v1.Codec
+	if newProtobufCodec != nil {
+		version.ProtobufCodec = newProtobufCodec(version.GroupVersion.String())
+	}
+	version.DeprecationMessage = m.deprecatedAPIGroupVersions[version.GroupVersion.String()]
 	return version
 }
 
@@ -900,15 +2108,27 @@ func (m *Master) removeThirdPartyStorage(path string) error {
 	storage, found := m.thirdPartyResources[path]
 	if found {
 		if err := m.removeAllThirdPartyResources(storage); err != nil {
+			// The resource stays installed, so its requests are accepted again.
+			if m.thirdPartyInflight != nil {
+				m.thirdPartyInflight[path] = &inflightRequests{}
+			}
 			return err
 		}
 		delete(m.thirdPartyResources, path)
+		delete(m.thirdPartyInflight, path)
+		delete(m.thirdPartyKinds, path)
 	}
 	return nil
 }
 
-// RemoveThirdPartyResource removes all resources matching `path`.  Also deletes any stored data
+// RemoveThirdPartyResource removes all resources matching `path`.  Also deletes any stored data.
+// New requests for the resource are refused first, and the in-flight ones are waited for, up to
+// the drain timeout, so that no handler uses the storage or routes after they are torn down.
+// The other third party resources can be installed and removed while the requests are drained.
 func (m *Master) RemoveThirdPartyResource(path string) error {
+	m.drainThirdPartyRequests(path)
+	m.thirdPartyInstallLock.Lock()
+	defer m.thirdPartyInstallLock.Unlock()
 	if err := m.removeThirdPartyStorage(path); err != nil {
 		return err
 	}
@@ -920,11 +2140,16 @@ func (m *Master) RemoveThirdPartyResource(path string) error {
 			m.handlerContainer.Remove(services[ix])
 		}
 	}
+	m.updateSwaggerAPI()
 	return nil
 }
 
 func (m *Master) removeAllThirdPartyResources(registry *thirdpartyresourcedataetcd.REST) error {
-	ctx := api.NewDefaultContext()
+	return deleteThirdPartyData(api.NewDefaultContext(), registry)
+}
+
+// deleteThirdPartyData deletes the objects in registry that are in the namespace of ctx.
+func deleteThirdPartyData(ctx api.Context, registry *thirdpartyresourcedataetcd.REST) error {
 	existingData, err := registry.List(ctx, nil)
 	if err != nil {
 		return err
@@ -953,10 +2178,83 @@ func (m *Master) ListThirdPartyResources() []string {
 	return result
 }
 
-func (m *Master) addThirdPartyResourceStorage(path string, storage *thirdpartyresourcedataetcd.REST) {
+// HasThirdPartyResourcePath returns true if a third party resource is installed
+// at path, e.g. /apis/company.com as returned by makeThirdPartyPath. It is cheaper
+// than ListThirdPartyResources, and safe to call while third party resources are
+// installed or removed: it returns true once the handlers of an installed resource
+// are serving, and false as soon as its removal starts. To install a resource only
+// if it is missing, call InstallThirdPartyResource, which refuses the resources
+// already installed, rather than checking first.
+func (m *Master) HasThirdPartyResourcePath(path string) bool {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	_, found := m.thirdPartyResources[path]
+	return found
+}
+
+// ThirdPartyResourceColumns returns the printer columns of the third party
+// resource installed for rsrc, or nil if none is installed.
+func (m *Master) ThirdPartyResourceColumns(rsrc *extensions.ThirdPartyResource) ([]extensions.ThirdPartyResourceColumn, error) {
+	_, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
+	if err != nil {
+		return nil, err
+	}
+	storage, found := m.thirdPartyResourceStorage(makeThirdPartyPath(group))
+	if !found {
+		return nil, nil
+	}
+	return storage.Columns, nil
+}
+
+// thirdPartyResourceStorage returns the storage of the third party resource
+// installed at path, if there is one.
+func (m *Master) thirdPartyResourceStorage(path string) (*thirdpartyresourcedataetcd.REST, bool) {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	storage, found := m.thirdPartyResources[path]
+	return storage, found
+}
+
+// addThirdPartyResourceStorage records storage as the storage of the objects of
+// kind, the third party resource installed at path. The requests in flight for
+// the objects keep being tracked if the resource is already installed.
+func (m *Master) addThirdPartyResourceStorage(path string, kind unversioned.GroupVersionKind, storage *thirdpartyresourcedataetcd.REST) {
 	m.thirdPartyResourcesLock.Lock()
 	defer m.thirdPartyResourcesLock.Unlock()
 	m.thirdPartyResources[path] = storage
+	if m.thirdPartyKinds == nil {
+		m.thirdPartyKinds = map[string]unversioned.GroupVersionKind{}
+	}
+	m.thirdPartyKinds[path] = kind
+	if m.thirdPartyInflight == nil {
+		m.thirdPartyInflight = map[string]*inflightRequests{}
+	}
+	if _, found := m.thirdPartyInflight[path]; !found {
+		m.thirdPartyInflight[path] = &inflightRequests{}
+	}
+}
+
+// thirdPartyKind returns the kind of the third party resource installed at path,
+// with the version it is served in, if there is one.
+func (m *Master) thirdPartyKind(path string) (unversioned.GroupVersionKind, bool) {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	kind, found := m.thirdPartyKinds[path]
+	return kind, found
+}
+
+// thirdPartyAPIGroup returns the discovery information of the group of the third
+// party resource installed at path, with the version it is currently served in.
+func (m *Master) thirdPartyAPIGroup(path string) unversioned.APIGroup {
+	kind, _ := m.thirdPartyKind(path)
+	groupVersion := unversioned.GroupVersionForDiscovery{
+		GroupVersion: kind.GroupVersion().String(),
+		Version:      kind.Version,
+	}
+	return unversioned.APIGroup{
+		Name:     kind.Group,
+		Versions: []unversioned.GroupVersionForDiscovery{groupVersion},
+	}
 }
 
 // InstallThirdPartyResource installs a third party resource specified by 'rsrc'.  When a resource is
@@ -966,30 +2264,118 @@ func (m *Master) addThirdPartyResourceStorage(path string, storage *thirdpartyre
 // For example, if you install a resource ThirdPartyResource{ Name: "foo.company.com", Versions: {"v1"} }
 // then the following RESTful resource is created on the server:
 //   http://<host>/apis/company.com/v1/foos/...
+// A third party resource whose path is already served, by a built-in API group or
+// by another third party resource, is not installed. Installations and removals
+// are serialized, so that of concurrent installations of a resource only one
+// succeeds.
 func (m *Master) InstallThirdPartyResource(rsrc *extensions.ThirdPartyResource) error {
+	m.thirdPartyInstallLock.Lock()
+	defer m.thirdPartyInstallLock.Unlock()
+	return m.installThirdPartyResource(rsrc)
+}
+
+// installThirdPartyResource installs rsrc like InstallThirdPartyResource. The
+// caller must hold thirdPartyInstallLock.
+func (m *Master) installThirdPartyResource(rsrc *extensions.ThirdPartyResource) error {
 	kind, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
 	if err != nil {
 		return err
 	}
-	thirdparty := m.thirdpartyapi(group, kind, rsrc.Versions[0].Name)
-	if err := thirdparty.InstallREST(m.handlerContainer); err != nil {
-		glog.Fatalf("Unable to setup thirdparty api: %v", err)
+	path := makeThirdPartyPath(group)
+	if root, found := m.registeredRootUnder(path); found {
+		return fmt.Errorf("third party resource %s collides with the API already served at %s", rsrc.Name, root)
+	}
+	thirdparty, err := m.installThirdPartyAPI(rsrc, group, kind)
+	if err != nil {
+		return fmt.Errorf("unable to install the API of third party resource %s: %v", rsrc.Name, err)
+	}
+	apiserver.AddGroupWebServiceFunc(m.handlerContainer, path, func() unversioned.APIGroup { return m.thirdPartyAPIGroup(path) })
+	apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{thirdparty.GroupVersion.String()})
+	m.updateSwaggerAPI()
+	return nil
+}
+
+// UpdateThirdPartyResource updates the third party resource installed for rsrc, or
+// installs it if it is missing. Only what changed is touched: when the version of
+// rsrc changed, its handlers are installed before the handlers of the previous
+// version are removed, and the discovery of its group switches to the new version
+// in between, so that the resource stays available. Its stored objects are kept,
+// and the requests in flight for the previous version complete. When only its
+// printer columns changed, no handlers are replaced. A resource of another kind
+// installed for the group of rsrc is not replaced.
+func (m *Master) UpdateThirdPartyResource(rsrc *extensions.ThirdPartyResource) error {
+	kind, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
+	if err != nil {
+		return err
+	}
+	if len(rsrc.Versions) == 0 {
+		return fmt.Errorf("third party resource %s has no version", rsrc.Name)
 	}
+	m.thirdPartyInstallLock.Lock()
+	defer m.thirdPartyInstallLock.Unlock()
 	path := makeThirdPartyPath(group)
-	groupVersion := unversioned.GroupVersionForDiscovery{
-		GroupVersion: group + "/" + rsrc.Versions[0].Name,
-		Version:      rsrc.Versions[0].Name,
+	installed, found := m.thirdPartyKind(path)
+	if !found {
+		return m.installThirdPartyResource(rsrc)
+	}
+	if installed.Kind != kind {
+		return fmt.Errorf("third party resource %s collides with the third party resource of kind %s installed at %s", rsrc.Name, installed.Kind, path)
+	}
+	if installed.Version == rsrc.Versions[0].Name {
+		m.thirdPartyResourcesLock.Lock()
+		defer m.thirdPartyResourcesLock.Unlock()
+		// The handlers keep the storage they were installed with, which only
+		// differs in its columns, used by thirdPartyTables.
+		storage := *m.thirdPartyResources[path]
+		storage.Columns = rsrc.AdditionalPrinterColumns
+		m.thirdPartyResources[path] = &storage
+		return nil
 	}
-	apiGroup := unversioned.APIGroup{
-		Name:     group,
-		Versions: []unversioned.GroupVersionForDiscovery{groupVersion},
+
+	previousStorage, _ := m.thirdPartyResourceStorage(path)
+	thirdparty, err := m.installThirdPartyAPI(rsrc, group, kind)
+	if err != nil {
+		return err
 	}
-	apiserver.AddGroupWebService(m.handlerContainer, path, apiGroup)
-	m.addThirdPartyResourceStorage(path, thirdparty.Storage[strings.ToLower(kind)+"s"].(*thirdpartyresourcedataetcd.REST))
 	apiserver.InstallServiceErrorHandler(m.handlerContainer, m.newRequestInfoResolver(), []string{thirdparty.GroupVersion.String()})
+	previous := path + "/" + installed.Version
+	for _, service := range m.handlerContainer.RegisteredWebServices() {
+		if service.RootPath() == previous {
+			m.handlerContainer.Remove(service)
+		}
+	}
+	// The watches of the version that is not served anymore are ended.
+	previousStorage.StopWatches()
+	m.updateSwaggerAPI()
 	return nil
 }
 
+// installThirdPartyAPI installs the handlers of the first version of rsrc, a
+// third party resource of the given group and kind, and records their storage.
+func (m *Master) installThirdPartyAPI(rsrc *extensions.ThirdPartyResource, group, kind string) (*apiserver.APIGroupVersion, error) {
+	thirdparty := m.thirdpartyapi(group, kind, rsrc.Versions[0].Name)
+	if err := thirdparty.InstallREST(m.handlerContainer); err != nil {
+		return nil, err
+	}
+	resourceStorage := thirdparty.Storage[strings.ToLower(kind)+"s"].(*thirdpartyresourcedataetcd.REST)
+	resourceStorage.Columns = rsrc.AdditionalPrinterColumns
+	resourceStorage.MaxObjectBytes = m.maxThirdPartyObjectBytes
+	m.addThirdPartyResourceStorage(makeThirdPartyPath(group), thirdparty.GroupVersion.WithKind(kind), resourceStorage)
+	return thirdparty, nil
+}
+
+// registeredRootUnder returns the root path of a web service registered at path
+// or under it, if there is one.
+func (m *Master) registeredRootUnder(path string) (string, bool) {
+	for _, service := range m.handlerContainer.RegisteredWebServices() {
+		root := service.RootPath()
+		if root == path || strings.HasPrefix(root, path+"/") {
+			return root, true
+		}
+	}
+	return "", false
+}
+
 func (m *Master) thirdpartyapi(group, kind, version string) *apiserver.APIGroupVersion {
 	resourceStorage := thirdpartyresourcedataetcd.NewREST(m.thirdPartyStorage, generic.UndecoratedStorage, group, kind)
 
@@ -1001,13 +2387,25 @@ func (m *Master) thirdpartyapi(group, kind, version string) *apiserver.APIGroupV
 
 	optionsExternalVersion := latest.GroupOrDie(api.GroupName).GroupVersion
 
+	admitters := []admission.Interface{}
+	if m.namespaceRegistry != nil {
+		admitters = append(admitters, newThirdPartyNamespaceLifecycle(m.namespaceRegistry))
+	}
+	if m.admissionControl != nil {
+		admitters = append(admitters, m.admissionControl)
+	}
+	var admit admission.Interface
+	if len(admitters) > 0 {
+		admit = thirdPartyAdmission{admission.NewChainHandler(admitters...), unversioned.GroupKind{Group: group, Kind: kind}}
+	}
+
 	return &apiserver.APIGroupVersion{
 		Root:                apiRoot,
 		GroupVersion:        unversioned.GroupVersion{Group: group, Version: version},
 		RequestInfoResolver: m.newRequestInfoResolver(),
 
 		Creater:   thirdpartyresourcedata.NewObjectCreator(group, version, api.Scheme),
-		Convertor: api.Scheme,
+		Convertor: thirdpartyresourcedata.NewObjectConvertor(api.Scheme),
 		Typer:     api.Scheme,
 
 		Mapper:                 thirdpartyresourcedata.NewMapper(latest.GroupOrDie(extensions.GroupName).RESTMapper, kind, version, group),
@@ -1016,12 +2414,48 @@ func (m *Master) thirdpartyapi(group, kind, version string) *apiserver.APIGroupV
 		Storage:                storage,
 		OptionsExternalVersion: &optionsExternalVersion,
 
+		Admit: admit,
+		// The handlers share the master's context mapper, so the user the master's
+		// authenticator stored in the request context reaches admission and storage.
 		Context: m.requestContextMapper,
 
 		MinRequestTimeout: m.minRequestTimeout,
+		SelfLinkPrefix:    m.selfLinkPrefix,
+		RequestMetrics:    m.requestMetrics,
 	}
 }
 
+// thirdPartyAdmission runs an admission chain on third party resource requests,
+// reporting the requests denied with an error that is not an API status as forbidden.
+type thirdPartyAdmission struct {
+	admission.Interface
+	kind unversioned.GroupKind
+}
+
+func (a thirdPartyAdmission) Admit(attributes admission.Attributes) error {
+	attributes = thirdPartyAttributes{attributes, a.kind}
+	err := a.Interface.Admit(attributes)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(apierrors.APIStatus); ok {
+		return err
+	}
+	return admission.NewForbidden(attributes, err)
+}
+
+// thirdPartyAttributes are the admission attributes of a third party resource
+// request, with the kind of the resource, e.g. company.com/Foo, rather than the
+// kind its objects are stored as.
+type thirdPartyAttributes struct {
+	admission.Attributes
+	kind unversioned.GroupKind
+}
+
+func (a thirdPartyAttributes) GetKind() unversioned.GroupKind {
+	return a.kind
+}
+
 // experimental returns the resources and codec for the experimental api
 func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 	// All resources except these are disabled by default.
@@ -1035,61 +2469,68 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		}
 		return enabled
 	}
-	storageDecorator := c.storageDecorator()
+	storageDecorator := func(resource string) generic.StorageDecorator {
+		return c.storageDecorator(extensions.GroupName, resource, m.watchCaches)
+	}
 	dbClient := func(resource string) storage.Interface {
-		return c.StorageDestinations.get(extensions.GroupName, resource)
+		return c.StorageDestinations.Get(extensions.GroupName, resource)
 	}
 
 	storage := map[string]rest.Storage{}
 	if isEnabled("horizontalpodautoscalers") {
-		autoscalerStorage, autoscalerStatusStorage := horizontalpodautoscaleretcd.NewREST(dbClient("horizontalpodautoscalers"), storageDecorator)
+		autoscalerStorage, autoscalerStatusStorage := horizontalpodautoscaleretcd.NewREST(dbClient("horizontalpodautoscalers"), storageDecorator("horizontalpodautoscalers"))
 		storage["horizontalpodautoscalers"] = autoscalerStorage
 		storage["horizontalpodautoscalers/status"] = autoscalerStatusStorage
-		controllerStorage := expcontrolleretcd.NewStorage(c.StorageDestinations.get("", "replicationControllers"), storageDecorator)
+		controllerStorage := expcontrolleretcd.NewStorage(c.StorageDestinations.Get("", "replicationControllers"), storageDecorator("replicationcontrollers"))
 		storage["replicationcontrollers"] = controllerStorage.ReplicationController
 		storage["replicationcontrollers/scale"] = controllerStorage.Scale
 	}
 	if isEnabled("thirdpartyresources") {
-		thirdPartyResourceStorage := thirdpartyresourceetcd.NewREST(dbClient("thirdpartyresources"), storageDecorator)
+		thirdPartyResourceStorage := thirdpartyresourceetcd.NewREST(dbClient("thirdpartyresources"), storageDecorator("thirdpartyresources"))
 		thirdPartyControl := ThirdPartyController{
 			master: m,
 			thirdPartyResourceRegistry: thirdPartyResourceStorage,
+			preinstalled:               preinstalledThirdPartyPaths(m.preinstalledThirdPartyResources),
 		}
 		go func() {
-			util.Forever(func() {
+			util.Until(func() {
 				if err := thirdPartyControl.SyncResources(); err != nil {
 					glog.Warningf("third party resource sync failed: %v", err)
 				}
-			}, 10*time.Second)
+			}, 10*time.Second, m.stopCh)
 		}()
 
 		storage["thirdpartyresources"] = thirdPartyResourceStorage
 	}
 
 	if isEnabled("daemonsets") {
-		daemonSetStorage, daemonSetStatusStorage := daemonetcd.NewREST(dbClient("daemonsets"), storageDecorator)
+		daemonSetStorage, daemonSetStatusStorage := daemonetcd.NewREST(dbClient("daemonsets"), storageDecorator("daemonsets"))
 		storage["daemonsets"] = daemonSetStorage
 		storage["daemonsets/status"] = daemonSetStatusStorage
 	}
 	if isEnabled("deployments") {
-		deploymentStorage := deploymentetcd.NewStorage(dbClient("deployments"), storageDecorator)
+		deploymentStorage := deploymentetcd.NewStorage(dbClient("deployments"), storageDecorator("deployments"))
 		storage["deployments"] = deploymentStorage.Deployment
 		storage["deployments/status"] = deploymentStorage.Status
 		storage["deployments/scale"] = deploymentStorage.Scale
 	}
 	if isEnabled("jobs") {
-		jobStorage, jobStatusStorage := jobetcd.NewREST(dbClient("jobs"), storageDecorator)
+		jobStorage, jobStatusStorage := jobetcd.NewREST(dbClient("jobs"), storageDecorator("jobs"))
 		storage["jobs"] = jobStorage
 		storage["jobs/status"] = jobStatusStorage
 	}
 	if isEnabled("ingresses") {
-		ingressStorage, ingressStatusStorage := ingressetcd.NewREST(dbClient("ingresses"), storageDecorator)
+		ingressStorage, ingressStatusStorage := ingressetcd.NewREST(dbClient("ingresses"), storageDecorator("ingresses"))
 		storage["ingresses"] = ingressStorage
 		storage["ingresses/status"] = ingressStatusStorage
 	}
 
 	extensionsGroup := latest.GroupOrDie(extensions.GroupName)
 	optionsExternalVersion := latest.GroupOrDie(api.GroupName).GroupVersion
+	var protobufCodec runtime.Codec
+	if newProtobufCodec != nil {
+		protobufCodec = newProtobufCodec(extensionsGroup.GroupVersion.String())
+	}
 
 	return &apiserver.APIGroupVersion{
 		Root:                m.apiGroupPrefix,
@@ -1109,26 +2550,99 @@ func (m *Master) experimental(c *Config) *apiserver.APIGroupVersion {
 		Admit:   m.admissionControl,
 		Context: m.requestContextMapper,
 
-		MinRequestTimeout: m.minRequestTimeout,
+		MinRequestTimeout:  m.minRequestTimeout,
+		StrictDecoding:     m.strictDecoding,
+		DeprecationMessage: m.deprecatedAPIGroupVersions[extensionsGroup.GroupVersion.String()],
+		ProtobufCodec:      protobufCodec,
+		DefaultContentType: m.defaultContentType,
+		SelfLinkPrefix:     m.selfLinkPrefix,
+		RequestMetrics:     m.requestMetrics,
 	}
 }
 
 // findExternalAddress returns ExternalIP of provided node with fallback to LegacyHostIP.
 func findExternalAddress(node *api.Node) (string, error) {
-	var fallback string
+	addrs := findExternalAddresses(node)
+	if len(addrs) == 0 {
+		return "", fmt.Errorf("Couldn't find external address: %v", node)
+	}
+	return addrs[0], nil
+}
+
+// findExternalAddresses returns the ExternalIP addresses of node, or its
+// LegacyHostIP addresses if it has no ExternalIP.
+func findExternalAddresses(node *api.Node) []string {
+	external, fallback := []string{}, []string{}
 	for ix := range node.Status.Addresses {
 		addr := &node.Status.Addresses[ix]
-		if addr.Type == api.NodeExternalIP {
-			return addr.Address, nil
+		switch addr.Type {
+		case api.NodeExternalIP:
+			external = append(external, addr.Address)
+		case api.NodeLegacyHostIP:
+			fallback = append(fallback, addr.Address)
 		}
-		if fallback == "" && addr.Type == api.NodeLegacyHostIP {
-			fallback = addr.Address
+	}
+	if len(external) > 0 {
+		return external
+	}
+	return fallback
+}
+
+// tunnelChecker is implemented by the tunnelers that can tell whether they have
+// an open tunnel to an address.
+type tunnelChecker interface {
+	HasTunnel(addr string) bool
+}
+
+// selectNodeAddress returns the external address of node the tunneler connects to,
+// according to the master's node address selection. With failover selection,
+// the address selected last is kept, so that its tunnel isn't replaced, unless the
+// tunneler couldn't open a tunnel to it since. A tunneler that isn't a
+// tunnelChecker always keeps the first address.
+func (m *Master) selectNodeAddress(node *api.Node) (string, error) {
+	if m.nodeAddressSelection != NodeAddressSelectionFailover {
+		return findExternalAddress(node)
+	}
+	addrs := findExternalAddresses(node)
+	if len(addrs) == 0 {
+		return "", fmt.Errorf("Couldn't find external address: %v", node)
+	}
+	m.nodeAddressesLock.Lock()
+	defer m.nodeAddressesLock.Unlock()
+	if m.nodeAddresses == nil {
+		m.nodeAddresses = map[string]string{}
+	}
+	selected := 0
+	if last, found := m.nodeAddresses[node.Name]; found {
+		for i := range addrs {
+			if addrs[i] != last {
+				continue
+			}
+			selected = i
+			if tunnels, ok := m.tunneler.(tunnelChecker); ok && !tunnels.HasTunnel(last) {
+				selected = (i + 1) % len(addrs)
+			}
+			break
 		}
 	}
-	if fallback != "" {
-		return fallback, nil
+	m.nodeAddresses[node.Name] = addrs[selected]
+	return addrs[selected], nil
+}
+
+// forgetNodeAddresses forgets the addresses selected for the nodes that are not
+// in nodes.
+func (m *Master) forgetNodeAddresses(nodes *api.NodeList) {
+	names := sets.NewString()
+	for i := range nodes.Items {
+		names.Insert(nodes.Items[i].Name)
+	}
+	m.nodeAddressesLock.Lock()
+	defer m.nodeAddressesLock.Unlock()
+	for name := range m.nodeAddresses {
+		if !names.Has(name) {
+			delete(m.nodeAddresses, name)
+		}
 	}
-	return "", fmt.Errorf("Couldn't find external address: %v", node)
 }
 
 func (m *Master) getNodeAddresses() ([]string, error) {
@@ -1136,10 +2650,16 @@ func (m *Master) getNodeAddresses() ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
+	resolve := m.selectNodeAddress
+	if m.tunnelerAddressResolver != nil {
+		resolve = m.tunnelerAddressResolver
+	} else {
+		m.forgetNodeAddresses(nodes)
+	}
 	addrs := []string{}
 	for ix := range nodes.Items {
 		node := &nodes.Items[ix]
-		addr, err := findExternalAddress(node)
+		addr, err := resolve(node)
 		if err != nil {
 			return nil, err
 		}
@@ -1148,13 +2668,60 @@ func (m *Master) getNodeAddresses() ([]string, error) {
 	return addrs, nil
 }
 
+// IsTunnelSyncHealthy returns an error if the SSH tunnels were last synced
+// successfully more than the tunnel sync health threshold ago.
 func (m *Master) IsTunnelSyncHealthy(req *http.Request) error {
 	if m.tunneler == nil {
 		return nil
 	}
-	lag := m.tunneler.SecondsSinceSync()
-	if lag > 600 {
-		return fmt.Errorf("Tunnel sync is taking to long: %d", lag)
+	return m.tunneler.Healthy(m.tunnelSyncHealthThreshold)
+}
+
+// thirdPartyStorageHealthTimeout bounds the probe of the third party storage made
+// by IsThirdPartyStorageHealthy.
+var thirdPartyStorageHealthTimeout = 5 * time.Second
+
+// IsThirdPartyStorageHealthy returns an error if the storage of the third party
+// objects can't be read, which may be a different backend than the main storage.
+// It is healthy while no third party resources are installed.
+func (m *Master) IsThirdPartyStorageHealthy(req *http.Request) error {
+	m.thirdPartyResourcesLock.RLock()
+	installed := len(m.thirdPartyResources)
+	m.thirdPartyResourcesLock.RUnlock()
+	if installed == 0 || m.thirdPartyStorage == nil {
+		return nil
+	}
+	ctx, cancel := api.WithTimeout(api.NewContext(), thirdPartyStorageHealthTimeout)
+	defer cancel()
+	// The etcd storage gives up when ctx expires, but the storage may not, so the
+	// probe doesn't wait for it past the timeout either.
+	s := m.thirdPartyStorage
+	errCh := make(chan error, 1)
+	go func() {
+		// Listing a key that doesn't exist reads from the backend without returning objects.
+		errCh <- s.List(ctx, "/ThirdPartyResourceData/.healthz", "", storage.Everything, &extensions.ThirdPartyResourceDataList{})
+	}()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("the third party storage is unavailable: %v", err)
+		}
+		return nil
+	case <-time.After(thirdPartyStorageHealthTimeout):
+		return fmt.Errorf("the third party storage did not answer within %v", thirdPartyStorageHealthTimeout)
+	}
+}
+
+// IsBootstrapControllerReady returns an error until the bootstrap controller has
+// reconciled the kubernetes service and its endpoints for the first time.
+func (m *Master) IsBootstrapControllerReady(req *http.Request) error {
+	if m.bootstrapController == nil {
+		return nil
+	}
+	select {
+	case <-m.bootstrapController.Ready():
+		return nil
+	default:
+		return fmt.Errorf("the kubernetes service and its endpoints have not been reconciled yet")
 	}
-	return nil
 }
@@ -25,10 +25,17 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
 	"reflect"
+	goruntime "runtime"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
+	"k8s.io/kubernetes/pkg/admission"
 	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/latest"
 	"k8s.io/kubernetes/pkg/api/testapi"
@@ -37,18 +44,25 @@ import (
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/auth/authenticator"
+	"k8s.io/kubernetes/pkg/auth/handlers"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/kubelet/client"
 	"k8s.io/kubernetes/pkg/registry/endpoint"
 	"k8s.io/kubernetes/pkg/registry/namespace"
 	"k8s.io/kubernetes/pkg/registry/registrytest"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
 	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
 	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
 	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
 	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+	etcdutil "k8s.io/kubernetes/pkg/storage/etcd/util"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/plugin/pkg/admission/admit"
 
 	"github.com/emicklei/go-restful"
 	"github.com/stretchr/testify/assert"
@@ -56,10 +70,10 @@ import (
 )
 
 // setUp is a convience function for setting up for (most) tests.
-func setUp(t *testing.T) (Master, *etcdtesting.EtcdTestServer, Config, *assert.Assertions) {
+func setUp(t *testing.T) (*Master, *etcdtesting.EtcdTestServer, Config, *assert.Assertions) {
 	server := etcdtesting.NewEtcdTestClientServer(t)
 
-	master := Master{}
+	master := &Master{}
 	config := Config{}
 	storageVersions := make(map[string]string)
 	storageDestinations := NewStorageDestinations()
@@ -72,11 +86,29 @@ func setUp(t *testing.T) (Master, *etcdtesting.EtcdTestServer, Config, *assert.A
 	storageVersions[extensions.GroupName] = testapi.Extensions.GroupVersion().String()
 	config.StorageVersions = storageVersions
 	config.PublicAddress = net.ParseIP("192.168.10.4")
+	config.APIPrefix = "/api"
+	config.APIGroupPrefix = "/apis"
 	master.nodeRegistry = registrytest.NewNodeRegistry([]string{"node1", "node2"}, api.NodeResources{})
 
 	return master, server, config, assert.New(t)
 }
 
+// setUpForInit is setUp for the tests that call master.init(): it also fills in
+// the service ranges and the mux, container and context mapper that New()
+// would have set up.
+func setUpForInit(t *testing.T) (*Master, *etcdtesting.EtcdTestServer, Config, *assert.Assertions) {
+	master, etcdserver, config, assert := setUp(t)
+	master.serviceNodePortRange = util.PortRange{Base: 10, Size: 10}
+	_, master.serviceClusterIPRange, _ = net.ParseCIDR("192.168.1.1/24")
+	mux := http.NewServeMux()
+	master.mux = mux
+	master.muxHelper = &apiserver.MuxHelper{Mux: mux, RegisteredPaths: []string{}}
+	master.rootWebService = new(restful.WebService)
+	master.handlerContainer = NewHandlerContainer(mux)
+	master.requestContextMapper = api.NewRequestContextMapper()
+	return master, etcdserver, config, assert
+}
+
 // TestNew verifies that the New function returns a Master
 // using the configuration properly.
 func TestNew(t *testing.T) {
@@ -88,7 +120,10 @@ func TestNew(t *testing.T) {
 	config.ProxyDialer = func(network, addr string) (net.Conn, error) { return nil, nil }
 	config.ProxyTLSClientConfig = &tls.Config{}
 
-	master := New(&config)
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
 
 	// Verify many of the variables match their config counterparts
 	assert.Equal(master.enableCoreControllers, config.EnableCoreControllers)
@@ -96,6 +131,7 @@ func TestNew(t *testing.T) {
 	assert.Equal(master.enableUISupport, config.EnableUISupport)
 	assert.Equal(master.enableSwaggerSupport, config.EnableSwaggerSupport)
 	assert.Equal(master.enableSwaggerSupport, config.EnableSwaggerSupport)
+	assert.Equal(master.enableOpenAPISupport, config.EnableOpenAPISupport)
 	assert.Equal(master.enableProfiling, config.EnableProfiling)
 	assert.Equal(master.apiPrefix, config.APIPrefix)
 	assert.Equal(master.apiGroupPrefix, config.APIGroupPrefix)
@@ -106,12 +142,21 @@ func TestNew(t *testing.T) {
 	assert.Equal(master.apiGroupVersionOverrides, config.APIGroupVersionOverrides)
 	assert.Equal(master.requestContextMapper, config.RequestContextMapper)
 	assert.Equal(master.cacheTimeout, config.CacheTimeout)
+	assert.Equal(master.maxRequestBodyBytes, int64(DefaultMaxRequestBodyBytes))
+	assert.Equal(master.maxThirdPartyObjectBytes, int64(DefaultMaxThirdPartyObjectBytes))
+	assert.Equal(master.thirdPartyDrainTimeout, DefaultThirdPartyDrainTimeout)
+	assert.Equal(master.featureGates, config.FeatureGates)
+	assert.Equal(master.storageVersions, config.StorageVersions)
 	assert.Equal(master.masterCount, config.MasterCount)
 	assert.Equal(master.externalHost, config.ExternalHost)
 	assert.Equal(master.clusterIP, config.PublicAddress)
 	assert.Equal(master.publicReadWritePort, config.ReadWritePort)
 	assert.Equal(master.serviceReadWriteIP, config.ServiceReadWriteIP)
 	assert.Equal(master.tunneler, config.Tunneler)
+	assert.Equal(master.reconcileInterval, DefaultReconcileInterval)
+	assert.Equal(master.endpointReconcilerType, MasterCountEndpointReconcilerType)
+	assert.Equal(master.masterLeaseTTL, DefaultMasterLeaseTTL)
+	assert.Nil(master.endpointReconciler)
 
 	// These functions should point to the same memory location
 	masterDialer, _ := util.Dialer(master.proxyTransport)
@@ -119,7 +164,187 @@ func TestNew(t *testing.T) {
 	configDialerFunc := fmt.Sprintf("%p", config.ProxyDialer)
 	assert.Equal(masterDialerFunc, configDialerFunc)
 
-	assert.Equal(master.proxyTransport.(*http.Transport).TLSClientConfig, config.ProxyTLSClientConfig)
+	// The proxy uses a copy of the TLS config, whose minimum version is raised.
+	assert.False(master.proxyTransport.(*http.Transport).TLSClientConfig == config.ProxyTLSClientConfig)
+	assert.Equal(uint16(tls.VersionTLS12), master.proxyTransport.(*http.Transport).TLSClientConfig.MinVersion)
+	assert.Equal(uint16(0), config.ProxyTLSClientConfig.MinVersion)
+}
+
+// TestProxyMinTLSVersion verifies that the proxy transport never negotiates
+// a TLS version lower than the configured minimum, without changing the TLS
+// config of the caller.
+func TestProxyMinTLSVersion(t *testing.T) {
+	dialer := func(network, addr string) (net.Conn, error) { return nil, nil }
+	testCases := []struct {
+		dialer        apiserver.ProxyDialerFunc
+		tlsConfig     *tls.Config
+		minTLSVersion uint16
+		expected      uint16
+	}{
+		{nil, nil, 0, tls.VersionTLS12},
+		{nil, nil, tls.VersionTLS11, tls.VersionTLS11},
+		{dialer, nil, 0, tls.VersionTLS12},
+		{dialer, &tls.Config{}, 0, tls.VersionTLS12},
+		{dialer, &tls.Config{MinVersion: tls.VersionTLS10}, 0, tls.VersionTLS12},
+		{dialer, &tls.Config{}, tls.VersionTLS11, tls.VersionTLS11},
+		{dialer, &tls.Config{MinVersion: tls.VersionTLS12}, tls.VersionTLS11, tls.VersionTLS12},
+	}
+	for i, testCase := range testCases {
+		_, etcdserver, config, assert := setUp(t)
+		config.KubeletClient = client.FakeKubeletClient{}
+		config.ProxyDialer = testCase.dialer
+		config.ProxyTLSClientConfig = testCase.tlsConfig
+		config.ProxyMinTLSVersion = testCase.minTLSVersion
+		var minVersion uint16
+		if testCase.tlsConfig != nil {
+			minVersion = testCase.tlsConfig.MinVersion
+		}
+
+		master, err := New(&config)
+		if !assert.NoError(err, "case %d", i) {
+			etcdserver.Terminate(t)
+			continue
+		}
+		assert.Equal(testCase.expected, master.proxyTransport.(*http.Transport).TLSClientConfig.MinVersion, "case %d", i)
+		if testCase.tlsConfig != nil {
+			assert.Equal(minVersion, testCase.tlsConfig.MinVersion, "case %d", i)
+		}
+		etcdserver.Terminate(t)
+	}
+}
+
+// TestProxyTransportIdleConns verifies that the proxy transport picks up the
+// configured connection pooling limits, the total one only if the transport has
+// one.
+func TestProxyTransportIdleConns(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	config.KubeletClient = client.FakeKubeletClient{}
+	config.ProxyDialer = func(network, addr string) (net.Conn, error) { return nil, nil }
+	config.ProxyMaxIdleConns = 1000
+	config.ProxyMaxIdleConnsPerHost = 10
+
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	transport := master.proxyTransport.(*http.Transport)
+	if maxIdleConns := reflect.ValueOf(transport).Elem().FieldByName("MaxIdleConns"); maxIdleConns.IsValid() {
+		assert.Equal(int64(1000), maxIdleConns.Int())
+	}
+	assert.Equal(10, transport.MaxIdleConnsPerHost)
+}
+
+// TestNewNormalizesAPIPrefixes verifies that New normalizes the API prefixes
+// and rejects the ones that can't be used for routing.
+func TestNewNormalizesAPIPrefixes(t *testing.T) {
+	testCases := []struct {
+		prefix   string
+		expected string
+		err      bool
+	}{
+		{prefix: "/api", expected: "/api"},
+		{prefix: "api", expected: "/api"},
+		{prefix: "/api/", expected: "/api"},
+		{prefix: "//custom/api//", expected: "/custom/api"},
+		{prefix: "", err: true},
+		{prefix: "/", err: true},
+		{prefix: "/my api", err: true},
+	}
+	for _, testCase := range testCases {
+		for _, group := range []bool{false, true} {
+			_, etcdserver, config, assert := setUp(t)
+			config.KubeletClient = client.FakeKubeletClient{}
+			if group {
+				config.APIGroupPrefix = testCase.prefix
+			} else {
+				config.APIPrefix = testCase.prefix
+			}
+
+			master, err := New(&config)
+			if testCase.err {
+				assert.Error(err, "prefix %q", testCase.prefix)
+				assert.Nil(master, "prefix %q", testCase.prefix)
+			} else if assert.NoError(err, "prefix %q", testCase.prefix) {
+				if group {
+					assert.Equal(testCase.expected, master.apiGroupPrefix)
+				} else {
+					assert.Equal(testCase.expected, master.apiPrefix)
+				}
+			}
+			etcdserver.Terminate(t)
+		}
+	}
+}
+
+// TestSetDefaultsIPv6 verifies the master service IP is allocated from an
+// IPv6 service cluster IP range.
+func TestSetDefaultsIPv6(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	_, ipnet, err := net.ParseCIDR("fd00::/112")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	config.ServiceClusterIPRange = ipnet
+	setDefaults(&config)
+
+	assert.Equal(net.IPv6len, len(config.ServiceReadWriteIP))
+	assert.True(net.ParseIP("fd00::1").Equal(config.ServiceReadWriteIP), "unexpected service IP %s", config.ServiceReadWriteIP)
+}
+
+// TestSetDefaultsMasterLeaseTTL verifies that the master lease TTL defaults to
+// the reconcile interval plus the grace period, and that a TTL that is set is kept.
+func TestSetDefaultsMasterLeaseTTL(t *testing.T) {
+	reconcileInterval := 30 * time.Second
+	config := Config{ReconcileInterval: &reconcileInterval, MasterLeaseGracePeriod: 10 * time.Second}
+	setDefaults(&config)
+	assert.Equal(t, 40*time.Second, config.MasterLeaseTTL)
+
+	config = Config{}
+	setDefaults(&config)
+	assert.Equal(t, DefaultMasterLeaseGracePeriod, config.MasterLeaseGracePeriod)
+	assert.Equal(t, DefaultMasterLeaseTTL, config.MasterLeaseTTL)
+
+	config = Config{MasterLeaseTTL: time.Minute}
+	setDefaults(&config)
+	assert.Equal(t, time.Minute, config.MasterLeaseTTL)
+}
+
+// TestAnchorCORSOrigins verifies that CORS allowed origin patterns are anchored
+// so that they only match whole origins.
+func TestAnchorCORSOrigins(t *testing.T) {
+	config := Config{
+		CorsAllowedOriginList: []string{`^http://example\.com$`, `http://example\.com`, `//foo\.com$`, `^a\.com|b\.com$`},
+	}
+	setDefaults(&config)
+	assert.Equal(t, []string{`^(?:^http://example\.com$)$`, `^(?:http://example\.com)$`, `^(?://foo\.com$)$`, `^(?:^a\.com|b\.com$)$`}, config.CorsAllowedOriginList)
+
+	regexps, err := util.CompileRegexps(config.CorsAllowedOriginList)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, r := range regexps[:2] {
+		if !r.MatchString("http://example.com") {
+			t.Errorf("expected %v to match http://example.com", r)
+		}
+		for _, origin := range []string{"http://evil.com.attacker.net", "http://example.com.attacker.net", "http://attacker.net/http://example.com"} {
+			if r.MatchString(origin) {
+				t.Errorf("expected %v not to match %s", r, origin)
+			}
+		}
+	}
+	if regexps[2].MatchString("http://foo.com.attacker.net") {
+		t.Errorf("expected %v not to match http://foo.com.attacker.net", regexps[2])
+	}
+	if !regexps[3].MatchString("a.com") || !regexps[3].MatchString("b.com") {
+		t.Errorf("expected %v to match a.com and b.com", regexps[3])
+	}
+	if regexps[3].MatchString("a.com.evil.net") {
+		t.Errorf("expected %v not to match a.com.evil.net", regexps[3])
+	}
 }
 
 // TestGetServersToValidate verifies the unexported getServersToValidate function
@@ -139,6 +364,182 @@ func TestGetServersToValidate(t *testing.T) {
 	}
 }
 
+// TestGetServersToValidateRetries verifies the health check retry settings are
+// applied to every server to validate.
+func TestGetServersToValidateRetries(t *testing.T) {
+	master, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	config.HealthzRetries = 3
+	config.HealthzRetryBackoff = time.Second
+	servers := master.getServersToValidate(&config)
+
+	assert.Equal(3, len(servers), "unexpected server list: %#v", servers)
+	for name, server := range servers {
+		assert.Equal(3, server.Retries, "unexpected retries for %s", name)
+		assert.Equal(time.Second, server.RetryBackoff, "unexpected retry backoff for %s", name)
+	}
+}
+
+// TestStorageDestinationsGet verifies resource overrides, group defaults and the
+// global default are consulted in that order.
+func TestStorageDestinationsGet(t *testing.T) {
+	assert := assert.New(t)
+
+	groupStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group")
+	overrideStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/override")
+	defaultStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/default")
+
+	destinations := NewStorageDestinations()
+	destinations.AddAPIGroup(api.GroupName, groupStorage)
+	destinations.AddAPIResource(api.GroupName, "events", overrideStorage)
+	destinations.AddAPIResource(api.GroupName, "persistentvolumes", overrideStorage)
+	destinations.AddStorageOverride(extensions.GroupName, "jobs", overrideStorage)
+
+	assert.Equal(groupStorage, destinations.Get(api.GroupName, "pods"))
+	assert.Equal(overrideStorage, destinations.Get(api.GroupName, "events"))
+	assert.Equal(overrideStorage, destinations.Get(api.GroupName, "persistentVolumes"))
+	assert.Equal(overrideStorage, destinations.Get(extensions.GroupName, "jobs"))
+	assert.Nil(destinations.Get(extensions.GroupName, "ingresses"))
+	assert.Nil(destinations.Get("company.com", "foos"))
+
+	destinations.Default = defaultStorage
+	assert.Equal(groupStorage, destinations.Get(api.GroupName, "pods"))
+	assert.Equal(defaultStorage, destinations.Get(extensions.GroupName, "ingresses"))
+	assert.Equal(defaultStorage, destinations.Get("company.com", "foos"))
+}
+
+// TestStorageDestinationsTimeout verifies that the destinations of a group with a
+// timeout, including its overrides and the global default, enforce the timeout.
+func TestStorageDestinationsTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	groupStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group")
+	overrideStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/override")
+	defaultStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/default")
+
+	destinations := NewStorageDestinations()
+	destinations.Default = defaultStorage
+	destinations.AddAPIGroup(api.GroupName, groupStorage)
+	destinations.AddAPIResource(api.GroupName, "events", overrideStorage)
+	destinations.SetAPIGroupTimeout(api.GroupName, time.Second)
+	destinations.SetAPIGroupTimeout(extensions.GroupName, 2*time.Second)
+
+	assert.Equal(storage.NewTimeoutStorage(groupStorage, time.Second), destinations.Get(api.GroupName, "pods"))
+	assert.Equal(storage.NewTimeoutStorage(overrideStorage, time.Second), destinations.Get(api.GroupName, "events"))
+	assert.Equal(storage.NewTimeoutStorage(defaultStorage, 2*time.Second), destinations.Get(extensions.GroupName, "jobs"))
+	assert.Equal(defaultStorage, destinations.Get("company.com", "foos"))
+}
+
+// TestStorageDestinationsPrefix verifies that the destinations of a group with a
+// prefix, including its overrides and the global default, store their keys under
+// the prefix.
+func TestStorageDestinationsPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	groupStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group")
+	overrideStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/override")
+	defaultStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/default")
+
+	destinations := NewStorageDestinations()
+	destinations.Default = defaultStorage
+	destinations.AddAPIGroupWithPrefix(api.GroupName, groupStorage, "/tenant-a")
+	destinations.AddAPIResource(api.GroupName, "events", overrideStorage)
+	destinations.AddAPIGroupWithPrefix(extensions.GroupName, nil, "tenant-b")
+	destinations.SetAPIGroupTimeout(extensions.GroupName, time.Second)
+
+	assert.Equal(storage.NewPrefixStorage(groupStorage, "/tenant-a"), destinations.Get(api.GroupName, "pods"))
+	assert.Equal(storage.NewPrefixStorage(overrideStorage, "/tenant-a"), destinations.Get(api.GroupName, "events"))
+	assert.Equal(storage.NewTimeoutStorage(storage.NewPrefixStorage(defaultStorage, "/tenant-b"), time.Second), destinations.Get(extensions.GroupName, "thirdpartyresourcedata"))
+	assert.Equal(defaultStorage, destinations.Get("company.com", "foos"))
+}
+
+// TestStorageDestinationsRateLimit verifies that the destinations of a group with
+// a rate limit, including its overrides, share its rate limiter.
+func TestStorageDestinationsRateLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	groupStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group")
+	overrideStorage := etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/override")
+
+	destinations := NewStorageDestinations()
+	destinations.AddAPIGroup(api.GroupName, groupStorage)
+	destinations.AddAPIResource(api.GroupName, "events", overrideStorage)
+	destinations.SetAPIGroupRateLimit(api.GroupName, StorageRateLimit{QPS: 10, Burst: 20})
+	limiter := destinations.APIGroups[api.GroupName].rateLimiter
+
+	assert.NotNil(limiter)
+	assert.Equal(storage.NewRateLimitedStorage(groupStorage, limiter), destinations.Get(api.GroupName, "pods"))
+	assert.Equal(storage.NewRateLimitedStorage(overrideStorage, limiter), destinations.Get(api.GroupName, "events"))
+
+	destinations.SetAPIGroupRateLimit(api.GroupName, StorageRateLimit{QPS: 10})
+	assert.Nil(destinations.APIGroups[api.GroupName].rateLimiter)
+	assert.Equal(groupStorage, destinations.Get(api.GroupName, "pods"))
+}
+
+// TestInstallThirdPartyAPIPrefix verifies that third party objects are stored
+// under the prefix of the extensions group.
+func TestInstallThirdPartyAPIPrefix(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	destinations := NewStorageDestinations()
+	destinations.AddAPIGroupWithPrefix(extensions.GroupName, etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix()), "tenant-a")
+	master.requestContextMapper = api.NewRequestContextMapper()
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = destinations.Get(extensions.GroupName, "thirdpartyresourcedata")
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	key := "/ThirdPartyResourceData/company.com/foos/default/test"
+	_, err = etcdserver.Client.Get(path.Join(etcdtest.PathPrefix(), "tenant-a", key), false, false)
+	assert.NoError(err)
+	_, err = etcdserver.Client.Get(path.Join(etcdtest.PathPrefix(), key), false, false)
+	assert.True(etcdutil.IsEtcdNotFound(err), "expected the unprefixed key not to exist, got %v", err)
+}
+
+// TestStorageDestinationsCodec verifies that the storage codec of each group
+// is recorded and falls back to the codec of the default destination.
+func TestStorageDestinationsCodec(t *testing.T) {
+	assert := assert.New(t)
+
+	destinations := NewStorageDestinations()
+	destinations.AddAPIGroup(api.GroupName, etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group"))
+	destinations.AddAPIGroupWithCodec(extensions.GroupName, etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/group"), testapi.Extensions.Codec())
+	destinations.AddAPIResource("company.com", "foos", etcdstorage.NewEtcdStorage(nil, testapi.Default.Codec(), "/override"))
+
+	assert.Equal(testapi.Default.Codec(), destinations.Codec(api.GroupName))
+	assert.Equal(testapi.Extensions.Codec(), destinations.Codec(extensions.GroupName))
+	assert.Nil(destinations.Codec("company.com"))
+	assert.Nil(destinations.Codec("other.com"))
+
+	destinations.Default = etcdstorage.NewEtcdStorage(nil, testapi.Extensions.Codec(), "/default")
+	assert.Equal(testapi.Extensions.Codec(), destinations.Codec("company.com"))
+	assert.Equal(testapi.Extensions.Codec(), destinations.Codec("other.com"))
+}
+
 // TestFindExternalAddress verifies both pass and fail cases for the unexported
 // findExternalAddress function
 func TestFindExternalAddress(t *testing.T) {
@@ -192,6 +593,11 @@ func TestNewBootstrapController(t *testing.T) {
 	master.masterCount = 1
 	master.serviceReadWritePort = 1000
 	master.publicReadWritePort = 1010
+	master.reconcileInterval = 5 * time.Second
+	master.reservedServiceIPs = []net.IP{net.ParseIP("10.0.0.10")}
+	master.kubernetesServiceSessionAffinity = api.ServiceAffinityClientIP
+	master.kubernetesServiceType = api.ServiceTypeNodePort
+	master.endpointReconciler = &fakeEndpointReconciler{}
 
 	controller := master.NewBootstrapController()
 
@@ -202,6 +608,11 @@ func TestNewBootstrapController(t *testing.T) {
 	assert.Equal(controller.MasterCount, master.masterCount)
 	assert.Equal(controller.ServicePort, master.serviceReadWritePort)
 	assert.Equal(controller.PublicServicePort, master.publicReadWritePort)
+	assert.Equal(controller.ReconcileInterval, master.reconcileInterval)
+	assert.Equal(controller.ServiceClusterIPReserved, master.reservedServiceIPs)
+	assert.Equal(controller.SessionAffinity, master.kubernetesServiceSessionAffinity)
+	assert.Equal(controller.KubernetesServiceType, master.kubernetesServiceType)
+	assert.Equal(controller.EndpointReconciler, master.endpointReconciler)
 }
 
 // TestControllerServicePorts verifies master extraServicePorts are
@@ -235,6 +646,40 @@ func TestControllerServicePorts(t *testing.T) {
 	assert.Equal(1010, controller.ExtraServicePorts[1].Port)
 }
 
+// TestShutdown verifies that Shutdown stops the master's loops, rejects new
+// requests and waits for in-flight requests to drain.
+func TestShutdown(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.stopCh = make(chan struct{})
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := master.inflight.track(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		close(started)
+		<-release
+	}))
+	go handler.ServeHTTP(httptest.NewRecorder(), &http.Request{})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(context.DeadlineExceeded, master.Shutdown(ctx))
+	select {
+	case <-master.stopCh:
+	default:
+		t.Errorf("expected stop channel to be closed")
+	}
+
+	rejected := httptest.NewRecorder()
+	handler.ServeHTTP(rejected, &http.Request{})
+	assert.Equal(http.StatusServiceUnavailable, rejected.Code)
+
+	close(release)
+	assert.NoError(master.Shutdown(context.Background()))
+	assert.NoError(master.Shutdown(context.Background()))
+}
+
 // TestNewHandlerContainer verifies that NewHandlerContainer uses the
 // mux provided
 func TestNewHandlerContainer(t *testing.T) {
@@ -272,6 +717,103 @@ func TestHandleFuncWithAuth(t *testing.T) {
 	assert.Contains(master.muxHelper.RegisteredPaths, "/test", "Path not found in muxHelper")
 }
 
+// TestRegisteredPaths verifies that the registered paths include the web services,
+// the mux handlers and the third party resource groups while they are installed.
+func TestRegisteredPaths(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+	defer etcdserver.Terminate(t)
+
+	master.muxHelper = &apiserver.MuxHelper{Mux: http.NewServeMux()}
+	master.HandleFuncWithAuth("/test", func(w http.ResponseWriter, r *http.Request) {})
+	master.InstallSwaggerAPI()
+
+	paths := sets.NewString(master.RegisteredPaths()...)
+	for _, path := range []string{"/apis/company.com", "/apis/company.com/v1", "/test", "/swaggerapi/", "/swaggerui/"} {
+		assert.True(paths.Has(path), "missing path %s in %v", path, paths.List())
+	}
+	assert.True(sort.StringsAreSorted(master.RegisteredPaths()))
+
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	paths = sets.NewString(master.RegisteredPaths()...)
+	assert.False(paths.Has("/apis/company.com"), "removed group still registered: %v", paths.List())
+	assert.True(paths.Has("/test"))
+}
+
+// TestAPIGroupVersions verifies that the group versions served by the master and
+// their roots are computed from its web services, including the third party
+// resource groups.
+func TestAPIGroupVersions(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+	defer etcdserver.Terminate(t)
+
+	master.apiPrefix = "/api"
+	master.apiGroupPrefix = "/apis"
+	for _, path := range []string{"/api", "/api/v1", "/apis", "/apis/extensions", "/apis/extensions/v1beta1"} {
+		ws := new(restful.WebService)
+		ws.Path(path)
+		master.handlerContainer.Add(ws)
+	}
+
+	expected := []unversioned.GroupVersion{
+		{Group: "company.com", Version: "v1"},
+		{Group: "extensions", Version: "v1beta1"},
+		{Version: "v1"},
+	}
+	assert.Equal(expected, master.APIGroupVersions())
+	assert.Equal(map[unversioned.GroupVersion]string{
+		{Group: "company.com", Version: "v1"}:     "/apis/company.com/v1",
+		{Group: "extensions", Version: "v1beta1"}: "/apis/extensions/v1beta1",
+		{Version: "v1"}: "/api/v1",
+	}, master.APIGroupVersionRoots())
+
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	assert.Equal(expected[1:], master.APIGroupVersions())
+}
+
+// TestRootIndex verifies that the index at / lists the served paths, including
+// the third party resource groups installed after init.
+func TestRootIndex(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	config.EnableIndex = true
+
+	master.init(&config)
+	server := httptest.NewServer(master.mux.(*http.ServeMux))
+	defer server.Close()
+
+	getRootPaths := func() sets.String {
+		resp, err := http.Get(server.URL + "/")
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		rootPaths := unversioned.RootPaths{}
+		assert.NoError(decodeResponse(resp, &rootPaths))
+		return sets.NewString(rootPaths.Paths...)
+	}
+
+	paths := getRootPaths()
+	for _, path := range []string{"/api", "/apis", "/apis/extensions"} {
+		assert.True(paths.Has(path), "missing path %s in %v", path, paths.List())
+	}
+	assert.False(paths.Has("/apis/company.com"))
+
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	assert.True(getRootPaths().Has("/apis/company.com"))
+
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	assert.False(getRootPaths().Has("/apis/company.com"))
+}
+
 // TestInstallSwaggerAPI verifies that the swagger api is added
 // at the proper endpoint.
 func TestInstallSwaggerAPI(t *testing.T) {
@@ -308,6 +850,184 @@ func TestInstallSwaggerAPI(t *testing.T) {
 	}
 }
 
+// TestInstallSwaggerAPITwice verifies that installing swagger again leaves a
+// single swagger web service, which still serves the listing.
+func TestInstallSwaggerAPITwice(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.handlerContainer = NewHandlerContainer(http.NewServeMux())
+	master.InstallSwaggerAPI()
+	master.InstallSwaggerAPI()
+
+	swaggerServices := 0
+	for _, ws := range master.handlerContainer.RegisteredWebServices() {
+		if ws.RootPath() == "/swaggerapi/" {
+			swaggerServices++
+		}
+	}
+	assert.Equal(1, swaggerServices)
+
+	server := httptest.NewServer(master.handlerContainer)
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/swaggerapi/")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+}
+
+// TestRefreshExternalAddress verifies that the external host and the swagger
+// URLs derived from it follow the external address of the schedulable nodes,
+// unless the external host is set by the config.
+func TestRefreshExternalAddress(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.handlerContainer = NewHandlerContainer(http.NewServeMux())
+	master.externalHost = "old.example.com"
+	master.InstallSwaggerAPI()
+	assert.Equal("https://old.example.com", master.swaggerConfig.WebServicesUrl)
+
+	assert.Error(master.RefreshExternalAddress(), "no node has an external address")
+	assert.Equal("old.example.com", master.externalHost)
+
+	nodes, _ := master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
+	if !assert.Equal(2, len(nodes.Items)) {
+		t.FailNow()
+	}
+	nodes.Items[0].Spec.Unschedulable = true
+	nodes.Items[0].Status.Addresses = []api.NodeAddress{{Type: api.NodeExternalIP, Address: "10.0.0.1"}}
+	nodes.Items[1].Status.Addresses = []api.NodeAddress{{Type: api.NodeExternalIP, Address: "10.0.0.2"}}
+
+	assert.NoError(master.RefreshExternalAddress())
+	assert.Equal("10.0.0.2", master.externalHost)
+	assert.Equal("https://10.0.0.2", master.swaggerConfig.WebServicesUrl)
+	assert.Equal("10.0.0.2", master.EffectiveConfig().ExternalHost)
+
+	// An external host set by the config is kept.
+	master.externalHost = "configured.example.com"
+	master.externalHostConfigured = true
+	assert.NoError(master.RefreshExternalAddress())
+	assert.Equal("configured.example.com", master.externalHost)
+}
+
+// TestInstallSwaggerAPIThirdParty verifies that third party resources installed
+// after swagger show up in the swagger listing.
+func TestInstallSwaggerAPIThirdParty(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.handlerContainer = NewHandlerContainer(http.NewServeMux())
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.InstallSwaggerAPI()
+
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/swaggerapi/")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(err)
+	assert.Contains(string(data), "/apis/company.com/v1")
+
+	resp, err = http.Get(server.URL + "/swaggerapi/apis/company.com/v1")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	resp, err = http.Get(server.URL + "/swaggerapi/")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	data, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(err)
+	assert.NotContains(string(data), "/apis/company.com/v1")
+}
+
+// TestInstallOpenAPI verifies that a Swagger 2.0 spec covering the installed
+// groups, including third party resources and the table output of their objects,
+// is served at /swagger.json.
+func TestInstallOpenAPI(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	columns := []extensions.ThirdPartyResourceColumn{{Name: "Replicas", JSONPath: ".spec.replicas"}}
+	if !assert.NoError(master.UpdateThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta:               api.ObjectMeta{Name: "foo.company.com"},
+		Versions:                 []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+		AdditionalPrinterColumns: columns,
+	})) {
+		t.FailNow()
+	}
+	master.InstallOpenAPI()
+	resp, err := http.Get(server.URL + "/swagger.json")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	spec := openAPISpec{}
+	if !assert.NoError(decodeResponse(resp, &spec)) {
+		t.FailNow()
+	}
+	assert.Equal("2.0", spec.Swagger)
+	item, ok := spec.Paths["/apis/company.com/v1/namespaces/{namespace}/foos"]
+	if !assert.True(ok, "third party path missing from %v", spec.Paths) {
+		t.FailNow()
+	}
+	list, ok := item["get"]
+	if !assert.True(ok, "missing get operation: %v", item) {
+		t.FailNow()
+	}
+	assert.Contains(list.Produces, tableContentType)
+	assert.Equal(columns, list.TableColumns)
+	if get := spec.Paths["/apis/company.com/v1/namespaces/{namespace}/foos/{name}"]["get"]; assert.NotNil(get) {
+		assert.Equal(columns, get.TableColumns)
+	}
+	for path, item := range spec.Paths {
+		if strings.Contains(path, "/watch/") && item["get"] != nil {
+			assert.Empty(item["get"].TableColumns, path)
+		}
+	}
+	assert.Empty(item["post"].TableColumns)
+	response, ok := list.Responses["200"]
+	if assert.True(ok, "missing 200 response: %v", list.Responses) && assert.NotNil(response.Schema) {
+		ref := strings.TrimPrefix(response.Schema.Ref, "#/definitions/")
+		_, found := spec.Definitions[ref]
+		assert.True(found, "definition %q missing", ref)
+	}
+	post := item["post"]
+	if assert.NotNil(post) {
+		found := false
+		for _, param := range post.Parameters {
+			if param.In == "body" {
+				found = true
+				assert.NotNil(param.Schema)
+			}
+		}
+		assert.True(found, "missing body parameter: %v", post.Parameters)
+	}
+}
+
 // TestDefaultAPIGroupVersion verifies that the unexported defaultAPIGroupVersion
 // creates the expected APIGroupVersion based off of master.
 func TestDefaultAPIGroupVersion(t *testing.T) {
@@ -338,22 +1058,174 @@ func TestExpapi(t *testing.T) {
 	assert.Equal(expAPIGroup.GroupVersion, extensionsGroupMeta.GroupVersion)
 }
 
-// TestGetNodeAddresses verifies that proper results are returned
-// when requesting node addresses.
-func TestGetNodeAddresses(t *testing.T) {
-	master, etcdserver, _, assert := setUp(t)
+// TestDeprecatedAPIGroupVersions verifies that the deprecation messages of the
+// master's config reach the group versions they are configured for.
+func TestDeprecatedAPIGroupVersions(t *testing.T) {
+	master, etcdserver, config, assert := setUp(t)
 	defer etcdserver.Terminate(t)
 
-	// Fail case (no addresses associated with nodes)
-	nodes, _ := master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
-	addrs, err := master.getNodeAddresses()
+	assert.Empty(master.api_v1().DeprecationMessage)
+	assert.Empty(master.experimental(&config).DeprecationMessage)
 
-	assert.Error(err, "getNodeAddresses should have caused an error as there are no addresses.")
-	assert.Equal([]string(nil), addrs)
+	master.deprecatedAPIGroupVersions = map[string]string{"extensions/v1beta1": "extensions/v1beta1 is deprecated"}
+	assert.Empty(master.api_v1().DeprecationMessage)
+	assert.Equal("extensions/v1beta1 is deprecated", master.experimental(&config).DeprecationMessage)
+}
 
-	// Pass case with External type IP
-	nodes, _ = master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
-	for index := range nodes.Items {
+// TestAggregatedDiscovery verifies that the master serves the groups it installs,
+// and the third party groups added and removed later, in a single discovery
+// document.
+func TestAggregatedDiscovery(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.init(&config)
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	server := httptest.NewServer(master.muxHelper.Mux.(*http.ServeMux))
+	defer server.Close()
+
+	groups := func() map[string]apiserver.DiscoveryGroup {
+		resp, err := http.Get(server.URL + aggregatedDiscoveryPath)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		discovery := apiserver.AggregatedDiscovery{}
+		assert.NoError(decodeResponse(resp, &discovery))
+		groups := map[string]apiserver.DiscoveryGroup{}
+		for _, group := range discovery.Groups {
+			groups[group.Name] = group
+		}
+		return groups
+	}
+
+	found := groups()
+	if assert.Len(found[""].Versions, 1) {
+		assert.Equal("v1", found[""].PreferredVersion)
+		assert.NotEmpty(found[""].Versions[0].APIResources)
+	}
+	_, ok := found["company.com"]
+	assert.False(ok)
+
+	if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	})) {
+		t.FailNow()
+	}
+	found = groups()
+	if assert.Len(found["company.com"].Versions, 1) {
+		assert.Equal("company.com/v1", found["company.com"].Versions[0].GroupVersion)
+		assert.Equal("foos", found["company.com"].Versions[0].APIResources[0].Name)
+	}
+
+	if !assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com"))) {
+		t.FailNow()
+	}
+	_, ok = groups()["company.com"]
+	assert.False(ok)
+}
+
+// TestPatchExtensions verifies that deployments in the extensions group, and their
+// scale, can be patched with JSON and merge patches, that patched objects are
+// validated, and that patches based on a stale resource version conflict.
+func TestPatchExtensions(t *testing.T) {
+	master, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.apiGroupPrefix = "/apis"
+	master.admissionControl = admit.NewAlwaysAdmit()
+	master.apiGroupVersionOverrides = map[string]APIGroupVersionOverride{
+		"extensions/v1beta1": {ResourceOverrides: map[string]bool{"deployments": true}},
+	}
+	master.handlerContainer = restful.NewContainer()
+	if !assert.NoError(master.experimental(&config).InstallREST(master.handlerContainer)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+	deploymentURL := server.URL + "/apis/extensions/v1beta1/namespaces/default/deployments/test"
+
+	resp, err := http.Post(server.URL+"/apis/extensions/v1beta1/namespaces/default/deployments", "application/json", strings.NewReader(`{
+		"apiVersion": "extensions/v1beta1",
+		"kind": "Deployment",
+		"metadata": {"name": "test"},
+		"spec": {
+			"replicas": 1,
+			"selector": {"app": "web"},
+			"template": {
+				"metadata": {"labels": {"app": "web"}},
+				"spec": {"containers": [{"name": "web", "image": "nginx"}]}
+			}
+		}
+	}`))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	created := map[string]interface{}{}
+	assert.NoError(decodeResponse(resp, &created))
+	if !assert.Equal(http.StatusCreated, resp.StatusCode) {
+		t.FailNow()
+	}
+	staleVersion := created["metadata"].(map[string]interface{})["resourceVersion"].(string)
+
+	patch := func(url string, patchType api.PatchType, body string) (*http.Response, map[string]interface{}) {
+		req, err := http.NewRequest("PATCH", url, strings.NewReader(body))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		req.Header.Set("Content-Type", string(patchType))
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		result := map[string]interface{}{}
+		assert.NoError(decodeResponse(resp, &result))
+		return resp, result
+	}
+	replicas := func(obj map[string]interface{}) interface{} {
+		spec, _ := obj["spec"].(map[string]interface{})
+		return spec["replicas"]
+	}
+
+	resp, result := patch(deploymentURL, api.MergePatchType, `{"spec":{"replicas":3}}`)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal(float64(3), replicas(result))
+
+	resp, result = patch(deploymentURL+"/scale", api.JSONPatchType, `[{"op":"replace","path":"/spec/replicas","value":5}]`)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal(float64(5), replicas(result))
+
+	resp, err = http.Get(deploymentURL)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	result = map[string]interface{}{}
+	assert.NoError(decodeResponse(resp, &result))
+	assert.Equal(float64(5), replicas(result))
+
+	resp, _ = patch(deploymentURL, api.JSONPatchType, `[{"op":"replace","path":"/spec/replicas","value":-1}]`)
+	assert.Equal(422, resp.StatusCode)
+
+	resp, _ = patch(deploymentURL, api.MergePatchType, `{"metadata":{"resourceVersion":"`+staleVersion+`"},"spec":{"replicas":7}}`)
+	assert.Equal(http.StatusConflict, resp.StatusCode)
+}
+
+// TestGetNodeAddresses verifies that proper results are returned
+// when requesting node addresses.
+func TestGetNodeAddresses(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	// Fail case (no addresses associated with nodes)
+	nodes, _ := master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
+	addrs, err := master.getNodeAddresses()
+
+	assert.Error(err, "getNodeAddresses should have caused an error as there are no addresses.")
+	assert.Equal([]string(nil), addrs)
+
+	// Pass case with External type IP
+	nodes, _ = master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
+	for index := range nodes.Items {
 		nodes.Items[index].Status.Addresses = []api.NodeAddress{{Type: api.NodeExternalIP, Address: "127.0.0.1"}}
 	}
 	addrs, err = master.getNodeAddresses()
@@ -368,31 +1240,92 @@ func TestGetNodeAddresses(t *testing.T) {
 	addrs, err = master.getNodeAddresses()
 	assert.NoError(err, "getNodeAddresses failback should not have returned an error.")
 	assert.Equal([]string{"127.0.0.2", "127.0.0.2"}, addrs)
+
+	// Pass case with a custom address resolver
+	master.tunnelerAddressResolver = func(node *api.Node) (string, error) {
+		return node.Name + ".bastion.example.com", nil
+	}
+	addrs, err = master.getNodeAddresses()
+	assert.NoError(err, "getNodeAddresses with a custom resolver should not have returned an error.")
+	assert.Equal([]string{"node1.bastion.example.com", "node2.bastion.example.com"}, addrs)
+
+	// Fail case with a custom address resolver
+	master.tunnelerAddressResolver = func(node *api.Node) (string, error) {
+		return "", fmt.Errorf("no bastion mapping for %s", node.Name)
+	}
+	addrs, err = master.getNodeAddresses()
+	assert.Error(err, "getNodeAddresses should have returned the resolver error.")
+	assert.Equal([]string(nil), addrs)
 }
 
-func TestDiscoveryAtAPIS(t *testing.T) {
-	master, etcdserver, config, assert := setUp(t)
+// fakeTunnelChecker is a Tunneler with open tunnels to some addresses.
+type fakeTunnelChecker struct {
+	Tunneler
+	tunnels sets.String
+}
+
+func (t *fakeTunnelChecker) HasTunnel(addr string) bool {
+	return t.tunnels.Has(addr)
+}
+
+// TestGetNodeAddressesFailover verifies that failover node address selection
+// keeps the address of every node until the tunnel to it can't be opened, and
+// then moves on to the node's next external address.
+func TestGetNodeAddressesFailover(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
 	defer etcdserver.Terminate(t)
 
-	// ================= preparation for master.init() ======================
-	portRange := util.PortRange{Base: 10, Size: 10}
-	master.serviceNodePortRange = portRange
+	nodes, _ := master.nodeRegistry.ListNodes(api.NewDefaultContext(), nil)
+	nodes.Items[0].Status.Addresses = []api.NodeAddress{
+		{Type: api.NodeExternalIP, Address: "10.0.0.1"},
+		{Type: api.NodeLegacyHostIP, Address: "10.0.1.1"},
+		{Type: api.NodeExternalIP, Address: "10.0.0.2"},
+	}
+	nodes.Items[1].Status.Addresses = []api.NodeAddress{{Type: api.NodeLegacyHostIP, Address: "10.0.1.2"}}
 
-	_, ipnet, err := net.ParseCIDR("192.168.1.1/24")
-	if !assert.NoError(err) {
-		t.Errorf("unexpected error: %v", err)
+	// The first address is always selected by default.
+	for i := 0; i < 2; i++ {
+		addrs, err := master.getNodeAddresses()
+		assert.NoError(err)
+		assert.Equal([]string{"10.0.0.1", "10.0.1.2"}, addrs)
 	}
-	master.serviceClusterIPRange = ipnet
 
-	mh := apiserver.MuxHelper{Mux: http.NewServeMux()}
-	master.muxHelper = &mh
-	master.rootWebService = new(restful.WebService)
+	tunneler := &fakeTunnelChecker{tunnels: sets.NewString()}
+	master.tunneler = tunneler
+	master.nodeAddressSelection = NodeAddressSelectionFailover
+	testCases := []struct {
+		tunnels  []string
+		expected []string
+	}{
+		// The first addresses are selected before any tunnel is opened.
+		{nil, []string{"10.0.0.1", "10.0.1.2"}},
+		// The addresses with open tunnels are kept.
+		{[]string{"10.0.0.1", "10.0.1.2"}, []string{"10.0.0.1", "10.0.1.2"}},
+		{[]string{"10.0.0.1", "10.0.1.2"}, []string{"10.0.0.1", "10.0.1.2"}},
+		// The next address of a node is selected when the tunnel to its address
+		// couldn't be opened, and the only address of a node is kept.
+		{nil, []string{"10.0.0.2", "10.0.1.2"}},
+		{[]string{"10.0.0.2", "10.0.1.2"}, []string{"10.0.0.2", "10.0.1.2"}},
+		{[]string{"10.0.1.2"}, []string{"10.0.0.1", "10.0.1.2"}},
+	}
+	for i, testCase := range testCases {
+		tunneler.tunnels = sets.NewString(testCase.tunnels...)
+		addrs, err := master.getNodeAddresses()
+		assert.NoError(err)
+		assert.Equal(testCase.expected, addrs, "%d", i)
+	}
 
-	master.handlerContainer = restful.NewContainer()
+	// The addresses of the deleted nodes are forgotten.
+	tunneler.tunnels = sets.NewString("10.0.0.1", "10.0.1.2")
+	nodes.Items = nodes.Items[:1]
+	_, err := master.getNodeAddresses()
+	assert.NoError(err)
+	assert.Equal(map[string]string{"node1": "10.0.0.1"}, master.nodeAddresses)
+}
 
-	master.mux = http.NewServeMux()
-	master.requestContextMapper = api.NewRequestContextMapper()
-	// ======================= end of preparation ===========================
+func TestDiscoveryAtAPIS(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
 
 	master.init(&config)
 	server := httptest.NewServer(master.handlerContainer.ServeMux)
@@ -425,6 +1358,249 @@ func TestDiscoveryAtAPIS(t *testing.T) {
 	assert.Equal(expectPreferredVersion, groupList.Groups[0].PreferredVersion)
 }
 
+// TestDisabledVersionAtAPIS verifies that a disabled version of a group is not
+// served, while the group is still listed at /apis.
+func TestDisabledVersionAtAPIS(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.apiGroupVersionOverrides = map[string]APIGroupVersionOverride{
+		testapi.Extensions.GroupVersion().String(): {Disable: true},
+	}
+
+	master.init(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/apis/" + testapi.Extensions.GroupVersion().String() + "/namespaces/default/jobs")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/apis")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	groupList := unversioned.APIGroupList{}
+	assert.NoError(decodeResponse(resp, &groupList))
+	if !assert.Equal(1, len(groupList.Groups)) {
+		t.FailNow()
+	}
+	assert.Equal(extensions.GroupName, groupList.Groups[0].Name)
+	assert.Empty(groupList.Groups[0].Versions)
+}
+
+// TestDisableCoreAPI verifies that the legacy API group, and its discovery, are
+// not served when DisableCoreAPI is set, while the other groups still are.
+func TestDisableCoreAPI(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.handlerContainer.Router(restful.CurlyRouter{})
+	master.apiPrefix = config.APIPrefix
+	master.apiGroupPrefix = config.APIGroupPrefix
+	master.disableCoreAPI = true
+
+	master.init(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	for _, path := range []string{"/api", "/api/v1", "/api/v1/namespaces/default/pods"} {
+		resp, err := http.Get(server.URL + path)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(http.StatusNotFound, resp.StatusCode, path)
+	}
+
+	resp, err := http.Get(server.URL + "/apis/" + testapi.Extensions.GroupVersion().String())
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+}
+
+// TestDisableCoreAPIControllers verifies that the core controller loops are not
+// started when the legacy API group is disabled.
+func TestDisableCoreAPIControllers(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	config.KubeletClient = client.FakeKubeletClient{}
+	config.EnableCoreControllers = true
+	config.DisableCoreAPI = true
+	master, err := New(&config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer master.Shutdown(context.Background())
+	assert.False(master.enableCoreControllers)
+	assert.Nil(master.bootstrapController)
+}
+
+// TestHideEmptyAPIGroups verifies that groups without installed resources are
+// not listed at /apis when HideEmptyAPIGroups is set.
+func TestHideEmptyAPIGroups(t *testing.T) {
+	extensionsGroupVersion := testapi.Extensions.GroupVersion().String()
+	testCases := map[string]struct {
+		overrides      map[string]APIGroupVersionOverride
+		hide           bool
+		expectedGroups int
+	}{
+		"default": {
+			expectedGroups: 1,
+		},
+		"no resources, listed": {
+			overrides: map[string]APIGroupVersionOverride{
+				extensionsGroupVersion: {ResourceOverrides: map[string]bool{"jobs": false, "horizontalpodautoscalers": false, "ingresses": false}},
+			},
+			expectedGroups: 1,
+		},
+		"no resources, hidden": {
+			overrides: map[string]APIGroupVersionOverride{
+				extensionsGroupVersion: {ResourceOverrides: map[string]bool{"jobs": false, "horizontalpodautoscalers": false, "ingresses": false}},
+			},
+			hide:           true,
+			expectedGroups: 0,
+		},
+		"disabled version, hidden": {
+			overrides: map[string]APIGroupVersionOverride{
+				extensionsGroupVersion: {Disable: true},
+			},
+			hide:           true,
+			expectedGroups: 0,
+		},
+		"resources, not hidden": {
+			hide:           true,
+			expectedGroups: 1,
+		},
+	}
+	for name, testCase := range testCases {
+		master, etcdserver, config, assert := setUpForInit(t)
+		master.apiGroupVersionOverrides = testCase.overrides
+		config.HideEmptyAPIGroups = testCase.hide
+
+		master.init(&config)
+		server := httptest.NewServer(master.handlerContainer.ServeMux)
+		resp, err := http.Get(server.URL + "/apis")
+		if assert.NoError(err, name) {
+			groupList := unversioned.APIGroupList{}
+			assert.NoError(decodeResponse(resp, &groupList), name)
+			assert.Equal(testCase.expectedGroups, len(groupList.Groups), name)
+		}
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
+
+// TestContentionProfiling verifies that block and mutex profiling is only
+// enabled when both profiling and contention profiling are requested, the mutex
+// profiling only if it is supported.
+func TestContentionProfiling(t *testing.T) {
+	defer goruntime.SetBlockProfileRate(0)
+	defer setMutexProfileFraction(0)
+	testCases := map[string]struct {
+		profiling, contention bool
+		expectedFraction      int
+	}{
+		"disabled":                     {},
+		"profiling only":               {profiling: true},
+		"contention without profiling": {contention: true},
+		"profiling and contention":     {profiling: true, contention: true, expectedFraction: 1},
+	}
+	for name, testCase := range testCases {
+		if !mutexProfilingSupported {
+			testCase.expectedFraction = 0
+		}
+		setMutexProfileFraction(0)
+		master, etcdserver, config, assert := setUpForInit(t)
+		config.EnableProfiling = testCase.profiling
+		config.EnableContentionProfiling = testCase.contention
+
+		master.init(&config)
+		assert.Equal(testCase.expectedFraction, setMutexProfileFraction(-1), name)
+		if testCase.profiling && testCase.contention {
+			paths := []string{"/debug/pprof/block"}
+			if mutexProfilingSupported {
+				paths = append(paths, "/debug/pprof/mutex")
+			}
+			server := httptest.NewServer(master.mux.(*http.ServeMux))
+			for _, path := range paths {
+				resp, err := http.Get(server.URL + path)
+				if assert.NoError(err, name) {
+					resp.Body.Close()
+					assert.Equal(http.StatusOK, resp.StatusCode, path)
+				}
+			}
+			server.Close()
+		}
+		etcdserver.Terminate(t)
+	}
+}
+
+// TestUIAssetPath verifies that the files of the UI asset directory are served at /ui/.
+func TestUIAssetPath(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	dir, err := ioutil.TempDir("", "ui")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	if !assert.NoError(ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("custom dashboard"), 0644)) {
+		t.FailNow()
+	}
+
+	config.EnableUISupport = true
+	config.UIAssetPath = dir
+
+	master.init(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/ui/index.html")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal("custom dashboard", string(data))
+}
+
+// TestStorageVersionsAtVersion verifies that the storage version of each group
+// is reported at /version/storage.
+func TestStorageVersionsAtVersion(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.storageVersions = config.StorageVersions
+
+	master.init(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/version/storage")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	versions := StorageVersions{}
+	assert.NoError(decodeResponse(resp, &versions))
+	assert.Equal(map[string]string{
+		api.GroupName:        testapi.Default.GroupVersion().String(),
+		extensions.GroupName: testapi.Extensions.GroupVersion().String(),
+	}, versions.StorageVersions)
+}
+
 var versionsToTest = []string{"v1", "v3"}
 
 type Foo struct {
@@ -436,8 +1612,8 @@ type Foo struct {
 }
 
 type FooList struct {
-	unversioned.TypeMeta `json:",inline"`
-	unversioned.ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata"`
+	unversioned.TypeMeta            `json:",inline"`
+	thirdpartyresourcedata.ListMeta `json:"metadata,omitempty" description:"standard list metadata; see http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata"`
 
 	Items []Foo `json:"items"`
 }
@@ -445,6 +1621,7 @@ type FooList struct {
 func initThirdParty(t *testing.T, version string) (*Master, *etcdtesting.EtcdTestServer, *httptest.Server, *assert.Assertions) {
 	master, etcdserver, _, assert := setUp(t)
 
+	master.requestContextMapper = api.NewRequestContextMapper()
 	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
 	api := &extensions.ThirdPartyResource{
 		ObjectMeta: api.ObjectMeta{
@@ -465,7 +1642,48 @@ func initThirdParty(t *testing.T, version string) (*Master, *etcdtesting.EtcdTes
 	}
 
 	server := httptest.NewServer(master.handlerContainer.ServeMux)
-	return &master, etcdserver, server, assert
+	return master, etcdserver, server, assert
+}
+
+// TestHasThirdPartyResourcePath verifies that a third party resource path is
+// reported as installed from its installation until its removal.
+func TestHasThirdPartyResourcePath(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	assert.True(master.HasThirdPartyResourcePath(makeThirdPartyPath("company.com")))
+	assert.False(master.HasThirdPartyResourcePath(makeThirdPartyPath("other.com")))
+	assert.False(master.HasThirdPartyResourcePath("company.com"))
+
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	assert.False(master.HasThirdPartyResourcePath(makeThirdPartyPath("company.com")))
+}
+
+// TestInstallThirdPartyResourceConcurrently verifies that only one of concurrent
+// installations of a third party resource succeeds.
+func TestInstallThirdPartyResourceConcurrently(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+	defer etcdserver.Terminate(t)
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "bar.other.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	errs := make(chan error, 10)
+	for i := 0; i < cap(errs); i++ {
+		go func() { errs <- master.InstallThirdPartyResource(rsrc) }()
+	}
+	installed := 0
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err == nil {
+			installed++
+		}
+	}
+	assert.Equal(1, installed)
+	assert.True(master.HasThirdPartyResourcePath(makeThirdPartyPath("other.com")))
 }
 
 func TestInstallThirdPartyAPIList(t *testing.T) {
@@ -660,37 +1878,362 @@ func testInstallThirdPartyAPIGetVersion(t *testing.T, version string) {
 	}
 }
 
-func TestInstallThirdPartyAPIPost(t *testing.T) {
-	for _, version := range versionsToTest {
-		testInstallThirdPartyAPIPostForVersion(t, version)
-	}
-}
-
-func testInstallThirdPartyAPIPostForVersion(t *testing.T, version string) {
-	master, etcdserver, server, assert := initThirdParty(t, version)
-	defer server.Close()
+// TestInstallThirdPartyAPIGetSelfLinkPrefix verifies that the self links of third
+// party objects are rebased onto the master's self link prefix.
+func TestInstallThirdPartyAPIGetSelfLinkPrefix(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
 	defer etcdserver.Terminate(t)
 
-	inputObj := Foo{
-		ObjectMeta: api.ObjectMeta{
-			Name: "test",
-		},
-		TypeMeta: unversioned.TypeMeta{
-			Kind:       "Foo",
-			APIVersion: "company.com/" + version,
-		},
-		SomeField:  "test field",
-		OtherField: 10,
+	master.selfLinkPrefix = "https://gateway.example.com/k8s"
+	master.requestContextMapper = api.NewRequestContextMapper()
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
 	}
-	data, err := json.Marshal(inputObj)
-	if !assert.NoError(err) {
-		return
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
 	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
 
-	resp, err := http.Post(server.URL+"/apis/company.com/"+version+"/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBufferString(`{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"test"}}`))
 	if !assert.NoError(err) {
-		t.Errorf("unexpected error: %v", err)
-		return
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/test")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	item := Foo{}
+	assert.NoError(decodeResponse(resp, &item))
+	assert.Equal("https://gateway.example.com/k8s/apis/company.com/v1/namespaces/default/foos/test", item.SelfLink)
+	assert.Equal(master.selfLinkPrefix, master.api_v1().SelfLinkPrefix)
+}
+
+// TestInstallThirdPartyAPIGetExport verifies that an exported third party object
+// has no cluster-specific fields, its status included, and can be created as is.
+func TestInstallThirdPartyAPIGetExport(t *testing.T) {
+	_, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	data := `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"test","labels":{"app":"test"}},"someField":"test field","status":{"ready":true}}`
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBufferString(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/test?export=true")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	exported, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	object := map[string]interface{}{}
+	if !assert.NoError(json.Unmarshal(exported, &object)) {
+		t.FailNow()
+	}
+	_, found := object["status"]
+	assert.False(found, "unexpected status in %s", exported)
+	assert.Equal("test field", object["someField"])
+	metadata, _ := object["metadata"].(map[string]interface{})
+	for _, field := range []string{"namespace", "uid", "resourceVersion", "selfLink", "creationTimestamp"} {
+		assert.Nil(metadata[field], "unexpected %s in %s", field, exported)
+	}
+	assert.Equal("test", metadata["name"])
+	assert.Equal(map[string]interface{}{"app": "test"}, metadata["labels"])
+
+	resp, err = http.Post(server.URL+"/apis/company.com/v1/namespaces/other/foos", "application/json", bytes.NewBuffer(exported))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+}
+
+func TestInstallThirdPartyAPIPost(t *testing.T) {
+	for _, version := range versionsToTest {
+		testInstallThirdPartyAPIPostForVersion(t, version)
+	}
+}
+
+// TestInstallThirdPartyAPIPostTooLarge verifies that third party resource
+// writes larger than the request body limit are rejected.
+func TestInstallThirdPartyAPIPostTooLarge(t *testing.T) {
+	master, etcdserver, _, assert := initThirdParty(t, "v1")
+	defer etcdserver.Terminate(t)
+	server := httptest.NewServer(apiserver.MaxRequestBodyBytes(64, nil, master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	inputObj := Foo{
+		ObjectMeta: api.ObjectMeta{
+			Name: "test",
+		},
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Foo",
+			APIVersion: "company.com/v1",
+		},
+		SomeField: strings.Repeat("a", 64),
+	}
+	data, err := json.Marshal(inputObj)
+	if !assert.NoError(err) {
+		return
+	}
+
+	// Send the body without a Content-Length so that the limit is enforced while
+	// the handler reads it.
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", ioutil.NopCloser(bytes.NewBuffer(data)))
+	if !assert.NoError(err) {
+		return
+	}
+	defer resp.Body.Close()
+	assert.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	status := unversioned.Status{}
+	assert.NoError(decodeResponse(resp, &status))
+	assert.Equal(unversioned.StatusReasonRequestEntityTooLarge, status.Reason)
+}
+
+// TestInstallThirdPartyAPIPostTooLargeObject verifies that third party objects
+// larger than the master's limit are refused before they are stored.
+func TestInstallThirdPartyAPIPostTooLargeObject(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	master.requestContextMapper = api.NewRequestContextMapper()
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	master.maxThirdPartyObjectBytes = 128
+	if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{Name: "v1"}},
+	})) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	post := func(someField string) *http.Response {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: "test"},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+			SomeField:  someField,
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		return resp
+	}
+
+	resp := post(strings.Repeat("a", 128))
+	assert.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+	status := unversioned.Status{}
+	assert.NoError(decodeResponse(resp, &status))
+	assert.Equal(unversioned.StatusReasonRequestEntityTooLarge, status.Reason)
+	assert.Contains(status.Message, "128 bytes allowed")
+
+	resp = post("a")
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+}
+
+// denyAdmission is an admission controller that denies every request it
+// handles, and records the attributes of the last one.
+type denyAdmission struct {
+	*admission.Handler
+	attributes admission.Attributes
+}
+
+func (d *denyAdmission) Admit(a admission.Attributes) error {
+	d.attributes = a
+	return fmt.Errorf("%s are not allowed in %s", a.GetResource().Resource, a.GetNamespace())
+}
+
+// TestInstallThirdPartyAPIAdmission verifies that third party resource writes
+// go through admission control, and denied writes are forbidden.
+func TestInstallThirdPartyAPIAdmission(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	admit := &denyAdmission{Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete)}
+	master.admissionControl = admit
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusForbidden, resp.StatusCode)
+
+	status := unversioned.Status{}
+	assert.NoError(decodeResponse(resp, &status))
+	assert.True(strings.Contains(status.Message, "foos are not allowed in default"), "unexpected message: %s", status.Message)
+
+	if assert.NotNil(admit.attributes) {
+		assert.Equal(admission.Create, admit.attributes.GetOperation())
+		assert.Equal(unversioned.GroupResource{Group: "company.com", Resource: "foos"}, admit.attributes.GetResource())
+		assert.Equal("default", admit.attributes.GetNamespace())
+	}
+}
+
+// userRecordingStorage is a storage.Interface that records the user in the context
+// of the last object created through it.
+type userRecordingStorage struct {
+	storage.Interface
+	user user.Info
+}
+
+func (s *userRecordingStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	s.user, _ = api.UserFrom(ctx)
+	return s.Interface.Create(ctx, key, obj, out, ttl)
+}
+
+// TestInstallThirdPartyAPIUser verifies that the user authenticated by the master's
+// authenticator reaches the storage of a third party resource.
+func TestInstallThirdPartyAPIUser(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	recorder := &userRecordingStorage{Interface: etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())}
+	master.thirdPartyStorage = recorder
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.requestContextMapper = api.NewRequestContextMapper()
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+
+	auth := authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+		return &user.DefaultInfo{Name: "alice", Groups: []string{"developers"}}, true, nil
+	})
+	handler, err := handlers.NewRequestAuthenticator(master.requestContextMapper, auth, handlers.Unauthorized(false), master.handlerContainer.ServeMux)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	if handler, err = api.NewRequestContextFilter(master.requestContextMapper, handler); !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	if assert.NotNil(recorder.user) {
+		assert.Equal("alice", recorder.user.GetName())
+		assert.Equal([]string{"developers"}, recorder.user.GetGroups())
+	}
+}
+
+// TestInstallThirdPartyAPIDryRun verifies that a dry run create of a third party
+// resource returns the object without storing it.
+func TestInstallThirdPartyAPIDryRun(t *testing.T) {
+	_, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		SomeField:  "test field",
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos?dryRun=All", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	item := Foo{}
+	assert.NoError(decodeResponse(resp, &item))
+	assert.Equal("test field", item.SomeField)
+	assert.Equal("", item.ResourceVersion)
+
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/test")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func testInstallThirdPartyAPIPostForVersion(t *testing.T, version string) {
+	master, etcdserver, server, assert := initThirdParty(t, version)
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	inputObj := Foo{
+		ObjectMeta: api.ObjectMeta{
+			Name: "test",
+		},
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Foo",
+			APIVersion: "company.com/" + version,
+		},
+		SomeField:  "test field",
+		OtherField: 10,
+	}
+	data, err := json.Marshal(inputObj)
+	if !assert.NoError(err) {
+		return
+	}
+
+	resp, err := http.Post(server.URL+"/apis/company.com/"+version+"/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.Errorf("unexpected error: %v", err)
+		return
 	}
 
 	assert.Equal(http.StatusCreated, resp.StatusCode)
@@ -725,6 +2268,102 @@ func testInstallThirdPartyAPIPostForVersion(t *testing.T, version string) {
 	}
 }
 
+// TestInstallThirdPartyAPIPostGenerateName verifies that a third party object
+// created with a generateName is stored and returned under the generated name.
+func TestInstallThirdPartyAPIPostGenerateName(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	inputObj := Foo{
+		ObjectMeta: api.ObjectMeta{
+			GenerateName: "test-",
+		},
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Foo",
+			APIVersion: "company.com/v1",
+		},
+		SomeField: "test field",
+	}
+	data, err := json.Marshal(inputObj)
+	if !assert.NoError(err) {
+		return
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	item := Foo{}
+	assert.NoError(decodeResponse(resp, &item))
+	if !assert.True(strings.HasPrefix(item.Name, "test-") && len(item.Name) > len("test-"), "unexpected name %q", item.Name) {
+		return
+	}
+	thirdPartyObj := extensions.ThirdPartyResourceData{}
+	assert.NoError(master.thirdPartyStorage.Get(
+		context.TODO(), etcdtest.AddPrefix("/ThirdPartyResourceData/company.com/foos/default/"+item.Name),
+		&thirdPartyObj, false))
+}
+
+// TestInstallThirdPartyAPIApply verifies that applying the same third party object
+// twice, as kubectl apply does, leaves it and its last applied configuration
+// exactly as they were.
+func TestInstallThirdPartyAPIApply(t *testing.T) {
+	_, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	config := Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Foo",
+			APIVersion: "company.com/v1",
+		},
+		SomeField:  "<test> & field",
+		OtherField: 12345678901234567,
+	}
+	lastApplied, err := json.Marshal(config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	config.Annotations = map[string]string{"kubectl.kubernetes.io/last-applied-configuration": string(lastApplied)}
+	data, err := json.Marshal(config)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+	created := Foo{}
+	assert.NoError(decodeResponse(resp, &created))
+
+	req, err := http.NewRequest("PUT", server.URL+"/apis/company.com/v1/namespaces/default/foos/test", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	updated := Foo{}
+	assert.NoError(decodeResponse(resp, &updated))
+
+	for _, item := range []Foo{created, updated} {
+		assert.Equal(string(lastApplied), item.Annotations["kubectl.kubernetes.io/last-applied-configuration"])
+		assert.Equal(config.SomeField, item.SomeField)
+		assert.Equal(config.OtherField, item.OtherField)
+	}
+	updated.ResourceVersion = created.ResourceVersion
+	if !assert.True(reflect.DeepEqual(created, updated)) {
+		t.Errorf("expected:\n%v\nsaw:\n%v\n", created, updated)
+	}
+}
+
 func TestInstallThirdPartyAPIDelete(t *testing.T) {
 	for _, version := range versionsToTest {
 		testInstallThirdPartyAPIDeleteVersion(t, version)
@@ -802,6 +2441,97 @@ func httpDelete(url string) (*http.Response, error) {
 	return client.Do(req)
 }
 
+// TestIsThirdPartyStorageHealthy verifies that the third party storage is probed
+// once a third party resource is installed.
+func TestIsThirdPartyStorageHealthy(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+
+	assert.NoError(master.IsThirdPartyStorageHealthy(nil))
+
+	etcdserver.Terminate(t)
+	assert.Error(master.IsThirdPartyStorageHealthy(nil))
+
+	// Without third party resources the storage isn't probed.
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	assert.NoError(master.IsThirdPartyStorageHealthy(nil))
+}
+
+// blockingListStorage is a storage.Interface whose lists block until release is
+// closed, whatever their context.
+type blockingListStorage struct {
+	storage.Interface
+	release chan struct{}
+}
+
+func (s *blockingListStorage) List(ctx context.Context, key string, resourceVersion string, filter storage.FilterFunc, listObj runtime.Object) error {
+	<-s.release
+	return nil
+}
+
+// TestIsThirdPartyStorageHealthyTimeout verifies that the probe of a third party
+// storage that doesn't answer fails within the timeout, whether the storage gives
+// up when its context expires or not.
+func TestIsThirdPartyStorageHealthyTimeout(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+	defer etcdserver.Terminate(t)
+	defer func(timeout time.Duration) { thirdPartyStorageHealthTimeout = timeout }(thirdPartyStorageHealthTimeout)
+	thirdPartyStorageHealthTimeout = 50 * time.Millisecond
+
+	hanging := etcdtesting.NewHangingEtcdServer()
+	defer hanging.Close()
+	release := make(chan struct{})
+	defer close(release)
+	for name, s := range map[string]storage.Interface{
+		"etcd":     etcdstorage.NewEtcdStorage(hanging.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix()),
+		"blocking": &blockingListStorage{release: release},
+	} {
+		master.thirdPartyStorage = s
+		done := make(chan error, 1)
+		go func() {
+			done <- master.IsThirdPartyStorageHealthy(nil)
+		}()
+		select {
+		case err := <-done:
+			assert.Error(err, name)
+		case <-time.After(util.ForeverTestTimeout):
+			t.Errorf("%s: the probe did not time out", name)
+		}
+	}
+}
+
+// TestInstallThirdPartyResourceCollision verifies that a third party resource is
+// not installed at a path already served by another API, and that the API
+// already served is left untouched.
+func TestInstallThirdPartyResourceCollision(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+
+	builtin := new(restful.WebService).Path("/apis/example.com/v1")
+	builtin.Route(builtin.GET("/").To(func(*restful.Request, *restful.Response) {}))
+	master.handlerContainer.Add(builtin)
+
+	for _, name := range []string{"bar.company.com", "foo.example.com"} {
+		err := master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+			ObjectMeta: api.ObjectMeta{Name: name},
+			Versions:   []extensions.APIVersion{{Name: "v1"}},
+		})
+		if assert.Error(err, name) {
+			assert.Contains(err.Error(), "collides", name)
+		}
+	}
+	assert.Equal([]string{"/apis/company.com"}, master.ListThirdPartyResources())
+
+	resp, err := http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+}
+
 func TestInstallThirdPartyResourceRemove(t *testing.T) {
 	for _, version := range versionsToTest {
 		testInstallThirdPartyResourceRemove(t, version)
@@ -890,3 +2620,104 @@ func testInstallThirdPartyResourceRemove(t *testing.T, version string) {
 		}
 	}
 }
+
+// TestUpdateThirdPartyResource verifies that updating the version of a third party
+// resource replaces its handlers and discovery without losing its objects, that
+// updating its columns leaves its handlers alone, and that a resource of another
+// kind is not replaced.
+func TestUpdateThirdPartyResource(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	defer server.Close()
+	defer etcdserver.Terminate(t)
+	path := makeThirdPartyPath("company.com")
+	get := func(url string) int {
+		resp, err := http.Get(server.URL + url)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	obj := Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo"},
+	}
+	if !assert.NoError(storeThirdPartyObject(master.thirdPartyStorage, "/ThirdPartyResourceData/company.com/foos/default/test", "test", obj)) {
+		t.FailNow()
+	}
+
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v2"}},
+	}
+	assert.NoError(master.UpdateThirdPartyResource(rsrc))
+	assert.Equal(http.StatusOK, get("/apis/company.com/v2/namespaces/default/foos/test"))
+	assert.Equal(http.StatusNotFound, get("/apis/company.com/v1/namespaces/default/foos/test"))
+	resp, err := http.Get(server.URL + path)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	group := unversioned.APIGroup{}
+	assert.NoError(decodeResponse(resp, &group))
+	if assert.Len(group.Versions, 1) {
+		assert.Equal("company.com/v2", group.Versions[0].GroupVersion)
+	}
+
+	handlers := len(master.handlerContainer.RegisteredWebServices())
+	rsrc.AdditionalPrinterColumns = []extensions.ThirdPartyResourceColumn{{Name: "Some Field", JSONPath: ".someField"}}
+	assert.NoError(master.UpdateThirdPartyResource(rsrc))
+	assert.Equal(handlers, len(master.handlerContainer.RegisteredWebServices()))
+	if storage, found := master.thirdPartyResourceStorage(path); assert.True(found) {
+		assert.Equal(rsrc.AdditionalPrinterColumns, storage.Columns)
+	}
+	assert.Equal(http.StatusOK, get("/apis/company.com/v2/namespaces/default/foos/test"))
+
+	other := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "bar.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v3"}},
+	}
+	assert.Error(master.UpdateThirdPartyResource(other))
+	assert.Equal(http.StatusOK, get("/apis/company.com/v2/namespaces/default/foos/test"))
+
+	// A resource that is not installed yet is installed.
+	other.Name = "bar.other.com"
+	assert.NoError(master.UpdateThirdPartyResource(other))
+	assert.True(master.HasThirdPartyResourcePath(makeThirdPartyPath("other.com")))
+}
+
+// TestPreinstalledThirdPartyResources verifies that init installs the preinstalled
+// third party resources, and that they can be removed.
+func TestPreinstalledThirdPartyResources(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.preinstalledThirdPartyResources = []extensions.ThirdPartyResource{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+			Versions:   []extensions.APIVersion{{Name: "v1"}},
+		},
+	}
+
+	master.init(&config)
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal([]string{"/apis/company.com"}, master.ListThirdPartyResources())
+
+	if !assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com"))) {
+		t.FailNow()
+	}
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
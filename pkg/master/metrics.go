@@ -0,0 +1,80 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsRegistry is the registry the metrics owned by a master are registered
+// against, and served from at /metrics. The masters that share a registry share
+// its metrics: their requests are counted together, and the gauges of a master,
+// e.g. of its watch caches or SSH tunnels, are the ones of the last master created
+// against the registry.
+type MetricsRegistry interface {
+	// RegisterOrGet registers collector, or returns the collector with the same
+	// descriptions that is already registered.
+	RegisterOrGet(collector prometheus.Collector) (prometheus.Collector, error)
+	// ServeHTTP serves the registered metrics.
+	http.Handler
+}
+
+// DefaultMetricsRegistry is the global prometheus registry.
+var DefaultMetricsRegistry MetricsRegistry = &globalMetricsRegistry{prometheus.Handler()}
+
+type globalMetricsRegistry struct {
+	http.Handler
+}
+
+func (*globalMetricsRegistry) RegisterOrGet(collector prometheus.Collector) (prometheus.Collector, error) {
+	return prometheus.RegisterOrGet(collector)
+}
+
+var tunnelSyncLatencyDesc = prometheus.NewDesc(
+	"apiserver_proxy_tunnel_sync_latency_secs",
+	"The time since the last successful synchronization of the SSH tunnels for proxy requests.",
+	nil, nil,
+)
+
+// tunnelSyncCollector collects the time since the SSH tunnels of a master were
+// last synchronized.
+type tunnelSyncCollector struct {
+	lock     sync.Mutex
+	tunneler Tunneler
+}
+
+// set collects the metric of tunneler in place of the one of the previous tunneler.
+func (t *tunnelSyncCollector) set(tunneler Tunneler) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.tunneler = tunneler
+}
+
+func (t *tunnelSyncCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- tunnelSyncLatencyDesc
+}
+
+func (t *tunnelSyncCollector) Collect(ch chan<- prometheus.Metric) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.tunneler != nil {
+		ch <- prometheus.MustNewConstMetric(tunnelSyncLatencyDesc, prometheus.GaugeValue, float64(t.tunneler.SecondsSinceSync()))
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMetricsRegistry is a MetricsRegistry that records the collectors registered
+// against it, and serves their number. Like the prometheus registries, it returns
+// the collector already registered with the same descriptions.
+type testMetricsRegistry struct {
+	lock       sync.Mutex
+	collectors []prometheus.Collector
+}
+
+func (r *testMetricsRegistry) RegisterOrGet(collector prometheus.Collector) (prometheus.Collector, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, registered := range r.collectors {
+		if describe(registered) == describe(collector) {
+			return registered, nil
+		}
+	}
+	r.collectors = append(r.collectors, collector)
+	return collector, nil
+}
+
+// describe returns the descriptions of the metrics of collector.
+func describe(collector prometheus.Collector) string {
+	ch := make(chan *prometheus.Desc, 10)
+	collector.Describe(ch)
+	close(ch)
+	descs := []string{}
+	for desc := range ch {
+		descs = append(descs, desc.String())
+	}
+	return strings.Join(descs, ",")
+}
+
+func (r *testMetricsRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	fmt.Fprintf(w, "%d collectors", len(r.collectors))
+}
+
+// TestMetricsRegistry verifies that the metrics of each master are registered
+// against its own registry, which is served at /metrics if enabled.
+func TestMetricsRegistry(t *testing.T) {
+	for _, enableMetrics := range []bool{true, false} {
+		master, etcdserver, config, assert := setUpForInit(t)
+		registry := &testMetricsRegistry{}
+		master.metricsRegistry = registry
+		config.EnableMetrics = enableMetrics
+
+		master.init(&config)
+		server := httptest.NewServer(master.muxHelper.Mux.(*http.ServeMux))
+
+		assert.Len(registry.collectors, 4)
+		assert.True(registry.collectors[0] == master.watchCaches)
+		assert.True(registry.collectors[1] == master.requestMetrics)
+		if assert.NotNil(master.thirdPartyMetrics) {
+			assert.True(registry.collectors[2] == master.thirdPartyMetrics.requests)
+			assert.True(registry.collectors[3] == master.thirdPartyMetrics.latencies)
+		}
+
+		resp, err := http.Get(server.URL + "/metrics")
+		if assert.NoError(err) {
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if enableMetrics {
+				assert.Equal(http.StatusOK, resp.StatusCode)
+				assert.Equal("4 collectors", string(body))
+			} else {
+				assert.Equal(http.StatusNotFound, resp.StatusCode)
+			}
+		}
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
+
+// fakeTunneler is a Tunneler whose tunnels were last synced secondsSinceSync ago.
+type fakeTunneler struct {
+	secondsSinceSync int64
+}
+
+func (t *fakeTunneler) Run(AddressFunc) {}
+func (t *fakeTunneler) Stop()           {}
+func (t *fakeTunneler) Dial(network, addr string) (net.Conn, error) {
+	return nil, fmt.Errorf("no tunnels")
+}
+func (t *fakeTunneler) SecondsSinceSync() int64            { return t.secondsSinceSync }
+func (t *fakeTunneler) Healthy(maxLag time.Duration) error { return nil }
+
+// TestMetricsRegistrySharedByMasters verifies that the masters created against
+// the same registry share its metrics, and that its gauges are the ones of the
+// last master.
+func TestMetricsRegistrySharedByMasters(t *testing.T) {
+	registry := &testMetricsRegistry{}
+	masters := []*Master{}
+	for _, secondsSinceSync := range []int64{10, 20} {
+		master, etcdserver, config, _ := setUpForInit(t)
+		defer etcdserver.Terminate(t)
+		master.metricsRegistry = registry
+		master.tunneler = &fakeTunneler{secondsSinceSync: secondsSinceSync}
+
+		master.init(&config)
+		masters = append(masters, master)
+	}
+	assert := assert.New(t)
+	assert.True(masters[0].requestMetrics == masters[1].requestMetrics)
+	assert.True(masters[0].watchCaches == masters[1].watchCaches)
+	assert.True(masters[0].thirdPartyMetrics.requests == masters[1].thirdPartyMetrics.requests)
+
+	var tunnelSync *tunnelSyncCollector
+	for _, collector := range registry.collectors {
+		if collector, ok := collector.(*tunnelSyncCollector); ok {
+			tunnelSync = collector
+		}
+	}
+	if !assert.NotNil(tunnelSync) {
+		t.FailNow()
+	}
+	ch := make(chan prometheus.Metric, 1)
+	tunnelSync.Collect(ch)
+	metric := &dto.Metric{}
+	if assert.NoError((<-ch).Write(metric)) {
+		assert.Equal(float64(20), metric.GetGauge().GetValue())
+	}
+}
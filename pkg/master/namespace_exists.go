@@ -0,0 +1,88 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/latest"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// withNamespaceExists wraps handler so that the creations and updates of
+// namespaced objects, third party ones included, fail unless their namespace
+// exists, and the creations also fail if it is terminating, like with the
+// NamespaceLifecycle admission plugin. Deletions are let through, so that the
+// objects of a deleted namespace can be cleaned up.
+func (m *Master) withNamespaceExists(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || len(info.Namespace) == 0 || info.Resource == "namespaces" {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		switch info.Verb {
+		case "create", "update", "patch":
+		default:
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if err := m.checkNamespace(info.Namespace, info.Verb == "create"); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// checkNamespace returns an API error if namespace doesn't exist, or if it is
+// terminating and create is true.
+func (m *Master) checkNamespace(namespace string, create bool) error {
+	ns, err := m.namespaceRegistry.GetNamespace(api.NewContext(), namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return err
+		}
+		return apierrors.NewInternalError(err)
+	}
+	if create && ns.Status.Phase == api.NamespaceTerminating {
+		return apierrors.NewForbidden("namespaces", namespace, fmt.Errorf("unable to create new content in namespace %s because it is being terminated", namespace))
+	}
+	return nil
+}
+
+// writeStatusError writes err to w as a Status of the legacy API group.
+func writeStatusError(w http.ResponseWriter, err error) {
+	apiStatus, ok := err.(apierrors.APIStatus)
+	if !ok {
+		apiStatus = apierrors.NewInternalError(err).(apierrors.APIStatus)
+	}
+	status := apiStatus.Status()
+	apiserver.SetStatusRequestID(&status, w)
+	data, err := runtime.Encode(latest.GroupOrDie(api.GroupName).Codec, &status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(status.Code))
+	w.Write(data)
+}
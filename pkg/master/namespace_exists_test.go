@@ -0,0 +1,83 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+)
+
+// TestRequireNamespaceExists verifies that a master configured with
+// RequireNamespaceExists refuses the writes of core and third party objects to
+// namespaces that don't exist, and their creation in terminating namespaces.
+func TestRequireNamespaceExists(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.apiPrefix = "/api"
+	master.requireNamespaceExists = true
+	master.init(&config)
+
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.thirdPartyStorage = config.StorageDestinations.Get(extensions.GroupName, "thirdpartyresourcedata")
+	err := master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	do := func(method, path, body string) int {
+		req, err := http.NewRequest(method, server.URL+path, bytes.NewBufferString(body))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	secret := func(name string) string {
+		return `{"kind":"Secret","apiVersion":"v1","metadata":{"name":"` + name + `"}}`
+	}
+	foo := `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"test"}}`
+
+	assert.Equal(http.StatusNotFound, do("POST", "/api/v1/namespaces/ns/secrets", secret("a")))
+	assert.Equal(http.StatusNotFound, do("POST", "/apis/company.com/v1/namespaces/ns/foos", foo))
+
+	assert.Equal(http.StatusCreated, do("POST", "/api/v1/namespaces", `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"ns"}}`))
+	assert.Equal(http.StatusCreated, do("POST", "/api/v1/namespaces/ns/secrets", secret("a")))
+	assert.Equal(http.StatusCreated, do("POST", "/apis/company.com/v1/namespaces/ns/foos", foo))
+
+	// The namespace has a finalizer, so it is terminating once deleted.
+	assert.Equal(http.StatusOK, do("DELETE", "/api/v1/namespaces/ns", ""))
+	assert.Equal(http.StatusForbidden, do("POST", "/api/v1/namespaces/ns/secrets", secret("b")))
+	assert.Equal(http.StatusOK, do("PUT", "/api/v1/namespaces/ns/secrets/a", secret("a")))
+	assert.Equal(http.StatusOK, do("DELETE", "/api/v1/namespaces/ns/secrets/a", ""))
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// namespaceObjectLimitsPluginName is the name of the admission plugin that
+// enforces Config.NamespaceObjectLimits.
+const namespaceObjectLimitsPluginName = "NamespaceObjectLimits"
+
+// namespaceObjectCountTTL is how long the object count of a namespace is trusted
+// before the objects are listed again.
+const namespaceObjectCountTTL = 10 * time.Second
+
+// namespaceObjectRelistInterval is how long a count at the limit is trusted to
+// refuse creations before the objects are listed again.
+const namespaceObjectRelistInterval = time.Second
+
+// namespaceObjectLimits refuses to create the objects of a resource in namespaces
+// that already have as many as their limit allows. The objects are counted by
+// listing them, and the count is then kept for namespaceObjectCountTTL and bumped
+// by the creations it admits, so that most creations don't list. A count at the
+// limit is listed again before a creation is refused, since the deletions aren't
+// seen, unless it was listed within namespaceObjectRelistInterval, so that a
+// burst of creations in a full namespace lists it once per interval rather than
+// once per creation. The counts are kept by each master, so the masters of a cluster
+// can together admit a few objects over the limit.
+//
+// A creation is counted when it is admitted, before it is stored, so the creations
+// that fail afterwards, e.g. refused by a later admission plugin or by validation,
+// are counted too until the count is listed again. This over-count only makes the
+// count reach the limit early, and the objects be listed again sooner: it never
+// refuses a creation on its own. The counts unused for namespaceObjectCountTTL are
+// evicted, so that deleted namespaces don't keep theirs.
+type namespaceObjectLimits struct {
+	*admission.Handler
+	// map from the resources, as written in the configuration, to their limit
+	limits map[string]int
+	// returns the storage the objects of a resource are listed from
+	lister func(unversioned.GroupResource) (rest.Lister, bool)
+	clock  util.Clock
+
+	lock   sync.Mutex
+	counts map[namespaceObjectCountKey]*namespaceObjectCount
+	// when the expired counts were last evicted
+	evicted time.Time
+}
+
+type namespaceObjectCountKey struct {
+	resource  unversioned.GroupResource
+	namespace string
+}
+
+// namespaceObjectCount is the number of objects of a resource in a namespace, as
+// listed at listed plus the creations admitted since.
+type namespaceObjectCount struct {
+	sync.Mutex
+	count  int
+	listed time.Time
+	// the number of admissions using the count, guarded by the lock of
+	// namespaceObjectLimits rather than by the count's own
+	users int
+}
+
+func newNamespaceObjectLimits(limits map[string]int, lister func(unversioned.GroupResource) (rest.Lister, bool)) *namespaceObjectLimits {
+	return &namespaceObjectLimits{
+		Handler: admission.NewHandler(admission.Create),
+		limits:  limits,
+		lister:  lister,
+		clock:   util.RealClock{},
+		counts:  map[namespaceObjectCountKey]*namespaceObjectCount{},
+	}
+}
+
+func (l *namespaceObjectLimits) Admit(a admission.Attributes) error {
+	if len(a.GetNamespace()) == 0 || len(a.GetSubresource()) > 0 {
+		return nil
+	}
+	resource := a.GetResource()
+	limit, found := l.limits[groupResourceKey(resource.Group, resource.Resource)]
+	if !found {
+		return nil
+	}
+	count := l.count(namespaceObjectCountKey{resource, a.GetNamespace()})
+	defer l.release(count)
+	count.Lock()
+	defer count.Unlock()
+	age := l.clock.Since(count.listed)
+	if age >= namespaceObjectCountTTL || (count.count >= limit && age >= namespaceObjectRelistInterval) {
+		listed := l.clock.Now()
+		n, err := l.list(resource, a.GetNamespace())
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		count.count, count.listed = n, listed
+	}
+	if count.count >= limit {
+		return admission.NewForbidden(a, fmt.Errorf("namespace %s already has the limit of %d %s", a.GetNamespace(), limit, resource.Resource))
+	}
+	// A dry run creates nothing.
+	if !a.IsDryRun() {
+		count.count++
+	}
+	return nil
+}
+
+// count returns the count of the objects of key, which hasn't been listed yet if
+// it is new. The count must be released once it is not used anymore. The counts
+// that expired and aren't used are evicted at most once per namespaceObjectCountTTL.
+func (l *namespaceObjectLimits) count(key namespaceObjectCountKey) *namespaceObjectCount {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if now := l.clock.Now(); now.Sub(l.evicted) >= namespaceObjectCountTTL {
+		for k, count := range l.counts {
+			// The counts without users aren't locked, nor changed.
+			if count.users == 0 && now.Sub(count.listed) >= namespaceObjectCountTTL {
+				delete(l.counts, k)
+			}
+		}
+		l.evicted = now
+	}
+	count, found := l.counts[key]
+	if !found {
+		count = &namespaceObjectCount{}
+		l.counts[key] = count
+	}
+	count.users++
+	return count
+}
+
+// release releases a count returned by count.
+func (l *namespaceObjectLimits) release(count *namespaceObjectCount) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	count.users--
+}
+
+// list returns the number of objects of resource in namespace.
+func (l *namespaceObjectLimits) list(resource unversioned.GroupResource, namespace string) (int, error) {
+	lister, found := l.lister(resource)
+	if !found {
+		return 0, fmt.Errorf("the objects of %s can't be listed", resource)
+	}
+	list, err := lister.List(api.WithNamespace(api.NewContext(), namespace), nil)
+	if err != nil {
+		return 0, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
+// namespaceObjectLister returns the storage the objects of resource are listed
+// from, third party ones included, if it is served.
+func (m *Master) namespaceObjectLister(resource unversioned.GroupResource) (rest.Lister, bool) {
+	var storage rest.Storage
+	switch resource.Group {
+	case api.GroupName:
+		for name, s := range m.storage {
+			if strings.ToLower(name) == resource.Resource {
+				storage = s
+			}
+		}
+	case extensions.GroupName:
+		storage = m.extensionsStorage[resource.Resource]
+	default:
+		registry, found := m.thirdPartyResourceStorage(makeThirdPartyPath(resource.Group))
+		if !found {
+			return nil, false
+		}
+		storage = registry
+	}
+	lister, ok := storage.(rest.Lister)
+	return lister, ok
+}
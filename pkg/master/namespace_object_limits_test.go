@@ -0,0 +1,219 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/emicklei/go-restful"
+)
+
+// podCountLister lists a number of pods in each namespace, and counts the lists.
+type podCountLister struct {
+	pods  map[string]int
+	lists int
+}
+
+func (l *podCountLister) NewList() runtime.Object {
+	return &api.PodList{}
+}
+
+func (l *podCountLister) List(ctx api.Context, options *unversioned.ListOptions) (runtime.Object, error) {
+	l.lists++
+	namespace, _ := api.NamespaceFrom(ctx)
+	return &api.PodList{Items: make([]api.Pod, l.pods[namespace])}, nil
+}
+
+// TestNamespaceObjectLimits verifies that the creations are refused once their
+// namespace has the limit of objects of their resource, and that the objects are
+// only listed again when their count expires, or reaches the limit and wasn't
+// listed within the relist interval.
+func TestNamespaceObjectLimits(t *testing.T) {
+	lister := &podCountLister{pods: map[string]int{"default": 1}}
+	limits := newNamespaceObjectLimits(map[string]int{"pods": 3}, func(resource unversioned.GroupResource) (rest.Lister, bool) {
+		return lister, resource == api.Resource("pods")
+	})
+	clock := &util.FakeClock{Time: time.Now()}
+	limits.clock = clock
+
+	create := func(namespace string, resource unversioned.GroupResource, subresource string) error {
+		return limits.Admit(admission.NewAttributesRecord(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}, api.Kind("Pod"), namespace, "foo", resource, subresource, admission.Create, nil))
+	}
+	expect := func(expectForbidden bool, lists int, err error) {
+		if apierrors.IsForbidden(err) != expectForbidden || (err != nil && !expectForbidden) {
+			t.Errorf("expected forbidden %v, got %v", expectForbidden, err)
+		}
+		if lister.lists != lists {
+			t.Errorf("expected %d lists, got %d", lists, lister.lists)
+		}
+	}
+
+	// The first creation lists the pods, the next one uses the count.
+	expect(false, 1, create("default", api.Resource("pods"), ""))
+	expect(false, 1, create("default", api.Resource("pods"), ""))
+	// The count is at the limit, so the pods are listed before refusing.
+	lister.pods["default"] = 3
+	clock.Step(namespaceObjectRelistInterval)
+	expect(true, 2, create("default", api.Resource("pods"), ""))
+	// The count at the limit was just listed, so it refuses without listing.
+	lister.pods["default"] = 2
+	expect(true, 2, create("default", api.Resource("pods"), ""))
+	// A deletion is seen when the count at the limit is listed again.
+	clock.Step(namespaceObjectRelistInterval)
+	expect(false, 3, create("default", api.Resource("pods"), ""))
+	// The count expires, and the creations done elsewhere are seen.
+	lister.pods["default"] = 0
+	clock.Step(namespaceObjectCountTTL)
+	expect(false, 4, create("default", api.Resource("pods"), ""))
+	// Each namespace has its own count.
+	expect(false, 5, create("other", api.Resource("pods"), ""))
+	// The other resources, the subresources and the cluster-scoped objects have
+	// no limit.
+	expect(false, 5, create("default", api.Resource("services"), ""))
+	expect(false, 5, create("default", api.Resource("pods"), "binding"))
+	expect(false, 5, create("", api.Resource("pods"), ""))
+	// The dry runs are refused at the limit, but don't count.
+	lister.pods["dry"] = 2
+	dryRun := func() error {
+		return limits.Admit(admission.WithDryRun(admission.NewAttributesRecord(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}, api.Kind("Pod"), "dry", "foo", api.Resource("pods"), "", admission.Create, nil)))
+	}
+	expect(false, 6, dryRun())
+	expect(false, 6, dryRun())
+	lister.pods["dry"] = 3
+	expect(false, 6, create("dry", api.Resource("pods"), ""))
+	clock.Step(namespaceObjectRelistInterval)
+	expect(true, 7, dryRun())
+}
+
+// TestNamespaceObjectLimitsEviction verifies that the counts unused for longer
+// than their expiry are evicted, and that the others are kept.
+func TestNamespaceObjectLimitsEviction(t *testing.T) {
+	lister := &podCountLister{pods: map[string]int{}}
+	limits := newNamespaceObjectLimits(map[string]int{"pods": 3}, func(resource unversioned.GroupResource) (rest.Lister, bool) {
+		return lister, true
+	})
+	clock := &util.FakeClock{Time: time.Now()}
+	limits.clock = clock
+	create := func(namespace string) {
+		if err := limits.Admit(admission.NewAttributesRecord(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}, api.Kind("Pod"), namespace, "foo", api.Resource("pods"), "", admission.Create, nil)); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	namespaces := func() []string {
+		names := []string{}
+		for key, count := range limits.counts {
+			if count.users != 0 {
+				t.Errorf("the count of %s wasn't released", key.namespace)
+			}
+			names = append(names, key.namespace)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	create("a")
+	create("b")
+	if names := namespaces(); !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("unexpected counts: %v", names)
+	}
+	clock.Step(namespaceObjectCountTTL / 2)
+	create("b")
+	if names := namespaces(); !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("unexpected counts: %v", names)
+	}
+	// Both counts expired, and are evicted. The count of b is created again.
+	clock.Step(namespaceObjectCountTTL / 2)
+	create("b")
+	if names := namespaces(); !reflect.DeepEqual(names, []string{"b"}) {
+		t.Errorf("unexpected counts: %v", names)
+	}
+	// A count in use isn't evicted.
+	used := limits.count(namespaceObjectCountKey{api.Resource("pods"), "b"})
+	clock.Step(namespaceObjectCountTTL)
+	create("c")
+	limits.release(used)
+	if names := namespaces(); !reflect.DeepEqual(names, []string{"b", "c"}) {
+		t.Errorf("unexpected counts: %v", names)
+	}
+}
+
+// TestThirdPartyNamespaceObjectLimits verifies that the limits apply to the
+// objects of third party resources, in each namespace.
+func TestThirdPartyNamespaceObjectLimits(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.admissionControl = admission.AppendPlugin(nil, namespaceObjectLimitsPluginName, newNamespaceObjectLimits(map[string]int{"company.com/foos": 2}, master.namespaceObjectLister))
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	testCases := []struct {
+		namespace string
+		name      string
+		status    int
+	}{
+		{"default", "a", http.StatusCreated},
+		{"default", "b", http.StatusCreated},
+		{"default", "c", http.StatusForbidden},
+		{"other", "a", http.StatusCreated},
+	}
+	for _, testCase := range testCases {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: testCase.name},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/"+testCase.namespace+"/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(testCase.status, resp.StatusCode, "%s/%s", testCase.namespace, testCase.name)
+	}
+}
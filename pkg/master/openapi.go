@@ -0,0 +1,304 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/version"
+
+	"github.com/emicklei/go-restful"
+	"github.com/emicklei/go-restful/swagger"
+)
+
+const openAPIPath = "/swagger.json"
+
+// The types below describe the subset of the Swagger 2.0 specification
+// (http://swagger.io/specification/) that is generated by the master.
+
+type openAPISpec struct {
+	Swagger     string                     `json:"swagger"`
+	Info        openAPIInfo                `json:"info"`
+	Paths       map[string]openAPIPathItem `json:"paths"`
+	Definitions map[string]*openAPISchema  `json:"definitions,omitempty"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// openAPIPathItem maps a lower case HTTP method to the operation serving it.
+type openAPIPathItem map[string]*openAPIOperation
+
+type openAPIOperation struct {
+	Description string                      `json:"description,omitempty"`
+	OperationID string                      `json:"operationId,omitempty"`
+	Consumes    []string                    `json:"consumes,omitempty"`
+	Produces    []string                    `json:"produces,omitempty"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+	// The additional printer columns of the table output of the gets and lists of
+	// third party objects, which is produced as application/json;as=Table.
+	TableColumns []extensions.ThirdPartyResourceColumn `json:"x-kubernetes-table-columns,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Type        string         `json:"type,omitempty"`
+	Format      string         `json:"format,omitempty"`
+	Items       *openAPISchema `json:"items,omitempty"`
+	Schema      *openAPISchema `json:"schema,omitempty"`
+}
+
+type openAPIResponse struct {
+	Description string         `json:"description"`
+	Schema      *openAPISchema `json:"schema,omitempty"`
+}
+
+type openAPISchema struct {
+	Ref         string                    `json:"$ref,omitempty"`
+	Type        string                    `json:"type,omitempty"`
+	Format      string                    `json:"format,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Enum        []string                  `json:"enum,omitempty"`
+	Items       *openAPISchema            `json:"items,omitempty"`
+	Required    []string                  `json:"required,omitempty"`
+	Properties  map[string]*openAPISchema `json:"properties,omitempty"`
+}
+
+// openAPIPrimitiveTypes are the types that can be used without a reference to a definition.
+var openAPIPrimitiveTypes = map[string]bool{
+	"integer": true,
+	"number":  true,
+	"string":  true,
+	"boolean": true,
+	"array":   true,
+	"object":  true,
+	"file":    true,
+}
+
+// InstallOpenAPI installs the /swagger.json endpoint, which serves a Swagger 2.0
+// description of the web services registered in the master. The description is
+// generated on every request so that it includes third party resources, and the
+// table output of their objects.
+func (m *Master) InstallOpenAPI() {
+	m.handlerContainer.ServeMux.HandleFunc(openAPIPath, func(w http.ResponseWriter, req *http.Request) {
+		spec := buildOpenAPISpec(m.handlerContainer.RegisteredWebServices())
+		m.addThirdPartyTableOutput(spec)
+		data, err := json.Marshal(spec)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", restful.MIME_JSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	})
+}
+
+// buildOpenAPISpec converts the Swagger 1.2 declarations go-restful generates for
+// the given web services into a single Swagger 2.0 document.
+func buildOpenAPISpec(webServices []*restful.WebService) *openAPISpec {
+	spec := &openAPISpec{
+		Swagger:     "2.0",
+		Info:        openAPIInfo{Title: "Kubernetes", Version: version.Get().GitVersion},
+		Paths:       map[string]openAPIPathItem{},
+		Definitions: map[string]*openAPISchema{},
+	}
+	builder := swagger.NewSwaggerBuilder(swagger.Config{
+		WebServices: webServices,
+		// Skip the swagger 1.2 web service itself.
+		ApiPath: "/swaggerapi/",
+	})
+	declarations := builder.ProduceAllDeclarations()
+	// Iterate in a stable order so that duplicate operations resolve the same way.
+	roots := []string{}
+	for root := range declarations {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	for _, root := range roots {
+		declaration := declarations[root]
+		for _, api := range declaration.Apis {
+			item, ok := spec.Paths[api.Path]
+			if !ok {
+				item = openAPIPathItem{}
+				spec.Paths[api.Path] = item
+			}
+			for i := range api.Operations {
+				item[strings.ToLower(api.Operations[i].Method)] = convertOperation(&api.Operations[i])
+			}
+		}
+		declaration.Models.Do(func(name string, model swagger.Model) {
+			// go-restful may emit models for primitive types, which need no definition.
+			if !openAPIPrimitiveTypes[name] {
+				spec.Definitions[name] = convertModel(&model)
+			}
+		})
+	}
+	return spec
+}
+
+// addThirdPartyTableOutput adds the table output served by thirdPartyTables to
+// the gets and lists of third party objects in spec: its content type, and the
+// additional printer columns of the resource, in an extension of the operation.
+func (m *Master) addThirdPartyTableOutput(spec *openAPISpec) {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	for path, storage := range m.thirdPartyResources {
+		kind := m.thirdPartyKinds[path]
+		prefix := path + "/" + kind.Version + "/"
+		resource := strings.ToLower(kind.Kind) + "s"
+		for apiPath, item := range spec.Paths {
+			get, found := item["get"]
+			if !found || !strings.HasPrefix(apiPath, prefix) || !isResourcePath(strings.TrimPrefix(apiPath, prefix), resource) {
+				continue
+			}
+			get.Produces = append(get.Produces, tableContentType)
+			get.TableColumns = storage.Columns
+		}
+	}
+}
+
+// isResourcePath returns true if path, relative to the root of a group version,
+// is the path of the objects of resource or of one of them, but not of a watch.
+func isResourcePath(path, resource string) bool {
+	segments := strings.Split(path, "/")
+	if segments[0] == "watch" {
+		return false
+	}
+	if last := len(segments) - 1; last > 0 && segments[last] == "{name}" {
+		segments = segments[:last]
+	}
+	return segments[len(segments)-1] == resource
+}
+
+func convertOperation(op *swagger.Operation) *openAPIOperation {
+	description := op.Summary
+	if len(op.Notes) > 0 {
+		description = op.Notes
+	}
+	result := &openAPIOperation{
+		Description: description,
+		OperationID: op.Nickname,
+		Consumes:    op.Consumes,
+		Produces:    op.Produces,
+		Responses:   map[string]*openAPIResponse{},
+	}
+	for i := range op.Parameters {
+		result.Parameters = append(result.Parameters, convertParameter(&op.Parameters[i]))
+	}
+	for _, message := range op.ResponseMessages {
+		response := &openAPIResponse{Description: message.Message}
+		if len(message.ResponseModel) > 0 {
+			response.Schema = schemaForType(message.ResponseModel)
+		}
+		result.Responses[strconv.Itoa(message.Code)] = response
+	}
+	if len(result.Responses) == 0 {
+		response := &openAPIResponse{Description: "OK"}
+		if op.Type != nil && *op.Type != "void" {
+			response.Schema = convertDataType(&op.DataTypeFields)
+		}
+		result.Responses[strconv.Itoa(http.StatusOK)] = response
+	}
+	return result
+}
+
+func convertParameter(param *swagger.Parameter) *openAPIParameter {
+	result := &openAPIParameter{
+		Name:        param.Name,
+		In:          param.ParamType,
+		Description: param.Description,
+		Required:    param.Required,
+	}
+	switch param.ParamType {
+	case "body":
+		result.Schema = convertDataType(&param.DataTypeFields)
+	case "form":
+		result.In = "formData"
+		fallthrough
+	default:
+		schema := convertDataType(&param.DataTypeFields)
+		result.Type = schema.Type
+		result.Format = schema.Format
+		result.Items = schema.Items
+		if len(result.Type) == 0 {
+			result.Type = "string"
+		}
+	}
+	return result
+}
+
+func convertModel(model *swagger.Model) *openAPISchema {
+	result := &openAPISchema{
+		Type:        "object",
+		Description: model.Description,
+		Required:    model.Required,
+		Properties:  map[string]*openAPISchema{},
+	}
+	model.Properties.Do(func(name string, property swagger.ModelProperty) {
+		schema := convertDataType(&property.DataTypeFields)
+		schema.Description = property.Description
+		result.Properties[name] = schema
+	})
+	return result
+}
+
+func convertDataType(fields *swagger.DataTypeFields) *openAPISchema {
+	var result *openAPISchema
+	switch {
+	case fields.Ref != nil:
+		result = schemaForType(*fields.Ref)
+	case fields.Type != nil:
+		result = schemaForType(*fields.Type)
+	default:
+		result = &openAPISchema{}
+	}
+	if len(result.Ref) == 0 {
+		result.Format = fields.Format
+		result.Enum = fields.Enum
+	}
+	if fields.Items != nil {
+		switch {
+		case fields.Items.Ref != nil:
+			result.Items = schemaForType(*fields.Items.Ref)
+		case fields.Items.Type != nil:
+			result.Items = schemaForType(*fields.Items.Type)
+			result.Items.Format = fields.Items.Format
+		}
+	}
+	return result
+}
+
+// schemaForType returns a schema for a swagger 1.2 type, which is either a
+// primitive type or the name of a model.
+func schemaForType(name string) *openAPISchema {
+	if openAPIPrimitiveTypes[name] {
+		return &openAPISchema{Type: name}
+	}
+	return &openAPISchema{Ref: "#/definitions/" + name}
+}
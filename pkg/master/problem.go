@@ -0,0 +1,194 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// problemContentType is the content type of RFC 7807 problem details.
+const problemContentType = "application/problem+json"
+
+// problemDetails is the RFC 7807 rendering of an error Status. The reason and
+// details of the Status are kept as extension members.
+type problemDetails struct {
+	Type     string                     `json:"type"`
+	Title    string                     `json:"title"`
+	Status   int                        `json:"status"`
+	Detail   string                     `json:"detail,omitempty"`
+	Instance string                     `json:"instance,omitempty"`
+	Reason   unversioned.StatusReason   `json:"reason,omitempty"`
+	Details  *unversioned.StatusDetails `json:"details,omitempty"`
+}
+
+// newProblemDetails returns the problem details of the error status of the
+// response to req, sent with the HTTP status code.
+func newProblemDetails(status *unversioned.Status, code int, req *http.Request) *problemDetails {
+	if status.Code != 0 {
+		code = int(status.Code)
+	}
+	return &problemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(code),
+		Status:   code,
+		Detail:   status.Message,
+		Instance: req.URL.Path,
+		Reason:   status.Reason,
+		Details:  status.Details,
+	}
+}
+
+// withProblemDetails wraps handler, if ProblemDetailsFeature is on, so that the
+// clients that prefer application/problem+json to JSON get the error statuses of
+// every handler, third party ones included, as RFC 7807 problem details. The
+// media type is removed from the Accept header handler sees, so that the other
+// responses are negotiated as if the client hadn't asked for it.
+func (m *Master) withProblemDetails(handler http.Handler) http.Handler {
+	if !m.featureEnabled(ProblemDetailsFeature) {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		accept, ok := negotiateProblemDetails(req.Header.Get("Accept"))
+		if !ok {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if len(accept) == 0 {
+			req.Header.Del("Accept")
+		} else {
+			req.Header.Set("Accept", accept)
+		}
+		writer := &problemResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}, req: req}
+		defer writer.Close()
+		handler.ServeHTTP(writer, req)
+	})
+}
+
+// negotiateProblemDetails returns true if the Accept header accept prefers
+// problem details to JSON, along with the header without the problem details
+// media type.
+func negotiateProblemDetails(accept string) (string, bool) {
+	problemQuality, jsonQuality := 0.0, 0.0
+	others := []string{}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case problemContentType:
+			if quality > problemQuality {
+				problemQuality = quality
+			}
+			continue
+		case "application/json", "application/*", "*/*":
+			if quality > jsonQuality {
+				jsonQuality = quality
+			}
+		}
+		others = append(others, strings.TrimSpace(part))
+	}
+	if problemQuality <= 0 || problemQuality < jsonQuality {
+		return accept, false
+	}
+	return strings.Join(others, ", "), true
+}
+
+// problemResponseWriter holds back the JSON bodies of error responses, and
+// writes them as problem details when it is closed if they are statuses. The
+// other responses are written through.
+type problemResponseWriter struct {
+	delegatingResponseWriter
+	req *http.Request
+
+	// code is the status code of the error response held back, if any.
+	code        int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (w *problemResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code >= http.StatusBadRequest && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.code = code
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *problemResponseWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.code != 0 {
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Close writes the error response held back, as problem details if its body is
+// a status and as is otherwise.
+func (w *problemResponseWriter) Close() error {
+	if w.code == 0 {
+		return nil
+	}
+	code, body := w.code, w.body.Bytes()
+	w.code = 0
+	status := &unversioned.Status{}
+	if err := json.Unmarshal(body, status); err == nil && status.Kind == "Status" {
+		if data, err := json.Marshal(newProblemDetails(status, code, w.req)); err == nil {
+			w.Header().Set("Content-Type", problemContentType)
+			body = data
+		}
+	}
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(code)
+	_, err := w.ResponseWriter.Write(body)
+	return err
+}
+
+// Flush writes out what has been written so far, unless it is an error response
+// held back.
+func (w *problemResponseWriter) Flush() {
+	if w.code != 0 {
+		return
+	}
+	w.delegatingResponseWriter.Flush()
+}
+
+func (w *problemResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.wroteHeader = true
+	return w.delegatingResponseWriter.Hijack()
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+// TestNegotiateProblemDetails verifies which Accept headers ask for problem
+// details, and the headers passed on without them.
+func TestNegotiateProblemDetails(t *testing.T) {
+	testCases := []struct {
+		accept   string
+		expected string
+		ok       bool
+	}{
+		{"", "", false},
+		{"application/json", "application/json", false},
+		{"*/*", "*/*", false},
+		{"application/problem+json", "", true},
+		{"application/problem+json, application/json", "application/json", true},
+		{"application/json, application/problem+json;q=0.5", "application/json, application/problem+json;q=0.5", false},
+		{"application/json;q=0.5, application/problem+json", "application/json;q=0.5", true},
+		{"application/problem+json;q=0, */*", "application/problem+json;q=0, */*", false},
+	}
+	for _, testCase := range testCases {
+		accept, ok := negotiateProblemDetails(testCase.accept)
+		if accept != testCase.expected || ok != testCase.ok {
+			t.Errorf("%q: expected %q (%v), got %q (%v)", testCase.accept, testCase.expected, testCase.ok, accept, ok)
+		}
+	}
+}
+
+// TestProblemDetails verifies that the clients that ask for problem details get
+// them for a not found and a conflicting object, of the built-in and third party
+// groups alike, while the other clients get statuses.
+func TestProblemDetails(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.apiPrefix = "/api"
+	master.apiGroupPrefix = "/apis"
+
+	master.init(&config)
+	if !assert.NoError(master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{Name: "v1"}},
+	})) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	do := func(method, path, accept, body string) (*http.Response, []byte) {
+		req, err := http.NewRequest(method, server.URL+path, bytes.NewBufferString(body))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if len(accept) > 0 {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(err)
+		return resp, data
+	}
+
+	pod := `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"foo"},"spec":{"containers":[{"name":"foo","image":"foo"}]}}`
+	resp, _ := do("POST", "/api/v1/namespaces/default/pods", "application/problem+json", pod)
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+	assert.Equal("application/json", resp.Header.Get("Content-Type"))
+
+	testCases := []struct {
+		method string
+		path   string
+		body   string
+		code   int
+		reason unversioned.StatusReason
+	}{
+		{"GET", "/api/v1/namespaces/default/pods/missing", "", http.StatusNotFound, unversioned.StatusReasonNotFound},
+		{"POST", "/api/v1/namespaces/default/pods", pod, http.StatusConflict, unversioned.StatusReasonAlreadyExists},
+		{"GET", "/apis/company.com/v1/namespaces/default/foos/missing", "", http.StatusNotFound, unversioned.StatusReasonNotFound},
+	}
+	for _, testCase := range testCases {
+		resp, data := do(testCase.method, testCase.path, "", testCase.body)
+		assert.Equal(testCase.code, resp.StatusCode, testCase.path)
+		assert.Equal("application/json", resp.Header.Get("Content-Type"), testCase.path)
+		status := unversioned.Status{}
+		if assert.NoError(json.Unmarshal(data, &status), testCase.path) {
+			assert.Equal("Status", status.Kind, testCase.path)
+			assert.Equal(testCase.reason, status.Reason, testCase.path)
+		}
+
+		resp, data = do(testCase.method, testCase.path, "application/problem+json", testCase.body)
+		assert.Equal(testCase.code, resp.StatusCode, testCase.path)
+		assert.Equal(problemContentType, resp.Header.Get("Content-Type"), testCase.path)
+		problem := problemDetails{}
+		if assert.NoError(json.Unmarshal(data, &problem), testCase.path) {
+			assert.Equal("about:blank", problem.Type, testCase.path)
+			assert.Equal(http.StatusText(testCase.code), problem.Title, testCase.path)
+			assert.Equal(testCase.code, problem.Status, testCase.path)
+			assert.Equal(status.Message, problem.Detail, testCase.path)
+			assert.Equal(testCase.path, problem.Instance, testCase.path)
+			assert.Equal(testCase.reason, problem.Reason, testCase.path)
+		}
+	}
+}
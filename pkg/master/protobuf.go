@@ -0,0 +1,27 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// newProtobufCodec returns the codec that encodes the objects of a built-in group
+// version, e.g. extensions/v1beta1, as protobuf. It is nil unless the binary is
+// built with the proto tag, which needs the generated protobuf marshalling of the
+// API types.
+var newProtobufCodec func(groupVersion string) runtime.Codec
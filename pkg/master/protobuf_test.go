@@ -0,0 +1,125 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apiserver"
+	secretetcd "k8s.io/kubernetes/pkg/registry/secret/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/emicklei/go-restful"
+)
+
+// prefixCodec stands in for a protobuf codec by prefixing the JSON encoding of
+// objects with their group version.
+type prefixCodec struct {
+	runtime.Codec
+	groupVersion string
+}
+
+func (c prefixCodec) Encode(obj runtime.Object) ([]byte, error) {
+	data, err := c.Codec.Encode(obj)
+	return append([]byte("protobuf "+c.groupVersion+":"), data...), err
+}
+
+// TestProtobufNegotiation verifies that the clients of the core and extensions
+// groups get protobuf when they ask for it and it is built in, that they get
+// JSON otherwise, and that the default content type is sent to the clients
+// without a preference.
+func TestProtobufNegotiation(t *testing.T) {
+	defer func(codec func(string) runtime.Codec) { newProtobufCodec = codec }(newProtobufCodec)
+
+	testCases := []struct {
+		protobuf           bool
+		defaultContentType string
+		accept             string
+		status             int
+		contentType        string
+	}{
+		{false, "", "", http.StatusOK, apiserver.JSONContentType},
+		{false, "", "application/json", http.StatusOK, apiserver.JSONContentType},
+		{false, "", "application/vnd.kubernetes.protobuf, application/json", http.StatusOK, apiserver.JSONContentType},
+		{false, "", "application/vnd.kubernetes.protobuf", http.StatusOK, apiserver.JSONContentType},
+		{true, "", "", http.StatusOK, apiserver.JSONContentType},
+		{true, "", "*/*", http.StatusOK, apiserver.JSONContentType},
+		{true, "", "application/json", http.StatusOK, apiserver.JSONContentType},
+		{true, "", "application/vnd.kubernetes.protobuf", http.StatusOK, apiserver.ProtobufContentType},
+		{true, "", "application/vnd.kubernetes.protobuf, application/json", http.StatusOK, apiserver.ProtobufContentType},
+		{true, apiserver.ProtobufContentType, "", http.StatusOK, apiserver.ProtobufContentType},
+		{true, apiserver.ProtobufContentType, "application/json", http.StatusOK, apiserver.JSONContentType},
+		{true, "", "text/html", http.StatusOK, apiserver.JSONContentType},
+		{true, apiserver.ProtobufContentType, "text/html", http.StatusOK, apiserver.JSONContentType},
+	}
+	for i, testCase := range testCases {
+		newProtobufCodec = nil
+		if testCase.protobuf {
+			newProtobufCodec = func(groupVersion string) runtime.Codec {
+				return prefixCodec{runtime.CodecFor(api.Scheme, unversioned.ParseGroupVersionOrDie(groupVersion)), groupVersion}
+			}
+		}
+
+		master, etcdserver, config, assert := setUp(t)
+		master.apiPrefix = "/api"
+		master.apiGroupPrefix = "/apis"
+		master.defaultContentType = testCase.defaultContentType
+		master.storage = map[string]rest.Storage{"secrets": secretetcd.NewREST(config.StorageDestinations.Get("", "secrets"), config.storageDecorator("", "secrets", nil))}
+		master.handlerContainer = restful.NewContainer()
+		master.handlerContainer.Router(apiserver.JSONFallbackRouter(restful.CurlyRouter{}))
+		if !assert.NoError(master.api_v1().InstallREST(master.handlerContainer)) || !assert.NoError(master.experimental(&config).InstallREST(master.handlerContainer)) {
+			t.FailNow()
+		}
+		server := httptest.NewServer(master.handlerContainer.ServeMux)
+
+		for groupVersion, path := range map[string]string{
+			"v1":                 "/api/v1/namespaces/default/secrets",
+			"extensions/v1beta1": "/apis/extensions/v1beta1/namespaces/default/jobs",
+		} {
+			req, err := http.NewRequest("GET", server.URL+path, nil)
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			req.Header.Set("Accept", testCase.accept)
+			resp, err := http.DefaultClient.Do(req)
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			assert.Equal(testCase.status, resp.StatusCode, "%d %s: %s", i, path, body)
+			if len(testCase.contentType) > 0 {
+				assert.Equal(testCase.contentType, resp.Header.Get("Content-Type"), "%d %s", i, path)
+			}
+			if testCase.contentType == apiserver.ProtobufContentType {
+				assert.True(strings.HasPrefix(string(body), "protobuf "+groupVersion+":"), "%d %s: %s", i, path, body)
+			} else {
+				assert.False(strings.HasPrefix(string(body), "protobuf"), "%d %s: %s", i, path, body)
+			}
+		}
+		server.Close()
+		etcdserver.Terminate(t)
+	}
+}
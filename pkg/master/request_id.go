@@ -0,0 +1,175 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/golang/glog"
+	"github.com/pborman/uuid"
+	"golang.org/x/net/context"
+)
+
+// maxRequestIDLength is the length of the longest request ID taken from a client.
+const maxRequestIDLength = 128
+
+// withRequestID wraps handler so that every request has an ID, which is set in
+// the X-Request-ID header of its response before any handler runs. The ID is
+// taken from the X-Request-ID header of the request if it is valid, so that a
+// request can be followed across components, and generated otherwise, in which
+// case it replaces the header of the request, e.g. for the proxied requests.
+//
+// This is the outermost handler, so that the requests refused before they reach
+// the API handlers get an ID too. The error statuses written as JSON also carry it
+// in their details, see apiserver.SetStatusRequestID, but the plain text errors,
+// e.g. those of the inflight limit or the request body limit, only in the header.
+func withRequestID(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestID := req.Header.Get(apiserver.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.NewRandom().String()
+			req.Header.Set(apiserver.RequestIDHeader, requestID)
+		}
+		w.Header().Set(apiserver.RequestIDHeader, requestID)
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// withRequestIDContext wraps handler so that the ID withRequestID gave a request
+// is set in its context, for the storage logs and the traces.
+func (m *Master) withRequestIDContext(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestID := req.Header.Get(apiserver.RequestIDHeader)
+		if ctx, ok := m.requestContextMapper.Get(req); ok && len(requestID) > 0 {
+			m.requestContextMapper.Update(req, api.WithRequestID(ctx, requestID))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// isValidRequestID returns true if requestID is made of at most
+// maxRequestIDLength printable ASCII characters, and no spaces, so that it can be
+// logged and sent back as is.
+func isValidRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range requestID {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestIDFrom returns the ID of the request ctx belongs to, if it has one.
+func requestIDFrom(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	return api.RequestIDFrom(ctx)
+}
+
+// requestLoggedStorage is a storage.Interface that logs the calls made for a
+// request with the ID of the request. Calls that fail for another reason than
+// the object they address existing or not, or having changed, are logged as
+// warnings, the others at verbosity 4. Watches are not logged.
+type requestLoggedStorage struct {
+	storage.Interface
+	// resource is the resource stored, e.g. pods.
+	resource string
+}
+
+// newRequestLoggedStorage returns s, logging the calls made to it for requests.
+func newRequestLoggedStorage(s storage.Interface, resource string) storage.Interface {
+	return &requestLoggedStorage{Interface: s, resource: resource}
+}
+
+// log returns a function that logs the operation on key, with its error, if ctx
+// belongs to a request.
+func (s *requestLoggedStorage) log(ctx context.Context, operation, key string) func(err error) {
+	requestID, ok := requestIDFrom(ctx)
+	if !ok {
+		return func(error) {}
+	}
+	start := time.Now()
+	return func(err error) {
+		switch {
+		case err == nil:
+			glog.V(4).Infof("Request %s: storage %s of %s %q took %v", requestID, operation, s.resource, key, time.Since(start))
+		case storage.IsNotFound(err) || storage.IsNodeExist(err) || storage.IsTestFailed(err):
+			glog.V(4).Infof("Request %s: storage %s of %s %q took %v: %v", requestID, operation, s.resource, key, time.Since(start), err)
+		default:
+			glog.Warningf("Request %s: storage %s of %s %q failed after %v: %v", requestID, operation, s.resource, key, time.Since(start), err)
+		}
+	}
+}
+
+func (s *requestLoggedStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	log := s.log(ctx, "Create", key)
+	err := s.Interface.Create(ctx, key, obj, out, ttl)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) Set(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	log := s.log(ctx, "Set", key)
+	err := s.Interface.Set(ctx, key, obj, out, ttl)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) Delete(ctx context.Context, key string, out runtime.Object) error {
+	log := s.log(ctx, "Delete", key)
+	err := s.Interface.Delete(ctx, key, out)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	log := s.log(ctx, "Get", key)
+	err := s.Interface.Get(ctx, key, objPtr, ignoreNotFound)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) GetToList(ctx context.Context, key string, filter storage.FilterFunc, listObj runtime.Object) error {
+	log := s.log(ctx, "GetToList", key)
+	err := s.Interface.GetToList(ctx, key, filter, listObj)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) List(ctx context.Context, key string, resourceVersion string, filter storage.FilterFunc, listObj runtime.Object) error {
+	log := s.log(ctx, "List", key)
+	err := s.Interface.List(ctx, key, resourceVersion, filter, listObj)
+	log(err)
+	return err
+}
+
+func (s *requestLoggedStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
+	log := s.log(ctx, "GuaranteedUpdate", key)
+	err := s.Interface.GuaranteedUpdate(ctx, key, ptrToType, ignoreNotFound, tryUpdate)
+	log(err)
+	return err
+}
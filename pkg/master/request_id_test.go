@@ -0,0 +1,194 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"golang.org/x/net/context"
+)
+
+// requestIDStorage is a storage.Interface recording the request IDs of the
+// lists made through it.
+type requestIDStorage struct {
+	storage.Interface
+	lock       sync.Mutex
+	requestIDs []string
+}
+
+func (s *requestIDStorage) List(ctx context.Context, key string, resourceVersion string, filter storage.FilterFunc, listObj runtime.Object) error {
+	s.lock.Lock()
+	requestID, _ := requestIDFrom(ctx)
+	s.requestIDs = append(s.requestIDs, requestID)
+	s.lock.Unlock()
+	return s.Interface.List(ctx, key, resourceVersion, filter, listObj)
+}
+
+// initRequestIDMaster returns a master initialized to serve requests with IDs.
+func initRequestIDMaster(t *testing.T) (*Master, func()) {
+	master, etcdserver, config, _ := setUpForInit(t)
+	master.apiPrefix = "/api"
+	master.apiGroupPrefix = "/apis"
+	master.disallowedMethods = sets.NewString("TRACE")
+	master.maxRequestBodyBytes = 1024
+
+	master.init(&config)
+	return master, func() { etcdserver.Terminate(t) }
+}
+
+// TestRequestID verifies that every response, errors and refusals included,
+// carries the ID of its request, picked by the client if it is valid and generated
+// otherwise, and that the error statuses carry it in their details.
+func TestRequestID(t *testing.T) {
+	master, terminate := initRequestIDMaster(t)
+	defer terminate()
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	do := func(method, path, requestID, body string) (*http.Response, *unversioned.Status) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(requestID) > 0 {
+			req.Header.Set(apiserver.RequestIDHeader, requestID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		status := &unversioned.Status{}
+		if resp.Header.Get("Content-Type") != "application/json" || json.NewDecoder(resp.Body).Decode(status) != nil || status.Kind != "Status" {
+			status = nil
+		}
+		return resp, status
+	}
+
+	resp, _ := do("GET", "/api/v1/namespaces/default/pods", "client-1", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if requestID := resp.Header.Get(apiserver.RequestIDHeader); requestID != "client-1" {
+		t.Errorf("expected the request ID of the client, got %q", requestID)
+	}
+
+	testCases := []struct {
+		method, path, body string
+		code               int
+		status             bool
+	}{
+		{"GET", "/api/v1/namespaces/default/pods/missing", "", http.StatusNotFound, true},
+		{"TRACE", "/api/v1/namespaces/default/pods", "", http.StatusMethodNotAllowed, true},
+		{"POST", "/api/v1/namespaces/default/pods", strings.Repeat("a", 2048), http.StatusRequestEntityTooLarge, false},
+	}
+	for _, test := range testCases {
+		resp, status := do(test.method, test.path, "client-2", test.body)
+		if resp.StatusCode != test.code {
+			t.Errorf("%s %s: expected status %d, got %d", test.method, test.path, test.code, resp.StatusCode)
+		}
+		if requestID := resp.Header.Get(apiserver.RequestIDHeader); requestID != "client-2" {
+			t.Errorf("%s %s: expected the request ID of the client on an error, got %q", test.method, test.path, requestID)
+		}
+		if !test.status {
+			continue
+		}
+		if status == nil || status.Details == nil || status.Details.RequestID != "client-2" {
+			t.Errorf("%s %s: expected the request ID of the client in the status, got %#v", test.method, test.path, status)
+		}
+	}
+
+	generated := map[string]bool{}
+	for _, requestID := range []string{"", "with space", strings.Repeat("a", maxRequestIDLength+1)} {
+		resp, _ = do("GET", "/api/v1/namespaces/default/pods", requestID, "")
+		got := resp.Header.Get(apiserver.RequestIDHeader)
+		if !isValidRequestID(got) || got == requestID || generated[got] {
+			t.Errorf("expected a new request ID instead of %q, got %q", requestID, got)
+		}
+		generated[got] = true
+	}
+}
+
+// TestRequestIDThirdParty verifies that the ID of a request for third party
+// objects reaches the storage calls made for it.
+func TestRequestIDThirdParty(t *testing.T) {
+	master, terminate := initRequestIDMaster(t)
+	defer terminate()
+	thirdPartyStorage := &requestIDStorage{Interface: master.thirdPartyStorage}
+	master.thirdPartyStorage = thirdPartyStorage
+	if err := master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{Name: "v1"}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	req, err := http.NewRequest("GET", server.URL+"/apis/company.com/v1/namespaces/default/foos", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set(apiserver.RequestIDHeader, "client-1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if requestID := resp.Header.Get(apiserver.RequestIDHeader); requestID != "client-1" {
+		t.Errorf("expected the request ID of the client, got %q", requestID)
+	}
+
+	thirdPartyStorage.lock.Lock()
+	defer thirdPartyStorage.lock.Unlock()
+	if len(thirdPartyStorage.requestIDs) != 1 || thirdPartyStorage.requestIDs[0] != "client-1" {
+		t.Errorf("expected one list for request client-1, got %v", thirdPartyStorage.requestIDs)
+	}
+}
+
+// TestRequestLoggedStorage verifies that the calls made through a request
+// logged storage reach the storage, within requests or not.
+func TestRequestLoggedStorage(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	s := newRequestLoggedStorage(config.StorageDestinations.Get("", "pods"), "pods")
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"}}
+	ctx := api.WithRequestID(api.NewDefaultContext(), "client-1")
+	assert.NoError(s.Create(ctx, "/pods/default/foo", pod, nil, 0))
+	assert.True(storage.IsNodeExist(s.Create(ctx, "/pods/default/foo", pod, nil, 0)))
+	out := &api.Pod{}
+	assert.NoError(s.Get(api.NewDefaultContext(), "/pods/default/foo", out, false))
+	assert.Equal("foo", out.Name)
+	assert.NoError(s.Delete(ctx, "/pods/default/foo", &api.Pod{}))
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/apiserver"
+)
+
+// withRequestInfo wraps handler so that the RequestInfo of a request is resolved
+// once, and put in its context for the filters inside, which read it with
+// requestInfo. It needs the request context, so it is installed inside the
+// context filter.
+func (m *Master) withRequestInfo(handler http.Handler) http.Handler {
+	resolver := m.newRequestInfoResolver()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		m.resolveRequestInfo(resolver, req)
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// resolveRequestInfo puts the RequestInfo of req, as resolved by resolver, in the
+// context of req.
+func (m *Master) resolveRequestInfo(resolver *apiserver.RequestInfoResolver, req *http.Request) {
+	ctx, ok := m.requestContextMapper.Get(req)
+	if !ok {
+		return
+	}
+	if info, err := resolver.GetRequestInfo(req); err == nil {
+		m.requestContextMapper.Update(req, apiserver.WithRequestInfo(ctx, info))
+	}
+}
+
+// requestInfo returns the RequestInfo of req put in its context by withRequestInfo.
+// It is resolved again for the requests that have none, which only happens when
+// a filter is served without the master's handler chain, e.g. in tests.
+func (m *Master) requestInfo(req *http.Request) (apiserver.RequestInfo, error) {
+	if ctx, ok := m.requestContextMapper.Get(req); ok {
+		if info, ok := apiserver.RequestInfoFrom(ctx); ok {
+			return info, nil
+		}
+	}
+	return m.newRequestInfoResolver().GetRequestInfo(req)
+}
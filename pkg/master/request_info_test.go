@@ -0,0 +1,72 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+)
+
+// TestWithRequestInfo verifies that the request info is put in the request
+// context, and resolved again when the default namespace of a third party
+// resource is added to the URL.
+func TestWithRequestInfo(t *testing.T) {
+	m := &Master{
+		apiPrefix:                   "/api",
+		requestContextMapper:        api.NewRequestContextMapper(),
+		thirdPartyDefaultNamespaces: map[string]string{"company.com/foos": "legacy"},
+		thirdPartyResources:         map[string]*thirdpartyresourcedatastorage.REST{"/apis/company.com": nil},
+	}
+	var info apiserver.RequestInfo
+	found := false
+	handler, err := api.NewRequestContextFilter(m.requestContextMapper, m.withRequestInfo(m.withThirdPartyDefaultNamespaces(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx, _ := m.requestContextMapper.Get(req)
+		info, found = apiserver.RequestInfoFrom(ctx)
+	}))))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		path      string
+		namespace string
+		name      string
+	}{
+		{"/api/v1/namespaces/default/pods/foo", "default", "foo"},
+		{"/apis/company.com/v1/foos/bar", "legacy", "bar"},
+	}
+	for _, testCase := range testCases {
+		found = false
+		req, err := http.NewRequest("GET", testCase.path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if !found {
+			t.Errorf("%s: expected the request info in the request context", testCase.path)
+			continue
+		}
+		if info.Namespace != testCase.namespace || info.Name != testCase.name {
+			t.Errorf("%s: expected %s/%s, got %s/%s", testCase.path, testCase.namespace, testCase.name, info.Namespace, info.Name)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// TestDelegatingResponseWriter verifies that the response writers of the filters
+// pass flushes and close notifications through, and fail to hijack the writers
+// that do not support it.
+func TestDelegatingResponseWriter(t *testing.T) {
+	for name, newWriter := range map[string]func(http.ResponseWriter) http.ResponseWriter{
+		"compression": func(w http.ResponseWriter) http.ResponseWriter {
+			return &compressingResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}, encoding: "gzip"}
+		},
+		"problem": func(w http.ResponseWriter) http.ResponseWriter {
+			return &problemResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}}
+		},
+		"not found page": func(w http.ResponseWriter) http.ResponseWriter {
+			return &notFoundPageWriter{delegatingResponseWriter: delegatingResponseWriter{w}}
+		},
+		"status": func(w http.ResponseWriter) http.ResponseWriter {
+			return &statusRecorder{delegatingResponseWriter: delegatingResponseWriter{w}}
+		},
+	} {
+		underlying := &singleCloseNotifier{ResponseRecorder: httptest.NewRecorder(), closed: make(chan bool, 1)}
+		w := newWriter(underlying)
+
+		w.(http.Flusher).Flush()
+		if !underlying.Flushed {
+			t.Errorf("%s: expected the flush to be passed through", name)
+		}
+		underlying.closed <- true
+		select {
+		case <-w.(http.CloseNotifier).CloseNotify():
+		case <-time.After(util.ForeverTestTimeout):
+			t.Errorf("%s: expected the disconnect to be notified", name)
+		}
+		if _, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			t.Errorf("%s: expected hijacking to fail", name)
+		}
+	}
+}
+
+// TestBufferedResponseFlush verifies that flushing a buffered response does not
+// write the response out.
+func TestBufferedResponseFlush(t *testing.T) {
+	underlying := httptest.NewRecorder()
+	response := newBufferedResponse(underlying)
+	response.Write([]byte("buffered"))
+	response.Flush()
+	if underlying.Flushed || underlying.Body.Len() > 0 {
+		t.Errorf("expected the response to stay buffered, got %q", underlying.Body.String())
+	}
+}
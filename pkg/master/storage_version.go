@@ -0,0 +1,104 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// storageVersionSetter is implemented by the storage codecs whose storage
+// version can be changed at runtime, like storage.StorageVersionCodec.
+type storageVersionSetter interface {
+	SetStorageVersion(version string) error
+}
+
+// SetStorageVersion makes the objects of group written from now on be stored in
+// version, e.g. to migrate them after an upgrade without restarting the master.
+// The objects stored before keep being read in their own version. It returns an
+// error if version is not a version of group registered in the scheme, or if the
+// storage of group can't change its version, e.g. because it was not built with
+// a storage.StorageVersionCodec.
+func (m *Master) SetStorageVersion(group, version string) error {
+	groupVersion, err := unversioned.ParseGroupVersion(version)
+	if err != nil {
+		return err
+	}
+	if groupVersion.Group != group {
+		return fmt.Errorf("storage version %q is not a version of the API group %q", version, group)
+	}
+	if len(api.Scheme.KnownTypes(groupVersion)) == 0 {
+		return fmt.Errorf("storage version %q is not a version of the API group %q registered in the scheme", version, group)
+	}
+	setters := m.storageVersionSetters(group)
+	if len(setters) == 0 {
+		return fmt.Errorf("the storage version of the API group %q can't be changed", group)
+	}
+
+	m.storageVersionsLock.Lock()
+	defer m.storageVersionsLock.Unlock()
+	for _, setter := range setters {
+		if err := setter.SetStorageVersion(version); err != nil {
+			return err
+		}
+	}
+	// Like the storage codecs, keep reading the previous storage version while
+	// the group is migrated.
+	previous := m.storageVersions[group]
+	if readVersions := m.storageReadVersions[group]; len(readVersions) > 0 && len(previous) > 0 && previous != version {
+		versions := sets.NewString(readVersions...)
+		versions.Insert(previous)
+		m.storageReadVersions[group] = versions.List()
+	}
+	if m.storageVersions == nil {
+		m.storageVersions = map[string]string{}
+	}
+	m.storageVersions[group] = version
+	return nil
+}
+
+// storageVersionSetters returns the storage codecs of the destinations of group
+// that can change their storage version, without duplicates. Groups without a
+// destination of their own have none.
+func (m *Master) storageVersionSetters(group string) []storageVersionSetter {
+	destinations, ok := m.storageDestinations.APIGroups[group]
+	if !ok {
+		return nil
+	}
+	codecs := []runtime.Codec{destinations.Codec}
+	if destinations.Default != nil {
+		codecs = append(codecs, destinations.Default.Codec())
+	}
+	for _, override := range destinations.Overrides {
+		codecs = append(codecs, override.Codec())
+	}
+	setters := []storageVersionSetter{}
+	seen := map[storageVersionSetter]bool{}
+	for _, codec := range codecs {
+		setter, ok := codec.(storageVersionSetter)
+		if !ok || seen[setter] {
+			continue
+		}
+		seen[setter] = true
+		setters = append(setters, setter)
+	}
+	return setters
+}
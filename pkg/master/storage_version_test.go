@@ -0,0 +1,115 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/latest"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/conversion"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+)
+
+// v1beta2Job is the Job of extensions/v1beta2, a version of the extensions group
+// only registered by the tests to change the storage version of the group to. It
+// is stored like the Job of extensions/v1beta1.
+type v1beta2Job struct {
+	v1beta1.Job
+}
+
+var v1beta2GroupVersion = unversioned.GroupVersion{Group: extensions.GroupName, Version: "v1beta2"}
+
+func init() {
+	api.Scheme.AddKnownTypeWithName(v1beta2GroupVersion.WithKind("Job"), &v1beta2Job{})
+	err := api.Scheme.AddConversionFuncs(
+		func(in *v1beta2Job, out *extensions.Job, s conversion.Scope) error {
+			return s.Convert(&in.Job, out, 0)
+		},
+		func(in *extensions.Job, out *v1beta2Job, s conversion.Scope) error {
+			return s.Convert(in, &out.Job, 0)
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TestSetStorageVersion verifies that the storage version of a group can be
+// changed at runtime to a registered version of the group, if its storage supports it,
+// and that the objects written before the change can still be read.
+func TestSetStorageVersion(t *testing.T) {
+	master, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	interfacesFor := func(version unversioned.GroupVersion) (*meta.VersionInterfaces, error) {
+		if version == v1beta2GroupVersion {
+			return &meta.VersionInterfaces{Codec: runtime.CodecFor(api.Scheme, v1beta2GroupVersion)}, nil
+		}
+		return latest.GroupOrDie(extensions.GroupName).InterfacesFor(version)
+	}
+
+	extensionsVersion := testapi.Extensions.GroupVersion().String()
+	codec, err := storage.NewStorageVersionCodec(interfacesFor, api.Scheme, extensionsVersion, []string{extensionsVersion})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	config.StorageDestinations.AddAPIGroup(extensions.GroupName, etcdstorage.NewEtcdStorage(etcdserver.Client, codec, etcdtest.PathPrefix()))
+	master.storageDestinations = config.StorageDestinations
+	master.storageVersions = map[string]string{extensions.GroupName: extensionsVersion}
+	master.storageReadVersions = map[string][]string{extensions.GroupName: {extensionsVersion}}
+
+	job := &extensions.Job{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"}}
+	before, err := runtime.Encode(codec, job)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Contains(string(before), fmt.Sprintf(`"apiVersion":"%s"`, extensionsVersion))
+
+	assert.Error(master.SetStorageVersion(extensions.GroupName, testapi.Default.GroupVersion().String()))
+	assert.Error(master.SetStorageVersion(extensions.GroupName, "extensions/v0"))
+	// The legacy group's storage was not built with a StorageVersionCodec.
+	assert.Error(master.SetStorageVersion(api.GroupName, testapi.Default.GroupVersion().String()))
+	assert.Equal(extensionsVersion, master.storageVersions[extensions.GroupName])
+	assert.Equal(extensionsVersion, codec.StorageVersion())
+
+	assert.NoError(master.SetStorageVersion(extensions.GroupName, v1beta2GroupVersion.String()))
+	assert.Equal(v1beta2GroupVersion.String(), master.storageVersions[extensions.GroupName])
+	assert.Equal(v1beta2GroupVersion.String(), codec.StorageVersion())
+	assert.Equal([]string{extensionsVersion}, master.storageReadVersions[extensions.GroupName])
+
+	after, err := runtime.Encode(codec, job)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Contains(string(after), fmt.Sprintf(`"apiVersion":"%s"`, v1beta2GroupVersion))
+	for _, data := range [][]byte{before, after} {
+		obj, err := codec.Decode(data)
+		if assert.NoError(err) {
+			assert.Equal("foo", obj.(*extensions.Job).Name)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// ThirdPartyAuditEvent describes a request that mutated a third party resource.
+type ThirdPartyAuditEvent struct {
+	// Verb is the kube verb of the request, e.g. create, update or delete.
+	Verb      string
+	Group     string
+	Version   string
+	Resource  string
+	Namespace string
+	// Name is the name of the created object for creates, and is empty for the requests
+	// that don't name an object, e.g. deletecollection or a create that failed.
+	Name string
+	// User is the name of the user who made the request, empty if it was not authenticated.
+	// It is the impersonated user for the requests that impersonate one.
+	User string
+	// Impersonator is the name of the authenticated user who impersonated User, empty
+	// if the request impersonated no one.
+	Impersonator string
+	// ClientAddress is the IP address of the client that made the request, the one
+	// forwarded by a trusted proxy for the requests that went through one.
+	ClientAddress string
+	// Code is the HTTP status code of the response.
+	Code int
+}
+
+// ThirdPartyAuditSink receives an event for every request that mutates a third party resource,
+// after the request has been served.
+type ThirdPartyAuditSink func(event ThirdPartyAuditEvent)
+
+// thirdPartyAuditedVerbs are the verbs of the requests recorded by auditThirdPartyResources.
+var thirdPartyAuditedVerbs = sets.NewString("create", "update", "patch", "delete", "deletecollection")
+
+// auditThirdPartyResources wraps handler so that the mutations of third party resources
+// it serves are reported to the master's third party audit sink.
+func (m *Master) auditThirdPartyResources(handler http.Handler) http.Handler {
+	if m.thirdPartyAuditSink == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || !thirdPartyAuditedVerbs.Has(info.Verb) || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		recorder := &statusRecorder{delegatingResponseWriter: delegatingResponseWriter{w}, code: http.StatusOK}
+		if info.Verb == "create" {
+			recorder.body = &bytes.Buffer{}
+		}
+		handler.ServeHTTP(recorder, req)
+
+		event := ThirdPartyAuditEvent{
+			Verb:      info.Verb,
+			Group:     info.APIGroup,
+			Version:   info.APIVersion,
+			Resource:  info.Resource,
+			Namespace: info.Namespace,
+			Name:      info.Name,
+			Code:      recorder.code,
+		}
+		if recorder.body != nil && recorder.code == http.StatusCreated {
+			event.Name = createdObjectName(recorder.body.Bytes())
+		}
+		if ip := remoteIP(req.RemoteAddr); ip != nil {
+			event.ClientAddress = ip.String()
+		}
+		if ctx, ok := m.requestContextMapper.Get(req); ok {
+			if user, ok := api.UserFrom(ctx); ok {
+				event.User = user.GetName()
+			}
+			if impersonator, ok := api.ImpersonatorFrom(ctx); ok {
+				event.Impersonator = impersonator.GetName()
+			}
+		}
+		m.thirdPartyAuditSink(event)
+	})
+}
+
+// createdObjectName returns the name in the metadata of the object encoded in the
+// body of the response to a create, or an empty string if it has none.
+func createdObjectName(body []byte) string {
+	created := struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return ""
+	}
+	return created.Metadata.Name
+}
+
+// statusRecorder is a http.ResponseWriter that records the status code of the response,
+// and its body if body is not nil. It passes flushes, close notifications and hijacking
+// through to the wrapped writer, so that watches can be served through it.
+type statusRecorder struct {
+	delegatingResponseWriter
+	code int
+	body *bytes.Buffer
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(data []byte) (int, error) {
+	if r.body != nil {
+		r.body.Write(data)
+	}
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/user"
+)
+
+// TestAuditThirdPartyResources verifies that create and delete requests for
+// third party resources are reported to the audit sink, and reads are not.
+func TestAuditThirdPartyResources(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	lock := sync.Mutex{}
+	events := []ThirdPartyAuditEvent{}
+	master.thirdPartyAuditSink = func(event ThirdPartyAuditEvent) {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, event)
+	}
+	master.requestContextMapper = api.NewRequestContextMapper()
+	withUser := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if ctx, ok := master.requestContextMapper.Get(req); ok {
+			master.requestContextMapper.Update(req, api.WithUser(ctx, &user.DefaultInfo{Name: "alice"}))
+		}
+		master.auditThirdPartyResources(master.handlerContainer.ServeMux).ServeHTTP(w, req)
+	})
+	handler, err := api.NewRequestContextFilter(master.requestContextMapper, withUser)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/test")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp, err = httpDelete(server.URL + "/apis/company.com/v1/namespaces/default/foos/test")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	// The name of an object whose name is generated is the one it was created with.
+	data, err = json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{GenerateName: "test-"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err = http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	expected := []ThirdPartyAuditEvent{
+		{Verb: "create", Group: "company.com", Version: "v1", Resource: "foos", Namespace: "default", Name: "test", User: "alice", ClientAddress: "127.0.0.1", Code: http.StatusCreated},
+		{Verb: "delete", Group: "company.com", Version: "v1", Resource: "foos", Namespace: "default", Name: "test", User: "alice", ClientAddress: "127.0.0.1", Code: http.StatusOK},
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if !assert.Len(events, 3) {
+		t.FailNow()
+	}
+	assert.True(strings.HasPrefix(events[2].Name, "test-") && len(events[2].Name) > len("test-"), "unexpected generated name %q", events[2].Name)
+	assert.Equal(expected, events[:2])
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apiserver"
+)
+
+// thirdPartyContentTypes wraps handler so that the requests for third party
+// objects from clients that ask for protobuf and don't accept JSON fail with a
+// 406 Not Acceptable. Third party objects are opaque JSON and can't be encoded
+// in protobuf. The clients that ask for neither get JSON, like those of the
+// other groups.
+func (m *Master) thirdPartyContentTypes(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		accept := req.Header.Get("Accept")
+		if !acceptsOnlyProtobuf(accept) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		writeStatusError(w, apierrors.NewNotAcceptable(fmt.Sprintf("third party resources can only be encoded as %s, not %s", apiserver.JSONContentType, accept)))
+	})
+}
+
+// acceptsOnlyProtobuf returns true if the Accept header accept allows protobuf
+// but not JSON, by name or with a wildcard.
+func acceptsOnlyProtobuf(accept string) bool {
+	protobuf := false
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(value)
+		if err != nil {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q <= 0 {
+			continue
+		}
+		switch mediaType {
+		case apiserver.JSONContentType, "application/*", "*/*":
+			return false
+		case apiserver.ProtobufContentType:
+			protobuf = true
+		}
+	}
+	return protobuf
+}
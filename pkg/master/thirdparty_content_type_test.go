@@ -0,0 +1,104 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apiserver"
+
+	"github.com/emicklei/go-restful"
+)
+
+// TestThirdPartyContentTypes verifies that the clients of third party resources
+// that only accept protobuf get a 406 Not Acceptable instead of the opaque JSON
+// of third party objects, and that the other clients get JSON.
+func TestThirdPartyContentTypes(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	master.handlerContainer.Router(apiserver.JSONFallbackRouter(restful.CurlyRouter{}))
+	server := httptest.NewServer(master.thirdPartyContentTypes(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	for accept, expected := range map[string]int{
+		"":                 http.StatusOK,
+		"application/json": http.StatusOK,
+		"*/*":              http.StatusOK,
+		"text/html":        http.StatusOK,
+		"application/vnd.kubernetes.protobuf, application/json":     http.StatusOK,
+		"application/vnd.kubernetes.protobuf":                       http.StatusNotAcceptable,
+		"application/json;q=0, application/vnd.kubernetes.protobuf": http.StatusNotAcceptable,
+	} {
+		for _, path := range []string{"/apis/company.com/v1/namespaces/default/foos", "/apis/company.com/v1/foos"} {
+			req, err := http.NewRequest("GET", server.URL+path, nil)
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			req.Header.Set("Accept", accept)
+			resp, err := http.DefaultClient.Do(req)
+			if !assert.NoError(err) {
+				t.FailNow()
+			}
+			assert.Equal(expected, resp.StatusCode, "%q %s", accept, path)
+			if expected == http.StatusNotAcceptable {
+				status := unversioned.Status{}
+				assert.NoError(decodeResponse(resp, &status))
+				assert.Equal(unversioned.StatusReasonNotAcceptable, status.Reason)
+			} else {
+				resp.Body.Close()
+			}
+		}
+	}
+
+	// The requests for other groups are left alone.
+	req, err := http.NewRequest("GET", server.URL+"/apis/other.com/v1/foos", nil)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	req.Header.Set("Accept", "application/vnd.kubernetes.protobuf")
+	resp, err := http.DefaultClient.Do(req)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestAcceptsOnlyProtobuf verifies the Accept headers that allow protobuf but
+// not JSON.
+func TestAcceptsOnlyProtobuf(t *testing.T) {
+	for accept, expected := range map[string]bool{
+		"":                          false,
+		"application/json":          false,
+		"application/json;as=Table": false,
+		"application/*":             false,
+		"text/html":                 false,
+		"application/vnd.kubernetes.protobuf, */*;q=0.1":            false,
+		"application/vnd.kubernetes.protobuf":                       true,
+		"application/json;q=0, application/vnd.kubernetes.protobuf": true,
+		"application/vnd.kubernetes.protobuf;q=0":                   false,
+	} {
+		if actual := acceptsOnlyProtobuf(accept); actual != expected {
+			t.Errorf("%q: expected %v, got %v", accept, expected, actual)
+		}
+	}
+}
@@ -46,6 +46,10 @@ type resourceInterface interface {
 	InstallThirdPartyResource(rsrc *expapi.ThirdPartyResource) error
 	// Is a particular third party resource currently installed?
 	HasThirdPartyResource(rsrc *expapi.ThirdPartyResource) (bool, error)
+	// The printer columns of the third party resource currently installed for 'rsrc'
+	ThirdPartyResourceColumns(rsrc *expapi.ThirdPartyResource) ([]expapi.ThirdPartyResourceColumn, error)
+	// Update the third party resource installed for 'rsrc'
+	UpdateThirdPartyResource(rsrc *expapi.ThirdPartyResource) error
 	// List all currently installed third party resources
 	ListThirdPartyResources() []string
 }
@@ -55,6 +59,22 @@ type resourceInterface interface {
 type ThirdPartyController struct {
 	master                     resourceInterface
 	thirdPartyResourceRegistry *thirdpartyresourceetcd.REST
+	// preinstalled are the paths of the resources installed from the master config,
+	// which are kept even though they have no ThirdPartyResource object.
+	preinstalled []string
+}
+
+// preinstalledThirdPartyPaths returns the RESTful paths of the resources in rsrcs.
+// The resources are validated with the master config, so names that can't be
+// parsed are skipped.
+func preinstalledThirdPartyPaths(rsrcs []expapi.ThirdPartyResource) []string {
+	paths := []string{}
+	for ix := range rsrcs {
+		if _, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(&rsrcs[ix]); err == nil {
+			paths = append(paths, makeThirdPartyPath(group))
+		}
+	}
+	return paths
 }
 
 // Synchronize a single resource with RESTful resources on the master
@@ -69,6 +89,15 @@ func (t *ThirdPartyController) SyncOneResource(rsrc *expapi.ThirdPartyResource)
 	if !hasResource {
 		return t.master.InstallThirdPartyResource(rsrc)
 	}
+	// A change of the printer columns is applied in place, without replacing
+	// the handlers of the resource.
+	columns, err := t.master.ThirdPartyResourceColumns(rsrc)
+	if err != nil {
+		return err
+	}
+	if !api.Semantic.DeepEqual(columns, rsrc.AdditionalPrinterColumns) {
+		return t.master.UpdateThirdPartyResource(rsrc)
+	}
 	return nil
 }
 
@@ -82,7 +111,7 @@ func (t *ThirdPartyController) SyncResources() error {
 }
 
 func (t *ThirdPartyController) syncResourceList(list runtime.Object) error {
-	existing := sets.String{}
+	existing := sets.NewString(t.preinstalled...)
 	switch list := list.(type) {
 	case *expapi.ThirdPartyResourceList:
 		// Loop across all schema objects for third party resources
@@ -28,7 +28,9 @@ import (
 type FakeAPIInterface struct {
 	removed   []string
 	installed []*expapi.ThirdPartyResource
+	updated   []*expapi.ThirdPartyResource
 	apis      []string
+	columns   map[string][]expapi.ThirdPartyResourceColumn
 	t         *testing.T
 }
 
@@ -58,6 +60,16 @@ func (f *FakeAPIInterface) HasThirdPartyResource(rsrc *expapi.ThirdPartyResource
 	return false, nil
 }
 
+func (f *FakeAPIInterface) ThirdPartyResourceColumns(rsrc *expapi.ThirdPartyResource) ([]expapi.ThirdPartyResourceColumn, error) {
+	_, group, _ := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
+	return f.columns[makeThirdPartyPath(group)], nil
+}
+
+func (f *FakeAPIInterface) UpdateThirdPartyResource(rsrc *expapi.ThirdPartyResource) error {
+	f.updated = append(f.updated, rsrc)
+	return nil
+}
+
 func (f *FakeAPIInterface) ListThirdPartyResources() []string {
 	return f.apis
 }
@@ -66,6 +78,7 @@ func TestSyncAPIs(t *testing.T) {
 	tests := []struct {
 		list              *expapi.ThirdPartyResourceList
 		apis              []string
+		preinstalled      []string
 		expectedInstalled []string
 		expectedRemoved   []string
 		name              string
@@ -161,6 +174,24 @@ func TestSyncAPIs(t *testing.T) {
 			expectedRemoved:   []string{"/apis/company.com", "/apis/company.com/v1"},
 			name:              "removes with existing",
 		},
+		{
+			list: &expapi.ThirdPartyResourceList{
+				Items: []expapi.ThirdPartyResource{
+					{
+						ObjectMeta: api.ObjectMeta{
+							Name: "foo.example.com",
+						},
+					},
+				},
+			},
+			apis: []string{
+				"/apis/company.com",
+				"/apis/company.com/v1",
+			},
+			preinstalled:      []string{"/apis/company.com"},
+			expectedInstalled: []string{"foo.example.com"},
+			name:              "keeps preinstalled",
+		},
 	}
 
 	for _, test := range tests {
@@ -169,7 +200,7 @@ func TestSyncAPIs(t *testing.T) {
 			t:    t,
 		}
 
-		cntrl := ThirdPartyController{master: &fake}
+		cntrl := ThirdPartyController{master: &fake, preinstalled: test.preinstalled}
 
 		if err := cntrl.syncResourceList(test.list); err != nil {
 			t.Errorf("[%s] unexpected error: %v", test.name)
@@ -202,3 +233,49 @@ func TestSyncAPIs(t *testing.T) {
 		}
 	}
 }
+
+// TestSyncOneResourceColumns verifies that a resource which is already installed
+// is updated only when its printer columns changed.
+func TestSyncOneResourceColumns(t *testing.T) {
+	columns := []expapi.ThirdPartyResourceColumn{{Name: "Some Field", JSONPath: ".someField"}}
+	tests := []struct {
+		name            string
+		installed       []expapi.ThirdPartyResourceColumn
+		columns         []expapi.ThirdPartyResourceColumn
+		expectedUpdated bool
+	}{
+		{name: "no columns"},
+		{name: "same columns", installed: columns, columns: columns},
+		{name: "added columns", columns: columns, expectedUpdated: true},
+		{name: "removed columns", installed: columns, expectedUpdated: true},
+		{
+			name:            "changed columns",
+			installed:       columns,
+			columns:         []expapi.ThirdPartyResourceColumn{{Name: "Some Field", JSONPath: ".otherField"}},
+			expectedUpdated: true,
+		},
+	}
+
+	for _, test := range tests {
+		fake := FakeAPIInterface{
+			apis:    []string{"/apis/example.com"},
+			columns: map[string][]expapi.ThirdPartyResourceColumn{"/apis/example.com": test.installed},
+			t:       t,
+		}
+		cntrl := ThirdPartyController{master: &fake}
+		rsrc := &expapi.ThirdPartyResource{
+			ObjectMeta:               api.ObjectMeta{Name: "foo.example.com"},
+			AdditionalPrinterColumns: test.columns,
+		}
+
+		if err := cntrl.SyncOneResource(rsrc); err != nil {
+			t.Errorf("[%s] unexpected error: %v", test.name, err)
+		}
+		if len(fake.installed) != 0 {
+			t.Errorf("[%s] unexpected installed APIs: %v", test.name, fake.installed)
+		}
+		if updated := len(fake.updated) == 1 && fake.updated[0] == rsrc; updated != test.expectedUpdated {
+			t.Errorf("[%s] expected updated %v, got %v", test.name, test.expectedUpdated, fake.updated)
+		}
+	}
+}
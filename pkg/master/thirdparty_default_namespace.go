@@ -0,0 +1,66 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+)
+
+// thirdPartyResourceKey returns the key of the third party resource named name in
+// the master's default namespaces, i.e. its group and resource, e.g.
+// company.com/foos for foo.company.com.
+func thirdPartyResourceKey(name string) (string, error) {
+	kind, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(&extensions.ThirdPartyResource{ObjectMeta: api.ObjectMeta{Name: name}})
+	if err != nil {
+		return "", err
+	}
+	return group + "/" + strings.ToLower(kind) + "s", nil
+}
+
+// withThirdPartyDefaultNamespaces wraps handler so that the requests for a single
+// object, or creating one, of a third party resource with a default namespace are
+// served in that namespace when their URL has none. Lists and watches without a
+// namespace keep spanning all the namespaces. The request info in the context of
+// the requests whose URL is rewritten is resolved again.
+func (m *Master) withThirdPartyDefaultNamespaces(handler http.Handler) http.Handler {
+	if len(m.thirdPartyDefaultNamespaces) == 0 {
+		return handler
+	}
+	resolver := m.newRequestInfoResolver()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || len(info.Namespace) > 0 || (len(info.Name) == 0 && info.Verb != "create") || info.Verb == "watch" {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		namespace, found := m.thirdPartyDefaultNamespaces[info.APIGroup+"/"+info.Resource]
+		if !found || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		parts := append([]string{info.APIPrefix, info.APIGroup, info.APIVersion, "namespaces", namespace}, info.Parts...)
+		req.URL.Path = "/" + path.Join(parts...)
+		m.resolveRequestInfo(resolver, req)
+		handler.ServeHTTP(w, req)
+	})
+}
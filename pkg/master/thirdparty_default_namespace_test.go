@@ -0,0 +1,116 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// TestThirdPartyDefaultNamespaces verifies that the URLs of third party objects
+// without a namespace are served in the default namespace of their resource, and
+// only if it has one.
+func TestThirdPartyDefaultNamespaces(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		SomeField:  "test field",
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+
+	// Off by default.
+	server := httptest.NewServer(master.withThirdPartyDefaultNamespaces(master.handlerContainer.ServeMux))
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/foos", "application/json", bytes.NewBuffer(data))
+	server.Close()
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.NotEqual(http.StatusCreated, resp.StatusCode)
+
+	master.thirdPartyDefaultNamespaces = map[string]string{"company.com/foos": "legacy"}
+	server = httptest.NewServer(master.withThirdPartyDefaultNamespaces(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	resp, err = http.Post(server.URL+"/apis/company.com/v1/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+	created := Foo{}
+	assert.NoError(decodeResponse(resp, &created))
+	assert.Equal("legacy", created.Namespace)
+	assert.Equal("/apis/company.com/v1/namespaces/legacy/foos/test", created.SelfLink)
+
+	for _, path := range []string{"/apis/company.com/v1/foos/test", "/apis/company.com/v1/namespaces/legacy/foos/test"} {
+		resp, err = http.Get(server.URL + path)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		assert.Equal(http.StatusOK, resp.StatusCode, path)
+		item := Foo{}
+		assert.NoError(decodeResponse(resp, &item))
+		assert.Equal("test field", item.SomeField, path)
+	}
+
+	// Lists without a namespace still span all the namespaces.
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/foos")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	list := FooList{}
+	assert.NoError(decodeResponse(resp, &list))
+	assert.Len(list.Items, 1)
+
+	req, err := http.NewRequest("DELETE", server.URL+"/apis/company.com/v1/foos/test", nil)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+}
+
+// TestThirdPartyResourceKey verifies the keys of the third party resources in the
+// master's default namespaces.
+func TestThirdPartyResourceKey(t *testing.T) {
+	key, err := thirdPartyResourceKey("foo-bar.company.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key != "company.com/foobars" {
+		t.Errorf("expected company.com/foobars, got %s", key)
+	}
+	if _, err := thirdPartyResourceKey("foo"); err == nil {
+		t.Errorf("expected an error for an unparsable name")
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+
+	"github.com/golang/glog"
+)
+
+// trackThirdPartyRequests wraps handler so that the requests for third party
+// objects are counted per third party resource, and refused with a 503 Service
+// Unavailable once the removal of their resource has started. This lets
+// RemoveThirdPartyResource wait for them before tearing down the storage and
+// handlers they use.
+func (m *Master) trackThirdPartyRequests(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		path := makeThirdPartyPath(info.APIGroup)
+		inflight := m.thirdPartyRequests(path)
+		if inflight == nil {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if !inflight.start() {
+			writeStatusError(w, apierrors.NewServiceUnavailable(fmt.Sprintf("the third party resource at %s is being removed", path)))
+			return
+		}
+		defer inflight.done()
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// thirdPartyRequests returns the in-flight requests of the third party resource
+// installed at path, or nil if there is none.
+func (m *Master) thirdPartyRequests(path string) *inflightRequests {
+	m.thirdPartyResourcesLock.RLock()
+	defer m.thirdPartyResourcesLock.RUnlock()
+	return m.thirdPartyInflight[path]
+}
+
+// drainThirdPartyRequests stops accepting requests for the third party resource
+// installed at path, stops its watches, which would otherwise only end at their
+// timeout, and waits for the in-flight requests to complete, for up to the drain
+// timeout.
+func (m *Master) drainThirdPartyRequests(path string) {
+	inflight := m.thirdPartyRequests(path)
+	if inflight == nil {
+		return
+	}
+	drained := inflight.drain()
+	if storage, found := m.thirdPartyResourceStorage(path); found {
+		storage.StopWatches()
+	}
+	select {
+	case <-drained:
+	case <-time.After(m.thirdPartyDrainTimeout):
+		glog.Warningf("Removing the third party resource at %s with requests still in flight after %v", path, m.thirdPartyDrainTimeout)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"golang.org/x/net/websocket"
+)
+
+// TestRemoveThirdPartyResourceDrains verifies that the removal of a third party
+// resource refuses its new requests, and waits for its in-flight ones to complete
+// before tearing it down, without blocking the installation of other resources.
+func TestRemoveThirdPartyResourceDrains(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+	master.thirdPartyDrainTimeout = time.Minute
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	server := httptest.NewServer(master.trackThirdPartyRequests(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		once.Do(func() {
+			close(started)
+			<-release
+		})
+		master.handlerContainer.ServeMux.ServeHTTP(w, req)
+	})))
+	defer server.Close()
+	get := func() int {
+		resp, err := http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos")
+		if !assert.NoError(err) {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	inflight := make(chan int)
+	go func() { inflight <- get() }()
+	<-started
+	removed := make(chan error)
+	go func() { removed <- master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")) }()
+
+	// Wait for the removal to start refusing requests.
+	deadline := time.Now().Add(util.ForeverTestTimeout)
+	for get() != http.StatusServiceUnavailable && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-removed:
+		t.Fatalf("the third party resource was removed with a request in flight: %v", err)
+	default:
+	}
+	assert.True(master.HasThirdPartyResourcePath(makeThirdPartyPath("company.com")))
+
+	installed := make(chan error)
+	go func() {
+		installed <- master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+			ObjectMeta: api.ObjectMeta{Name: "bar.other.com"},
+			Versions:   []extensions.APIVersion{{Name: "v1"}},
+		})
+	}()
+	select {
+	case err := <-installed:
+		assert.NoError(err)
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("the installation of another third party resource waited for the drain")
+	}
+	assert.True(master.HasThirdPartyResourcePath(makeThirdPartyPath("other.com")))
+
+	close(release)
+	assert.Equal(http.StatusOK, <-inflight)
+	assert.NoError(<-removed)
+	assert.False(master.HasThirdPartyResourcePath(makeThirdPartyPath("company.com")))
+	assert.Equal(http.StatusNotFound, get())
+}
+
+// TestRemoveThirdPartyResourceDrainTimeout verifies that the removal of a third
+// party resource doesn't wait for its in-flight requests beyond the drain timeout.
+func TestRemoveThirdPartyResourceDrainTimeout(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+	master.thirdPartyDrainTimeout = 10 * time.Millisecond
+
+	inflight := master.thirdPartyRequests(makeThirdPartyPath("company.com"))
+	if !assert.NotNil(inflight) || !assert.True(inflight.start()) {
+		t.FailNow()
+	}
+	defer inflight.done()
+	assert.NoError(master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")))
+	assert.False(master.HasThirdPartyResourcePath(makeThirdPartyPath("company.com")))
+}
+
+// TestRemoveThirdPartyResourceEndsWebsocketWatches verifies that the third party
+// objects can be watched over a websocket, with an event per text frame, and that
+// the removal of their resource closes the websocket rather than waiting for the
+// watch to time out.
+func TestRemoveThirdPartyResourceEndsWebsocketWatches(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+	master.thirdPartyDrainTimeout = time.Hour
+
+	server := httptest.NewServer(master.trackThirdPartyRequests(master.handlerContainer.ServeMux))
+	defer server.Close()
+	dest, _ := url.Parse(server.URL)
+	dest.Scheme = "ws"
+	dest.Path = "/apis/company.com/v1/watch/namespaces/default/foos"
+	ws, err := websocket.Dial(dest.String(), "", "http://localhost")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	defer ws.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	var event struct {
+		Type   watch.EventType `json:"type"`
+		Object Foo             `json:"object"`
+	}
+	ws.SetReadDeadline(time.Now().Add(util.ForeverTestTimeout))
+	if !assert.NoError(websocket.JSON.Receive(ws, &event)) {
+		t.FailNow()
+	}
+	assert.Equal(watch.Added, event.Type)
+	assert.Equal("test", event.Object.Name)
+
+	removed := make(chan error)
+	go func() { removed <- master.RemoveThirdPartyResource(makeThirdPartyPath("company.com")) }()
+	select {
+	case err := <-removed:
+		assert.NoError(err)
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("the removal waited for the watch")
+	}
+	var unused interface{}
+	assert.Equal(io.EOF, websocket.JSON.Receive(ws, &unused))
+}
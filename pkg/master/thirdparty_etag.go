@@ -0,0 +1,118 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// thirdPartyETags wraps handler so that reads of single third party objects carry
+// an ETag derived from the resource version of the object, and reads whose
+// If-None-Match header matches it are answered with 304 Not Modified.
+func (m *Master) thirdPartyETags(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || info.Verb != "get" || len(info.Name) == 0 || len(info.Subresource) > 0 || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		response := newBufferedResponse(w)
+		handler.ServeHTTP(response, req)
+
+		if response.code == http.StatusOK {
+			if etag := resourceVersionETag(response.body.Bytes()); len(etag) > 0 {
+				response.header.Set("ETag", etag)
+				if etagMatches(req.Header.Get("If-None-Match"), etag) {
+					copyHeader(w.Header(), response.header)
+					w.Header().Del("Content-Type")
+					w.Header().Del("Content-Length")
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+			}
+		}
+		copyHeader(w.Header(), response.header)
+		w.WriteHeader(response.code)
+		w.Write(response.body.Bytes())
+	})
+}
+
+// resourceVersionETag returns a strong ETag for the resource version of the
+// JSON encoded object in data, or "" if it has none.
+func resourceVersionETag(data []byte) string {
+	object := struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(data, &object); err != nil || len(object.Metadata.ResourceVersion) == 0 {
+		return ""
+	}
+	return `"` + object.Metadata.ResourceVersion + `"`
+}
+
+// etagMatches returns true if the value of an If-None-Match header lists etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func copyHeader(dst, src http.Header) {
+	for key, values := range src {
+		dst[key] = values
+	}
+}
+
+// bufferedResponse is a http.ResponseWriter that holds the response in memory.
+// Flushes are ignored, while close notifications and hijacking are passed
+// through to the writer it buffers the response for.
+type bufferedResponse struct {
+	delegatingResponseWriter
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+// newBufferedResponse returns a bufferedResponse whose header starts as a copy of
+// the header of w, e.g. with the ID of the request.
+func newBufferedResponse(w http.ResponseWriter) *bufferedResponse {
+	response := &bufferedResponse{delegatingResponseWriter: delegatingResponseWriter{w}, header: http.Header{}, code: http.StatusOK}
+	copyHeader(response.header, w.Header())
+	return response
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *bufferedResponse) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *bufferedResponse) Flush() {}
@@ -0,0 +1,100 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// TestThirdPartyETags verifies that reads of third party objects carry the
+// resource version as ETag, and that conditional reads are answered with 304
+// until the object changes.
+func TestThirdPartyETags(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	server := httptest.NewServer(master.thirdPartyETags(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	obj := Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		SomeField:  "test field",
+	}
+	data, err := json.Marshal(obj)
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	created := Foo{}
+	assert.NoError(decodeResponse(resp, &created))
+
+	get := func(etag string) *http.Response {
+		req, err := http.NewRequest("GET", server.URL+"/apis/company.com/v1/namespaces/default/foos/test", nil)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		if len(etag) > 0 {
+			req.Header.Set("If-None-Match", etag)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		return resp
+	}
+
+	resp = get("")
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	assert.Equal(`"`+created.ResourceVersion+`"`, etag)
+	item := Foo{}
+	assert.NoError(decodeResponse(resp, &item))
+	assert.Equal("test field", item.SomeField)
+
+	resp = get(etag)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(http.StatusNotModified, resp.StatusCode)
+	assert.Equal(etag, resp.Header.Get("ETag"))
+	assert.Empty(body)
+
+	resp = get(`"0"`)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	// Reads of missing objects are passed through.
+	resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/missing")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+	assert.Empty(resp.Header.Get("ETag"))
+}
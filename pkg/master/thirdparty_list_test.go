@@ -0,0 +1,163 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apiserver"
+)
+
+// TestInstallThirdPartyAPIListAllNamespaces verifies that third party objects can
+// be listed across namespaces, with label and field selectors and in pages.
+func TestInstallThirdPartyAPIListAllNamespaces(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	handler, err := api.NewRequestContextFilter(master.requestContextMapper, master.thirdPartyListPages(master.handlerContainer.ServeMux))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, obj := range []struct{ namespace, name, tier string }{{"ns2", "a", "web"}, {"ns1", "b", "db"}, {"ns1", "a", "web"}} {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: obj.name, Labels: map[string]string{"tier": obj.tier}},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/"+obj.namespace+"/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	list := func(query url.Values) FooList {
+		resp, err := http.Get(server.URL + "/apis/company.com/v1/foos?" + query.Encode())
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		if !assert.Equal(http.StatusOK, resp.StatusCode, "query %v", query) {
+			t.FailNow()
+		}
+		list := FooList{}
+		assert.NoError(decodeResponse(resp, &list))
+		return list
+	}
+	keys := func(list FooList) []string {
+		keys := []string{}
+		for _, item := range list.Items {
+			keys = append(keys, item.Namespace+"/"+item.Name)
+		}
+		return keys
+	}
+
+	assert.Equal([]string{"ns1/a", "ns1/b", "ns2/a"}, keys(list(url.Values{"limit": {"3"}})))
+	assert.Equal([]string{"ns1/a", "ns2/a"}, keys(list(url.Values{"labelSelector": {"tier=web"}, "limit": {"3"}})))
+	assert.Equal([]string{"ns1/a", "ns2/a"}, keys(list(url.Values{"fieldSelector": {"metadata.name=a"}, "limit": {"3"}})))
+	assert.Equal([]string{"ns2/a"}, keys(list(url.Values{"fieldSelector": {"metadata.namespace=ns2"}})))
+
+	first := list(url.Values{"limit": {"2"}})
+	assert.Equal([]string{"ns1/a", "ns1/b"}, keys(first))
+	assert.Equal([]string{"ns2/a"}, keys(list(url.Values{"limit": {"2"}, "continue": {first.Continue}})))
+}
+
+// TestInstallThirdPartyAPIListPartialObjectMetadata verifies that third party
+// objects can be listed with only their metadata, in pages.
+func TestInstallThirdPartyAPIListPartialObjectMetadata(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	handler, err := api.NewRequestContextFilter(master.requestContextMapper, master.thirdPartyListPages(master.handlerContainer.ServeMux))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, name := range []string{"a", "b"} {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: name, Labels: map[string]string{"tier": "web"}},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+			SomeField:  "secret",
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(http.StatusCreated, resp.StatusCode)
+	}
+
+	list := func(query url.Values) apiserver.PartialObjectMetadataList {
+		req, err := http.NewRequest("GET", server.URL+"/apis/company.com/v1/namespaces/default/foos?"+query.Encode(), nil)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		req.Header.Set("Accept", apiserver.PartialObjectMetadataListContentType)
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if !assert.NoError(err) || !assert.Equal(http.StatusOK, resp.StatusCode, "%s", body) {
+			t.FailNow()
+		}
+		assert.Equal(apiserver.PartialObjectMetadataListContentType, resp.Header.Get("Content-Type"))
+		assert.False(strings.Contains(string(body), "secret"), "expected only the metadata of the objects, got %s", body)
+		list := apiserver.PartialObjectMetadataList{}
+		assert.NoError(json.Unmarshal(body, &list))
+		return list
+	}
+	names := func(list apiserver.PartialObjectMetadataList) []string {
+		names := []string{}
+		for _, item := range list.Items {
+			assert.Equal(map[string]string{"tier": "web"}, item.Labels)
+			names = append(names, item.Name)
+		}
+		return names
+	}
+
+	all := list(url.Values{})
+	assert.Equal("PartialObjectMetadataList", all.Kind)
+	assert.Equal("company.com/v1", all.APIVersion)
+	assert.Equal([]string{"a", "b"}, names(all))
+
+	first := list(url.Values{"limit": {"1"}})
+	assert.Equal([]string{"a"}, names(first))
+	assert.Equal([]string{"b"}, names(list(url.Values{"limit": {"1"}, "continue": {first.Continue}})))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// thirdPartyMetrics are the metrics of the requests for third party resources.
+type thirdPartyMetrics struct {
+	requests  *prometheus.CounterVec
+	latencies *prometheus.HistogramVec
+}
+
+// newThirdPartyMetrics registers the metrics of third party resource requests
+// against registry, reusing the metrics already registered by another master.
+func newThirdPartyMetrics(registry MetricsRegistry) (*thirdPartyMetrics, error) {
+	requests, err := registry.RegisterOrGet(prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "apiserver_third_party_request_count",
+			Help: "Counter of third party resource requests broken out for each verb, API group, resource and HTTP response code.",
+		},
+		[]string{"verb", "group", "resource", "code"},
+	))
+	if err != nil {
+		return nil, err
+	}
+	latencies, err := registry.RegisterOrGet(prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "apiserver_third_party_request_latencies",
+			Help: "Response latency distribution in microseconds for each verb, API group and resource of third party resource requests.",
+			// Use the same buckets as the core API request latencies, ranging from 125 ms to 8 seconds.
+			Buckets: prometheus.ExponentialBuckets(125000, 2.0, 7),
+		},
+		[]string{"verb", "group", "resource"},
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &thirdPartyMetrics{
+		requests:  requests.(*prometheus.CounterVec),
+		latencies: latencies.(*prometheus.HistogramVec),
+	}, nil
+}
+
+// instrumentThirdPartyResources wraps handler so that the requests for third
+// party resources it serves are counted and timed, separately from the requests
+// for the resources built into the master.
+func (m *Master) instrumentThirdPartyResources(handler http.Handler) http.Handler {
+	if m.thirdPartyMetrics == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || !info.IsResourceRequest || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		reqStart := time.Now()
+		recorder := &statusRecorder{delegatingResponseWriter: delegatingResponseWriter{w}, code: http.StatusOK}
+		handler.ServeHTTP(recorder, req)
+
+		m.thirdPartyMetrics.requests.WithLabelValues(info.Verb, info.APIGroup, info.Resource, strconv.Itoa(recorder.code)).Inc()
+		m.thirdPartyMetrics.latencies.WithLabelValues(info.Verb, info.APIGroup, info.Resource).Observe(float64(time.Since(reqStart) / time.Microsecond))
+	})
+}
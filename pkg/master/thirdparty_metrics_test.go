@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// TestInstrumentThirdPartyResources verifies that requests for third party
+// resources are counted and timed per verb, group and resource.
+func TestInstrumentThirdPartyResources(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	metrics, err := newThirdPartyMetrics(&testMetricsRegistry{})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	master.thirdPartyMetrics = metrics
+	server := httptest.NewServer(master.instrumentThirdPartyResources(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	for i := 0; i < 2; i++ {
+		resp, err = http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos/test")
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(http.StatusOK, resp.StatusCode)
+	}
+
+	// Requests outside of the third party API groups are not recorded.
+	resp, err = http.Get(server.URL + "/apis/other.com/v1/namespaces/default/foos")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+
+	counts := []struct {
+		verb, code string
+		count      float64
+	}{
+		{"create", "201", 1},
+		{"get", "200", 2},
+	}
+	for _, item := range counts {
+		metric := &dto.Metric{}
+		if assert.NoError(metrics.requests.WithLabelValues(item.verb, "company.com", "foos", item.code).Write(metric)) {
+			assert.Equal(item.count, metric.GetCounter().GetValue(), "unexpected count for %s", item.verb)
+		}
+		metric = &dto.Metric{}
+		if assert.NoError(metrics.latencies.WithLabelValues(item.verb, "company.com", "foos").Write(metric)) {
+			assert.Equal(uint64(item.count), metric.GetHistogram().GetSampleCount(), "unexpected latency samples for %s", item.verb)
+		}
+	}
+	metric := &dto.Metric{}
+	if assert.NoError(metrics.requests.WithLabelValues("list", "other.com", "foos", "404").Write(metric)) {
+		assert.Equal(float64(0), metric.GetCounter().GetValue())
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/registry/namespace"
+	thirdpartyresourcedataetcd "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// thirdPartyNamespaceLifecycle refuses to create third party objects in namespaces
+// that don't exist or are being terminated, like the NamespaceLifecycle admission
+// plugin does for the core resources. Unlike the plugin it reads the namespaces
+// straight from the registry, so it needs no client.
+type thirdPartyNamespaceLifecycle struct {
+	*admission.Handler
+	registry namespace.Registry
+}
+
+func newThirdPartyNamespaceLifecycle(registry namespace.Registry) admission.Interface {
+	return thirdPartyNamespaceLifecycle{
+		Handler:  admission.NewHandler(admission.Create),
+		registry: registry,
+	}
+}
+
+func (l thirdPartyNamespaceLifecycle) Admit(a admission.Attributes) error {
+	if len(a.GetNamespace()) == 0 {
+		return nil
+	}
+	ns, err := l.registry.GetNamespace(api.NewContext(), a.GetNamespace())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return err
+		}
+		return apierrors.NewInternalError(err)
+	}
+	if ns.Status.Phase == api.NamespaceTerminating {
+		return admission.NewForbidden(a, fmt.Errorf("unable to create new content in namespace %s because it is being terminated", a.GetNamespace()))
+	}
+	return nil
+}
+
+// deleteThirdPartyNamespaceData deletes the objects of every installed third party
+// resource in a namespace. It is called after the namespace has been deleted, so
+// that no third party objects are left behind in etcd.
+func (m *Master) deleteThirdPartyNamespaceData(obj runtime.Object) error {
+	ns, ok := obj.(*api.Namespace)
+	if !ok {
+		return fmt.Errorf("expected a *Namespace, got %#v", obj)
+	}
+	m.thirdPartyResourcesLock.RLock()
+	registries := make([]*thirdpartyresourcedataetcd.REST, 0, len(m.thirdPartyResources))
+	for _, registry := range m.thirdPartyResources {
+		registries = append(registries, registry)
+	}
+	m.thirdPartyResourcesLock.RUnlock()
+
+	ctx := api.WithNamespace(api.NewContext(), ns.Name)
+	errs := []error{}
+	for _, registry := range registries {
+		if err := deleteThirdPartyData(ctx, registry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/registry/namespace"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"k8s.io/kubernetes/pkg/storage"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+
+	"github.com/emicklei/go-restful"
+	"golang.org/x/net/context"
+)
+
+// namespaceMapRegistry serves GetNamespace from a map of namespaces.
+type namespaceMapRegistry struct {
+	namespace.Registry
+	namespaces map[string]*api.Namespace
+}
+
+func (r *namespaceMapRegistry) GetNamespace(ctx api.Context, name string) (*api.Namespace, error) {
+	ns, found := r.namespaces[name]
+	if !found {
+		return nil, apierrors.NewNotFound("namespaces", name)
+	}
+	return ns, nil
+}
+
+// TestThirdPartyNamespaceLifecycle verifies that third party objects can only be
+// created in namespaces that exist and are not being terminated.
+func TestThirdPartyNamespaceLifecycle(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	master.namespaceRegistry = &namespaceMapRegistry{
+		namespaces: map[string]*api.Namespace{
+			"default": {ObjectMeta: api.ObjectMeta{Name: "default"}},
+			"terminating": {
+				ObjectMeta: api.ObjectMeta{Name: "terminating"},
+				Status:     api.NamespaceStatus{Phase: api.NamespaceTerminating},
+			},
+		},
+	}
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	master.handlerContainer = restful.NewContainer()
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(etcdserver.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	rsrc := &extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	}
+	if !assert.NoError(master.InstallThirdPartyResource(rsrc)) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.handlerContainer.ServeMux)
+	defer server.Close()
+
+	for namespace, expected := range map[string]int{
+		"default":      http.StatusCreated,
+		"doesnotexist": http.StatusNotFound,
+		"terminating":  http.StatusForbidden,
+	} {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: "test"},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/"+namespace+"/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(expected, resp.StatusCode, namespace)
+	}
+}
+
+// TestDeleteThirdPartyNamespaceData verifies that the third party objects of a
+// deleted namespace are deleted, and the objects of other namespaces are kept.
+func TestDeleteThirdPartyNamespaceData(t *testing.T) {
+	master, etcdserver, server, assert := initThirdParty(t, "v1")
+	server.Close()
+	defer etcdserver.Terminate(t)
+
+	for _, namespace := range []string{"default", "other"} {
+		obj := Foo{
+			ObjectMeta: api.ObjectMeta{Name: "test"},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo"},
+		}
+		if !assert.NoError(storeThirdPartyObject(master.thirdPartyStorage, "/ThirdPartyResourceData/company.com/foos/"+namespace+"/test", "test", obj)) {
+			t.FailNow()
+		}
+	}
+
+	if !assert.NoError(master.deleteThirdPartyNamespaceData(&api.Namespace{ObjectMeta: api.ObjectMeta{Name: "other"}})) {
+		t.FailNow()
+	}
+
+	thirdPartyObj := extensions.ThirdPartyResourceData{}
+	err := master.thirdPartyStorage.Get(context.TODO(), etcdtest.AddPrefix("/ThirdPartyResourceData/company.com/foos/other/test"), &thirdPartyObj, false)
+	assert.True(storage.IsNotFound(err), "expected the object in the deleted namespace to be deleted: %v", err)
+	err = master.thirdPartyStorage.Get(context.TODO(), etcdtest.AddPrefix("/ThirdPartyResourceData/company.com/foos/default/test"), &thirdPartyObj, false)
+	assert.NoError(err)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+)
+
+// thirdPartyListPages wraps handler so that lists of third party objects can be
+// read in pages, with the limit and continue query parameters. The page is passed
+// to the third party storage in the context of the request.
+func (m *Master) thirdPartyListPages(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		limit, cont := query.Get("limit"), query.Get("continue")
+		if len(limit) == 0 && len(cont) == 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info, err := m.requestInfo(req)
+		if err != nil || info.Verb != "list" || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		page := thirdpartyresourcedata.ListPage{Continue: cont}
+		if len(limit) > 0 {
+			if page.Limit, err = strconv.ParseInt(limit, 10, 64); err != nil || page.Limit <= 0 {
+				http.Error(w, fmt.Sprintf("invalid limit %q: must be a positive integer", limit), http.StatusBadRequest)
+				return
+			}
+		}
+		if ctx, ok := m.requestContextMapper.Get(req); ok {
+			m.requestContextMapper.Update(req, thirdpartyresourcedata.WithListPage(ctx, page))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// TestThirdPartyListPages verifies that a list of third party objects can be read
+// in pages with the limit and continue query parameters, that every page reports
+// the resource version of the first one, and that the next pages are refused once
+// the list changed.
+func TestThirdPartyListPages(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	handler, err := api.NewRequestContextFilter(master.requestContextMapper, master.thirdPartyListPages(master.handlerContainer.ServeMux))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	create := func(name string) {
+		data, err := json.Marshal(Foo{
+			ObjectMeta: api.ObjectMeta{Name: name},
+			TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		})
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		resp.Body.Close()
+		assert.Equal(http.StatusCreated, resp.StatusCode)
+	}
+	for _, name := range []string{"e", "c", "a", "d", "b"} {
+		create(name)
+	}
+
+	list := func(query url.Values) (*http.Response, FooList) {
+		resp, err := http.Get(server.URL + "/apis/company.com/v1/namespaces/default/foos?" + query.Encode())
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		page := FooList{}
+		if resp.StatusCode == http.StatusOK {
+			assert.NoError(decodeResponse(resp, &page))
+		}
+		return resp, page
+	}
+
+	names := []string{}
+	query := url.Values{"limit": {"2"}}
+	resourceVersion := ""
+	for pages := 0; ; pages++ {
+		if pages == 3 {
+			t.Fatalf("expected three pages, got more")
+		}
+		resp, page := list(query)
+		if !assert.Equal(http.StatusOK, resp.StatusCode) {
+			t.FailNow()
+		}
+		if pages == 0 {
+			resourceVersion = page.ResourceVersion
+		}
+		assert.Equal(resourceVersion, page.ResourceVersion)
+		for _, item := range page.Items {
+			names = append(names, item.Name)
+		}
+		if len(page.Continue) == 0 {
+			break
+		}
+		assert.Len(page.Items, 2)
+		query.Set("continue", page.Continue)
+	}
+	assert.Equal([]string{"a", "b", "c", "d", "e"}, names)
+
+	// Lists without limit aren't paginated.
+	_, page := list(url.Values{})
+	assert.Len(page.Items, 5)
+	assert.Empty(page.Continue)
+
+	for _, query := range []url.Values{{"limit": {"0"}}, {"limit": {"two"}}, {"continue": {"not a token"}}} {
+		resp, _ := list(query)
+		resp.Body.Close()
+		assert.Equal(http.StatusBadRequest, resp.StatusCode, "query %v", query)
+	}
+
+	// The next pages are refused once an object they would hold is created.
+	_, page = list(url.Values{"limit": {"2"}})
+	create("bb")
+	resp, _ := list(url.Values{"limit": {"2"}, "continue": {page.Continue}})
+	resp.Body.Close()
+	assert.Equal(http.StatusGone, resp.StatusCode)
+}
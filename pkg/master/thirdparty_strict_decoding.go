@@ -0,0 +1,78 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+)
+
+// thirdPartyStrictDecoding wraps handler so that the creations and updates
+// of third party objects whose metadata has fields that object metadata
+// doesn't have fail with a 422 Unprocessable Entity, if strict third party
+// decoding is on. The rest of a third party object is not checked, as a
+// ThirdPartyResource has no schema to check it against.
+func (m *Master) thirdPartyStrictDecoding(handler http.Handler) http.Handler {
+	if !m.strictThirdPartyDecoding {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := m.requestInfo(req)
+		if err != nil || (info.Verb != "create" && info.Verb != "update") || len(info.Subresource) > 0 || !m.HasThirdPartyResourcePath(makeThirdPartyPath(info.APIGroup)) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			writeStatusError(w, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		object := struct {
+			Kind     string          `json:"kind"`
+			Metadata json.RawMessage `json:"metadata"`
+		}{}
+		// Malformed objects are reported by handler.
+		if err := json.Unmarshal(body, &object); err != nil || len(object.Metadata) == 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		meta := v1.ObjectMeta{}
+		unknown, err := runtime.UnknownFields(object.Metadata, &meta)
+		if err != nil || len(unknown) == 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		json.Unmarshal(object.Metadata, &meta)
+		errs := field.ErrorList{}
+		for _, path := range unknown {
+			name := path[strings.LastIndexAny(path, ".[")+1:]
+			errs = append(errs, &field.Error{Type: field.ErrorTypeInvalid, Field: "metadata." + path, BadValue: strings.TrimSuffix(name, "]"), Detail: "unknown field"})
+		}
+		writeStatusError(w, apierrors.NewInvalid(object.Kind, meta.Name, errs))
+	})
+}
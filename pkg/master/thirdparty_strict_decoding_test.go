@@ -0,0 +1,79 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// TestThirdPartyStrictDecoding verifies that third party objects with unknown
+// metadata fields are refused when strict third party decoding is on, and that
+// the rest of their data is still opaque.
+func TestThirdPartyStrictDecoding(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	post := func(handler http.Handler, body string) *http.Response {
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBufferString(body))
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		return resp
+	}
+
+	// Off by default.
+	resp := post(master.thirdPartyStrictDecoding(master.handlerContainer.ServeMux), `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"a","lables":{"app":"a"}}}`)
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	master.strictThirdPartyDecoding = true
+	handler := master.thirdPartyStrictDecoding(master.handlerContainer.ServeMux)
+
+	resp = post(handler, `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"b","lables":{"app":"b"}}}`)
+	assert.Equal(apierrors.StatusUnprocessableEntity, resp.StatusCode)
+	status := unversioned.Status{}
+	assert.NoError(decodeResponse(resp, &status))
+	if assert.NotNil(status.Details) && assert.Len(status.Details.Causes, 1) {
+		assert.Equal("metadata.lables", status.Details.Causes[0].Field)
+	}
+	assert.True(strings.Contains(status.Message, `Foo "b" is invalid`), "unexpected message: %s", status.Message)
+
+	resp = post(handler, `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"c","labels":{"app":"c"}},"someFeild":"value"}`)
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+}
+
+// TestStrictDecodingConfig verifies that strict decoding is turned on for the
+// built-in API groups by the master's config.
+func TestStrictDecodingConfig(t *testing.T) {
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	assert.False(master.api_v1().StrictDecoding)
+	master.strictDecoding = true
+	assert.True(master.api_v1().StrictDecoding)
+}
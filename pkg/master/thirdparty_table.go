@@ -0,0 +1,89 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+)
+
+// tableContentType is the content type of the table output of third party objects.
+const tableContentType = "application/json;as=Table"
+
+// thirdPartyTables wraps handler so that the gets and lists of third party objects
+// whose Accept header asks for application/json;as=Table are answered with the
+// table of the objects, including the additional printer columns of their
+// resource.
+func (m *Master) thirdPartyTables(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !acceptsTable(req.Header.Get("Accept")) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		info, err := m.requestInfo(req)
+		if err != nil || (info.Verb != "get" && info.Verb != "list") || len(info.Subresource) > 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		storage, found := m.thirdPartyResourceStorage(makeThirdPartyPath(info.APIGroup))
+		if !found {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		response := newBufferedResponse(w)
+		handler.ServeHTTP(response, req)
+
+		if response.code != http.StatusOK {
+			copyHeader(w.Header(), response.header)
+			w.WriteHeader(response.code)
+			w.Write(response.body.Bytes())
+			return
+		}
+		table, err := thirdpartyresourcedata.NewTable(response.body.Bytes(), storage.Columns, time.Now())
+		if err != nil {
+			writeStatusError(w, apierrors.NewInternalError(err))
+			return
+		}
+		data, err := json.Marshal(table)
+		if err != nil {
+			writeStatusError(w, apierrors.NewInternalError(err))
+			return
+		}
+		copyHeader(w.Header(), response.header)
+		w.Header().Del("Content-Length")
+		w.Header().Set("Content-Type", tableContentType)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	})
+}
+
+// acceptsTable returns true if an Accept header lists application/json;as=Table.
+func acceptsTable(accept string) bool {
+	for _, value := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(value)
+		if err == nil && mediaType == "application/json" && params["as"] == "Table" {
+			return true
+		}
+	}
+	return false
+}
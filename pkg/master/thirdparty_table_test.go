@@ -0,0 +1,120 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+)
+
+// TestThirdPartyTables verifies that gets and lists of third party objects are
+// answered with a table, with the additional printer columns of their resource,
+// only when the client asks for one.
+func TestThirdPartyTables(t *testing.T) {
+	master, etcdserver, thirdPartyServer, assert := initThirdParty(t, "v1")
+	thirdPartyServer.Close()
+	defer etcdserver.Terminate(t)
+
+	storage, found := master.thirdPartyResourceStorage(makeThirdPartyPath("company.com"))
+	if !assert.True(found) {
+		t.FailNow()
+	}
+	storage.Columns = []extensions.ThirdPartyResourceColumn{{Name: "Some Field", JSONPath: ".someField"}}
+	server := httptest.NewServer(master.thirdPartyTables(master.handlerContainer.ServeMux))
+	defer server.Close()
+
+	data, err := json.Marshal(Foo{
+		ObjectMeta: api.ObjectMeta{Name: "test"},
+		TypeMeta:   unversioned.TypeMeta{Kind: "Foo", APIVersion: "company.com/v1"},
+		SomeField:  "test field",
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp, err := http.Post(server.URL+"/apis/company.com/v1/namespaces/default/foos", "application/json", bytes.NewBuffer(data))
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusCreated, resp.StatusCode)
+
+	get := func(path, accept string) *http.Response {
+		req, err := http.NewRequest("GET", server.URL+path, nil)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		if !assert.NoError(err) {
+			t.FailNow()
+		}
+		return resp
+	}
+
+	for _, path := range []string{"/apis/company.com/v1/namespaces/default/foos/test", "/apis/company.com/v1/namespaces/default/foos", "/apis/company.com/v1/foos"} {
+		resp := get(path, "application/json;as=Table, application/json")
+		assert.Equal(http.StatusOK, resp.StatusCode, path)
+		assert.Equal(tableContentType, resp.Header.Get("Content-Type"), path)
+		table := thirdpartyresourcedata.Table{}
+		assert.NoError(decodeResponse(resp, &table))
+		assert.Equal("Table", table.Kind, path)
+		if assert.Len(table.ColumnDefinitions, 3, path) {
+			assert.Equal("Some Field", table.ColumnDefinitions[2].Name)
+		}
+		if assert.Len(table.Rows, 1, path) {
+			cells := table.Rows[0].Cells
+			assert.Equal("test", cells[0], path)
+			assert.Equal("test field", cells[2], path)
+		}
+	}
+
+	// Other clients keep getting the objects.
+	resp = get("/apis/company.com/v1/namespaces/default/foos/test", "application/json")
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	item := Foo{}
+	assert.NoError(decodeResponse(resp, &item))
+	assert.Equal("test field", item.SomeField)
+
+	// Errors are not converted.
+	resp = get("/apis/company.com/v1/namespaces/default/foos/missing", "application/json;as=Table")
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+// TestAcceptsTable verifies the Accept headers that ask for table output.
+func TestAcceptsTable(t *testing.T) {
+	for accept, expected := range map[string]bool{
+		"application/json;as=Table":                   true,
+		"application/json; as=Table; v=v1":            true,
+		"application/yaml, application/json;as=Table": true,
+		"application/json":                            false,
+		"application/json;as=PartialObjectMetadata":   false,
+		"": false,
+	} {
+		if actual := acceptsTable(accept); actual != expected {
+			t.Errorf("%q: expected %v, got %v", accept, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/util/httpstream"
+)
+
+// withRequestTimeout wraps handler so that requests running for longer than the
+// master's max request timeout are answered with 504 Gateway Timeout. Their
+// context expires at the same time, so that the etcd reads they are blocked on are
+// cancelled and no more writes are started for them; a write already sent to etcd
+// is waited for, so the handler may run on briefly after the 504. Long running
+// requests are exempt.
+func (m *Master) withRequestTimeout(handler http.Handler) http.Handler {
+	if m.maxRequestTimeout <= 0 {
+		return handler
+	}
+	withDeadline := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if ctx, ok := m.requestContextMapper.Get(req); ok {
+			ctx, cancel := api.WithTimeout(ctx, m.maxRequestTimeout)
+			defer cancel()
+			m.requestContextMapper.Update(req, ctx)
+		}
+		handler.ServeHTTP(w, req)
+	})
+	timeoutHandler := apiserver.TimeoutHandler(withDeadline, func(*http.Request) (<-chan time.Time, string) {
+		return time.After(m.maxRequestTimeout), ""
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if m.isLongRunningRequest(req) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		timeoutHandler.ServeHTTP(w, req)
+	})
+}
+
+// withCancelOnDisconnect wraps handler so that the context of a request is
+// cancelled when its client disconnects, so that the etcd reads made for it are
+// cancelled instead of running on for nobody, and no write is started for it.
+// The disconnect is only notified once, so handler is given a response writer
+// whose CloseNotify reports the one waited for here, e.g. to end a watch.
+// Upgraded connections are left alone, their handlers take over the connection.
+func (m *Master) withCancelOnDisconnect(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		notifier, ok := w.(http.CloseNotifier)
+		if !ok || httpstream.IsUpgradeRequest(req) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		ctx, ok := m.requestContextMapper.Get(req)
+		if !ok {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		ctx, cancel := api.WithCancel(ctx)
+		defer cancel()
+		m.requestContextMapper.Update(req, ctx)
+
+		done := make(chan struct{})
+		defer close(done)
+		closed := notifier.CloseNotify()
+		disconnected := make(chan bool)
+		go func() {
+			select {
+			case <-closed:
+				close(disconnected)
+				cancel()
+			case <-done:
+			}
+		}()
+		handler.ServeHTTP(&disconnectResponseWriter{delegatingResponseWriter: delegatingResponseWriter{w}, disconnected: disconnected}, req)
+	})
+}
+
+// disconnectResponseWriter is an http.ResponseWriter whose CloseNotify returns a
+// channel that is closed when the client disconnects, so that it can be waited
+// for any number of times.
+type disconnectResponseWriter struct {
+	delegatingResponseWriter
+	disconnected <-chan bool
+}
+
+func (w *disconnectResponseWriter) CloseNotify() <-chan bool {
+	return w.disconnected
+}
+
+// isLongRunningRequest returns true if req is expected to stay open for a long
+// time, i.e. it is a watch or its path matches the long running request regexp.
+func (m *Master) isLongRunningRequest(req *http.Request) bool {
+	if req.URL.Query().Get("watch") == "true" {
+		return true
+	}
+	return m.longRunningRequestRE != nil && m.longRunningRequestRE.MatchString(req.URL.Path)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	thirdpartyresourcedatastorage "k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
+	"k8s.io/kubernetes/pkg/storage/etcd/etcdtest"
+	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+	"k8s.io/kubernetes/pkg/util"
+)
+
+// TestWithRequestTimeout verifies that requests running longer than the max
+// request timeout are answered with 504 and have their context cancelled, while
+// long running requests are left alone.
+func TestWithRequestTimeout(t *testing.T) {
+	m := &Master{
+		maxRequestTimeout:    50 * time.Millisecond,
+		longRunningRequestRE: regexp.MustCompile(DefaultLongRunningRequestRE),
+		requestContextMapper: api.NewRequestContextMapper(),
+	}
+	cancelled := make(chan bool, 1)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx, ok := m.requestContextMapper.Get(req)
+		if !ok {
+			t.Errorf("no context for %s", req.URL)
+			return
+		}
+		if req.URL.Query().Get("wait") == "true" {
+			select {
+			case <-ctx.Done():
+				cancelled <- true
+				return
+			case <-time.After(200 * time.Millisecond):
+			}
+		}
+		cancelled <- false
+		w.WriteHeader(http.StatusOK)
+	})
+	filter, err := api.NewRequestContextFilter(m.requestContextMapper, m.withRequestTimeout(handler))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(filter)
+	defer server.Close()
+
+	table := []struct {
+		path         string
+		expectedCode int
+		cancelled    bool
+	}{
+		{"/api/v1/pods", http.StatusOK, false},
+		{"/api/v1/pods?wait=true", http.StatusGatewayTimeout, true},
+		{"/api/v1/pods?watch=true&wait=true", http.StatusOK, false},
+		{"/api/v1/watch/pods?wait=true", http.StatusOK, false},
+	}
+	for _, item := range table {
+		resp, err := http.Get(server.URL + item.path)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", item.path, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != item.expectedCode {
+			t.Errorf("%s: expected status %d, got %d", item.path, item.expectedCode, resp.StatusCode)
+		}
+		if c := <-cancelled; c != item.cancelled {
+			t.Errorf("%s: expected cancelled %v, got %v", item.path, item.cancelled, c)
+		}
+	}
+}
+
+// TestWithRequestTimeoutCancelsEtcdReads verifies that the etcd reads of the
+// requests that time out are cancelled.
+func TestWithRequestTimeoutCancelsEtcdReads(t *testing.T) {
+	m := &Master{
+		maxRequestTimeout:    50 * time.Millisecond,
+		requestContextMapper: api.NewRequestContextMapper(),
+	}
+	hanging := etcdtesting.NewHangingEtcdServer()
+	helper := etcdstorage.NewEtcdStorage(hanging.Client, testapi.Default.Codec(), etcdtest.PathPrefix())
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx, _ := m.requestContextMapper.Get(req)
+		helper.Get(ctx, "/pods/default/foo", &api.Pod{}, false)
+	})
+	filter, err := api.NewRequestContextFilter(m.requestContextMapper, m.withRequestTimeout(handler))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(filter)
+	defer server.Close()
+	defer hanging.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/namespaces/default/pods/foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		t.Errorf("expected status %d, got %d", http.StatusGatewayTimeout, resp.StatusCode)
+	}
+	select {
+	case <-hanging.Cancelled:
+	case <-time.After(util.ForeverTestTimeout):
+		t.Errorf("expected the etcd read to be cancelled")
+	}
+}
+
+// TestWithCancelOnDisconnect verifies that the etcd reads made for core and third
+// party requests are cancelled when their client disconnects.
+func TestWithCancelOnDisconnect(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	hanging := etcdtesting.NewHangingEtcdServer()
+	config.StorageDestinations.AddAPIResource("", "pods", etcdstorage.NewEtcdStorage(hanging.Client, testapi.Default.Codec(), etcdtest.PathPrefix()))
+	master.apiPrefix = "/api"
+	master.init(&config)
+
+	master.thirdPartyStorage = etcdstorage.NewEtcdStorage(hanging.Client, testapi.Extensions.Codec(), etcdtest.PathPrefix())
+	master.thirdPartyResources = map[string]*thirdpartyresourcedatastorage.REST{}
+	err := master.InstallThirdPartyResource(&extensions.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "foo.company.com"},
+		Versions:   []extensions.APIVersion{{APIGroup: "group", Name: "v1"}},
+	})
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+	// The reads that were not cancelled are released before the server is closed.
+	defer hanging.Close()
+
+	client := &http.Client{Timeout: 100 * time.Millisecond}
+	for path, key := range map[string]string{
+		"/api/v1/namespaces/default/pods":              "/pods/default",
+		"/apis/company.com/v1/namespaces/default/foos": "/ThirdPartyResourceData/company.com/foos/default",
+	} {
+		if _, err := client.Get(server.URL + path); err == nil {
+			t.Errorf("%s: expected the client to give up", path)
+		}
+		select {
+		case cancelled := <-hanging.Cancelled:
+			assert.Equal("/v2/keys"+etcdtest.AddPrefix(key), cancelled, path)
+		case <-time.After(util.ForeverTestTimeout):
+			t.Errorf("%s: expected the etcd read to be cancelled", path)
+		}
+	}
+}
+
+// TestWithCancelOnDisconnectNotifiesHandler verifies that a handler waiting for
+// its client to disconnect, like a watch between two events, is still notified.
+func TestWithCancelOnDisconnectNotifiesHandler(t *testing.T) {
+	m := &Master{requestContextMapper: api.NewRequestContextMapper()}
+	served := make(chan struct{})
+	handler, err := api.NewRequestContextFilter(m.requestContextMapper, m.withCancelOnDisconnect(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer close(served)
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case <-w.(http.CloseNotifier).CloseNotify():
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	select {
+	case <-served:
+	case <-time.After(util.ForeverTestTimeout):
+		t.Errorf("expected the handler to be notified of the disconnect")
+	}
+}
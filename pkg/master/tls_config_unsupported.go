@@ -0,0 +1,48 @@
+// +build !go1.8
+
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import "crypto/tls"
+
+// cloneTLSConfig returns a copy of config, which can be changed without changing
+// config. The Go releases before 1.8 can't clone a tls.Config, so only the fields
+// that all of them have are copied.
+func cloneTLSConfig(config *tls.Config) *tls.Config {
+	return &tls.Config{
+		Rand:                     config.Rand,
+		Time:                     config.Time,
+		Certificates:             config.Certificates,
+		NameToCertificate:        config.NameToCertificate,
+		GetCertificate:           config.GetCertificate,
+		RootCAs:                  config.RootCAs,
+		NextProtos:               config.NextProtos,
+		ServerName:               config.ServerName,
+		ClientAuth:               config.ClientAuth,
+		ClientCAs:                config.ClientCAs,
+		InsecureSkipVerify:       config.InsecureSkipVerify,
+		CipherSuites:             config.CipherSuites,
+		PreferServerCipherSuites: config.PreferServerCipherSuites,
+		SessionTicketsDisabled:   config.SessionTicketsDisabled,
+		SessionTicketKey:         config.SessionTicketKey,
+		ClientSessionCache:       config.ClientSessionCache,
+		MinVersion:               config.MinVersion,
+		MaxVersion:               config.MaxVersion,
+		CurvePreferences:         config.CurvePreferences,
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"golang.org/x/net/context"
+)
+
+// Tracer starts the spans that trace the requests served by the master. It can
+// be implemented on top of any distributed tracing library.
+type Tracer interface {
+	// StartSpan starts a span named operationName. parent is nil for the span of
+	// a request, and the span of the request for the spans of its storage calls.
+	StartSpan(operationName string, parent Span) Span
+}
+
+// Span is an operation traced by a Tracer.
+type Span interface {
+	// SetTag annotates the span with a key and value.
+	SetTag(key string, value interface{})
+	// Finish ends the span.
+	Finish()
+}
+
+// spanKey is the type of the context key of the span of a request.
+type spanKey int
+
+// requestSpanKey is the context key of the span of a request.
+const requestSpanKey spanKey = 0
+
+// spanFrom returns the span of the request ctx belongs to, if it is traced.
+func spanFrom(ctx context.Context) (Span, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	span, ok := ctx.Value(requestSpanKey).(Span)
+	return span, ok
+}
+
+// withTracing wraps handler so that every request is traced by a span of the
+// master's tracer, which the storage calls made for the request are children of.
+func (m *Master) withTracing(handler http.Handler) http.Handler {
+	if m.tracer == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		span := m.tracer.StartSpan(req.Method+" "+req.URL.Path, nil)
+		defer span.Finish()
+		if ctx, ok := m.requestContextMapper.Get(req); ok {
+			if requestID, ok := api.RequestIDFrom(ctx); ok {
+				span.SetTag("requestID", requestID)
+			}
+			m.requestContextMapper.Update(req, api.WithValue(ctx, requestSpanKey, span))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// tracedStorage is a storage.Interface that traces the calls made for a traced
+// request by a child span of the request's span. Watches are not traced.
+type tracedStorage struct {
+	storage.Interface
+	tracer Tracer
+	// resource is the resource stored, e.g. pods, set as a tag of the spans.
+	resource string
+}
+
+// newTracedStorage returns s, tracing the calls made to it with tracer if it is not nil.
+func newTracedStorage(s storage.Interface, tracer Tracer, resource string) storage.Interface {
+	if tracer == nil {
+		return s
+	}
+	return &tracedStorage{Interface: s, tracer: tracer, resource: resource}
+}
+
+// trace starts a span of the operation on key if ctx belongs to a traced request.
+// The returned function finishes it, tagging it with err.
+func (s *tracedStorage) trace(ctx context.Context, operation, key string) func(err error) {
+	parent, ok := spanFrom(ctx)
+	if !ok {
+		return func(error) {}
+	}
+	span := s.tracer.StartSpan("storage "+operation, parent)
+	span.SetTag("resource", s.resource)
+	span.SetTag("key", key)
+	return func(err error) {
+		if err != nil {
+			span.SetTag("error", err.Error())
+		}
+		span.Finish()
+	}
+}
+
+func (s *tracedStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	finish := s.trace(ctx, "Create", key)
+	err := s.Interface.Create(ctx, key, obj, out, ttl)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) Set(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	finish := s.trace(ctx, "Set", key)
+	err := s.Interface.Set(ctx, key, obj, out, ttl)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) Delete(ctx context.Context, key string, out runtime.Object) error {
+	finish := s.trace(ctx, "Delete", key)
+	err := s.Interface.Delete(ctx, key, out)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	finish := s.trace(ctx, "Get", key)
+	err := s.Interface.Get(ctx, key, objPtr, ignoreNotFound)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) GetToList(ctx context.Context, key string, filter storage.FilterFunc, listObj runtime.Object) error {
+	finish := s.trace(ctx, "GetToList", key)
+	err := s.Interface.GetToList(ctx, key, filter, listObj)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) List(ctx context.Context, key string, resourceVersion string, filter storage.FilterFunc, listObj runtime.Object) error {
+	finish := s.trace(ctx, "List", key)
+	err := s.Interface.List(ctx, key, resourceVersion, filter, listObj)
+	finish(err)
+	return err
+}
+
+func (s *tracedStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
+	finish := s.trace(ctx, "GuaranteedUpdate", key)
+	err := s.Interface.GuaranteedUpdate(ctx, key, ptrToType, ignoreNotFound, tryUpdate)
+	finish(err)
+	return err
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// testSpan is a Span recording its tags and whether it is finished.
+type testSpan struct {
+	name     string
+	parent   *testSpan
+	tags     map[string]interface{}
+	finished bool
+}
+
+func (s *testSpan) SetTag(key string, value interface{}) {
+	s.tags[key] = value
+}
+
+func (s *testSpan) Finish() {
+	s.finished = true
+}
+
+// testTracer is a Tracer recording the spans it starts.
+type testTracer struct {
+	lock  sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) StartSpan(operationName string, parent Span) Span {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	span := &testSpan{name: operationName, tags: map[string]interface{}{}}
+	if parent != nil {
+		span.parent = parent.(*testSpan)
+	}
+	t.spans = append(t.spans, span)
+	return span
+}
+
+// TestTracing verifies that a request is traced by a span, and that the storage
+// calls made for it are traced by child spans.
+func TestTracing(t *testing.T) {
+	master, etcdserver, config, assert := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	tracer := &testTracer{}
+	config.Tracer = tracer
+	master.tracer = tracer
+	master.apiPrefix = "/api"
+
+	master.init(&config)
+	server := httptest.NewServer(master.InsecureHandler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/namespaces/default/pods")
+	if !assert.NoError(err) {
+		t.FailNow()
+	}
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	tracer.lock.Lock()
+	defer tracer.lock.Unlock()
+	if !assert.Len(tracer.spans, 2) {
+		t.FailNow()
+	}
+	request, list := tracer.spans[0], tracer.spans[1]
+	assert.Equal("GET /api/v1/namespaces/default/pods", request.name)
+	assert.Nil(request.parent)
+	assert.True(request.finished)
+	assert.Equal("storage List", list.name)
+	assert.True(list.parent == request)
+	assert.Equal("pods", list.tags["resource"])
+	assert.True(list.finished)
+}
+
+// TestTracedStorageUntracedContext verifies that the storage calls made outside
+// of a traced request are not traced.
+func TestTracedStorageUntracedContext(t *testing.T) {
+	_, etcdserver, config, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+
+	tracer := &testTracer{}
+	s := newTracedStorage(config.StorageDestinations.Get("", "pods"), tracer, "pods")
+	err := s.Get(api.NewDefaultContext(), "/pods/default/foo", &api.Pod{}, true)
+	assert.NoError(err)
+	assert.Empty(tracer.spans)
+
+	assert.True(newTracedStorage(s, nil, "pods") == s)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package master
 
 import (
+	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"net"
@@ -38,6 +39,9 @@ type Tunneler interface {
 	Stop()
 	Dial(net, addr string) (net.Conn, error)
 	SecondsSinceSync() int64
+	// Healthy returns an error if the tunnels were last synced successfully
+	// more than maxLag ago.
+	Healthy(maxLag time.Duration) error
 }
 
 type SSHTunneler struct {
@@ -135,6 +139,21 @@ func (c *SSHTunneler) SecondsSinceSync() int64 {
 	return now - then
 }
 
+func (c *SSHTunneler) Healthy(maxLag time.Duration) error {
+	lag := c.SecondsSinceSync()
+	if lag > int64(maxLag.Seconds()) {
+		return fmt.Errorf("Tunnel sync is taking to long: %d", lag)
+	}
+	return nil
+}
+
+// HasTunnel returns true if a tunnel to addr is open.
+func (c *SSHTunneler) HasTunnel(addr string) bool {
+	c.tunnelsLock.Lock()
+	defer c.tunnelsLock.Unlock()
+	return c.tunnels != nil && c.tunnels.Has(addr)
+}
+
 func (c *SSHTunneler) needToReplaceTunnels(addrs []string) bool {
 	c.tunnelsLock.Lock()
 	defer c.tunnelsLock.Unlock()
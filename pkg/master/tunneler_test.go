@@ -86,6 +86,7 @@ func TestIsTunnelSyncHealthy(t *testing.T) {
 	master, etcdserver, _, assert := setUp(t)
 	defer etcdserver.Terminate(t)
 	master.tunneler = tunneler
+	master.tunnelSyncHealthThreshold = DefaultTunnelSyncHealthThreshold
 
 	// Pass case: 540 second lag
 	tunneler.lastSync = time.Date(2015, time.January, 1, 1, 1, 1, 1, time.UTC).Unix()
@@ -99,6 +100,25 @@ func TestIsTunnelSyncHealthy(t *testing.T) {
 	assert.Error(err, "IsTunnelSyncHealthy() should have returned an error.")
 }
 
+// TestIsTunnelSyncHealthyThreshold verifies that a custom tunnel sync health
+// threshold is honored.
+func TestIsTunnelSyncHealthyThreshold(t *testing.T) {
+	tunneler := &SSHTunneler{}
+	master, etcdserver, _, assert := setUp(t)
+	defer etcdserver.Terminate(t)
+	master.tunneler = tunneler
+	master.tunnelSyncHealthThreshold = 2 * time.Minute
+
+	// Pass case: 60 second lag
+	tunneler.lastSync = time.Date(2015, time.January, 1, 1, 1, 1, 1, time.UTC).Unix()
+	tunneler.clock = &util.FakeClock{Time: time.Date(2015, time.January, 1, 1, 2, 1, 1, time.UTC)}
+	assert.NoError(master.IsTunnelSyncHealthy(nil), "IsTunnelSyncHealthy() should not have returned an error.")
+
+	// Fail case: 180 second lag
+	tunneler.clock = &util.FakeClock{Time: time.Date(2015, time.January, 1, 1, 4, 1, 1, time.UTC)}
+	assert.Error(master.IsTunnelSyncHealthy(nil), "IsTunnelSyncHealthy() should have returned an error.")
+}
+
 // generateTempFile creates a temporary file path
 func generateTempFilePath(prefix string) string {
 	tmpPath, _ := filepath.Abs(fmt.Sprintf("%s/%s-%d", os.TempDir(), prefix, time.Now().Unix()))
@@ -0,0 +1,392 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"k8s.io/kubernetes/pkg/admission"
+	"k8s.io/kubernetes/pkg/api"
+	apiutil "k8s.io/kubernetes/pkg/api/util"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/registry/service/ipallocator"
+	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// minServiceClusterIPRangeSize is the smallest number of addresses a service
+// cluster IP range may have.
+const minServiceClusterIPRangeSize = 8
+
+// maxServiceClusterIPRangeBits is the largest number of host bits a service
+// cluster IP range may have, as the IP allocator can't allocate from larger ones.
+const maxServiceClusterIPRangeBits = 30
+
+// InvalidConfigError is returned by New when a field of the config can't be
+// used to create a master.
+type InvalidConfigError struct {
+	// Field is the name of the invalid Config field.
+	Field string
+	Err   error
+}
+
+func (e *InvalidConfigError) Error() string {
+	return fmt.Sprintf("invalid master config %s: %v", e.Field, e.Err)
+}
+
+// IsInvalidConfig returns true if err was returned by New for an invalid config.
+func IsInvalidConfig(err error) bool {
+	_, ok := err.(*InvalidConfigError)
+	return ok
+}
+
+// validateConfig checks the fields of c that have no sensible default, and
+// normalizes the API prefixes.
+func validateConfig(c *Config) error {
+	if c.KubeletClient == nil {
+		return &InvalidConfigError{"KubeletClient", errors.New("must be specified")}
+	}
+	if c.PublicAddress == nil {
+		return &InvalidConfigError{"PublicAddress", errors.New("must be specified")}
+	}
+	if c.StorageDestinations.Default == nil {
+		// The legacy API group has no name, and is always installed.
+		if group, ok := c.StorageDestinations.APIGroups[api.GroupName]; !ok || group.Default == nil {
+			return &InvalidConfigError{"StorageDestinations", errors.New("must include a destination for the legacy API group")}
+		}
+	}
+	for group, destinations := range c.StorageDestinations.APIGroups {
+		if limit := destinations.RateLimit; limit != nil && (limit.QPS <= 0 || limit.Burst <= 0 || limit.MaxWait < 0) {
+			return &InvalidConfigError{"StorageDestinations", fmt.Errorf("invalid rate limit %+v of group %q: the QPS and burst must be positive, and the maximum wait not negative", *limit, group)}
+		}
+	}
+	if c.ServiceClusterIPRange != nil {
+		if ones, bits := c.ServiceClusterIPRange.Mask.Size(); bits == 0 || bits-ones > maxServiceClusterIPRangeBits {
+			return &InvalidConfigError{"ServiceClusterIPRange", fmt.Errorf("%v must have a canonical mask of at most %d host bits", c.ServiceClusterIPRange, maxServiceClusterIPRangeBits)}
+		}
+		if size := ipallocator.RangeSize(c.ServiceClusterIPRange); size < minServiceClusterIPRangeSize {
+			return &InvalidConfigError{"ServiceClusterIPRange", fmt.Errorf("%v must have at least %d IP addresses", c.ServiceClusterIPRange, minServiceClusterIPRangeSize)}
+		}
+	}
+	switch c.KubernetesServiceSessionAffinity {
+	case "", api.ServiceAffinityNone, api.ServiceAffinityClientIP:
+	default:
+		return &InvalidConfigError{"KubernetesServiceSessionAffinity", fmt.Errorf("unsupported session affinity %q", c.KubernetesServiceSessionAffinity)}
+	}
+	switch c.KubernetesServiceType {
+	case "", api.ServiceTypeNodePort:
+	case api.ServiceTypeClusterIP:
+		if c.KubernetesServiceNodePort != 0 {
+			return &InvalidConfigError{"KubernetesServiceType", fmt.Errorf("a %s service cannot have the node port %d", c.KubernetesServiceType, c.KubernetesServiceNodePort)}
+		}
+		for _, port := range c.ExtraServicePorts {
+			if port.NodePort != 0 {
+				return &InvalidConfigError{"KubernetesServiceType", fmt.Errorf("a %s service cannot have the node port %d", c.KubernetesServiceType, port.NodePort)}
+			}
+		}
+	default:
+		return &InvalidConfigError{"KubernetesServiceType", fmt.Errorf("unsupported service type %q", c.KubernetesServiceType)}
+	}
+	nodePortRange := c.ServiceNodePortRange
+	if nodePortRange.Size == 0 {
+		nodePortRange = defaultServiceNodePortRange
+	}
+	for i, port := range c.ExtraServicePorts {
+		if port.NodePort != 0 && !nodePortRange.Contains(port.NodePort) {
+			return &InvalidConfigError{fmt.Sprintf("ExtraServicePorts[%d]", i), fmt.Errorf("node port %d is not in the service node port range %v", port.NodePort, nodePortRange)}
+		}
+	}
+	if err := validateReservedServiceIPs(c); err != nil {
+		return err
+	}
+	if err := validateEndpointReconciler(c); err != nil {
+		return err
+	}
+	for name := range c.FeatureGates {
+		if _, found := featureDefaults[Feature(name)]; !found {
+			return &InvalidConfigError{"FeatureGates", fmt.Errorf("unknown feature %q, the known features are %s", name, strings.Join(knownFeatures(), ", "))}
+		}
+	}
+	switch c.NodeAddressSelection {
+	case "", NodeAddressSelectionFirst, NodeAddressSelectionFailover:
+	default:
+		return &InvalidConfigError{"NodeAddressSelection", fmt.Errorf("unknown selection %q", c.NodeAddressSelection)}
+	}
+	if c.EnableUISupport && len(c.UIAssetPath) > 0 {
+		if info, err := os.Stat(c.UIAssetPath); err != nil {
+			return &InvalidConfigError{"UIAssetPath", err}
+		} else if !info.IsDir() {
+			return &InvalidConfigError{"UIAssetPath", fmt.Errorf("%s is not a directory", c.UIAssetPath)}
+		}
+	}
+	if len(c.FaviconPath) > 0 {
+		if info, err := os.Stat(c.FaviconPath); err != nil {
+			return &InvalidConfigError{"FaviconPath", err}
+		} else if info.IsDir() {
+			return &InvalidConfigError{"FaviconPath", fmt.Errorf("%s is a directory", c.FaviconPath)}
+		}
+	}
+	for group, readVersions := range c.StorageReadVersions {
+		if _, found := c.StorageVersions[group]; !found {
+			return &InvalidConfigError{"StorageReadVersions", fmt.Errorf("group %q has read versions but no storage version", group)}
+		}
+		for _, version := range readVersions {
+			if apiutil.GetGroup(version) != group {
+				return &InvalidConfigError{"StorageReadVersions", fmt.Errorf("version %q is not in group %q", version, group)}
+			}
+		}
+	}
+	if err := validatePreinstalledThirdPartyResources(c); err != nil {
+		return err
+	}
+	for name, namespace := range c.ThirdPartyDefaultNamespaces {
+		if _, err := thirdPartyResourceKey(name); err != nil {
+			return &InvalidConfigError{"ThirdPartyDefaultNamespaces", err}
+		}
+		if !validation.IsDNS1123Label(namespace) {
+			return &InvalidConfigError{"ThirdPartyDefaultNamespaces", fmt.Errorf("%q is not a valid namespace for %s", namespace, name)}
+		}
+	}
+	if c.ThirdPartyDrainTimeout < 0 {
+		return &InvalidConfigError{"ThirdPartyDrainTimeout", fmt.Errorf("%v must not be negative", c.ThirdPartyDrainTimeout)}
+	}
+	if c.ShutdownDelay != nil && *c.ShutdownDelay < 0 {
+		return &InvalidConfigError{"ShutdownDelay", fmt.Errorf("%v must not be negative", *c.ShutdownDelay)}
+	}
+	for groupVersion, message := range c.DeprecatedAPIGroupVersions {
+		if groupVersion != "v1" && groupVersion != "extensions/v1beta1" {
+			return &InvalidConfigError{"DeprecatedAPIGroupVersions", fmt.Errorf("%q is not a built-in group version", groupVersion)}
+		}
+		if len(message) == 0 {
+			return &InvalidConfigError{"DeprecatedAPIGroupVersions", fmt.Errorf("the deprecation message of %s is empty", groupVersion)}
+		}
+	}
+	if c.AdmissionWebhook != nil {
+		if u, err := url.Parse(c.AdmissionWebhook.URL); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+			return &InvalidConfigError{"AdmissionWebhook", fmt.Errorf("%q is not an absolute HTTPS URL", c.AdmissionWebhook.URL)}
+		}
+		if c.AdmissionWebhook.Timeout < 0 {
+			return &InvalidConfigError{"AdmissionWebhook", fmt.Errorf("negative timeout %v", c.AdmissionWebhook.Timeout)}
+		}
+	}
+	if len(c.AdmissionPluginResources) > 0 {
+		if _, err := parseAdmissionPluginResources(c.AdmissionPluginResources); err != nil {
+			return &InvalidConfigError{"AdmissionPluginResources", err}
+		}
+		names := admission.PluginNames(c.AdmissionControl)
+		if c.AdmissionControl != nil && names == nil {
+			return &InvalidConfigError{"AdmissionPluginResources", errors.New("the admission control was not created from plugins")}
+		}
+		plugins := sets.NewString(names...)
+		if c.AdmissionWebhook != nil {
+			plugins.Insert(webhookPluginName)
+		}
+		for name := range c.AdmissionPluginResources {
+			if !plugins.Has(name) {
+				return &InvalidConfigError{"AdmissionPluginResources", fmt.Errorf("unknown admission plugin %q", name)}
+			}
+		}
+	}
+	switch c.DefaultContentType {
+	case "", apiserver.JSONContentType:
+	case apiserver.ProtobufContentType:
+		// None of the API types has a protobuf encoding yet, so protobuf would
+		// only ever be answered with JSON.
+		return &InvalidConfigError{"DefaultContentType", errors.New("the API types have no protobuf encoding yet")}
+	default:
+		return &InvalidConfigError{"DefaultContentType", fmt.Errorf("unsupported content type %q", c.DefaultContentType)}
+	}
+	if len(c.SelfLinkPrefix) > 0 {
+		u, err := url.Parse(c.SelfLinkPrefix)
+		if err != nil || len(u.RawQuery) > 0 || len(u.Fragment) > 0 || (len(u.Host) == 0 && !strings.HasPrefix(u.Path, "/")) || (len(u.Host) > 0 && u.Scheme != "http" && u.Scheme != "https") {
+			return &InvalidConfigError{"SelfLinkPrefix", fmt.Errorf("%q is neither an absolute path nor an HTTP or HTTPS URL", c.SelfLinkPrefix)}
+		}
+	}
+	if c.WatchCacheResyncPeriod < 0 {
+		return &InvalidConfigError{"WatchCacheResyncPeriod", fmt.Errorf("negative period %v", c.WatchCacheResyncPeriod)}
+	}
+	for resource, period := range c.WatchCacheResyncPeriods {
+		if _, err := parseGroupResource(resource); err != nil {
+			return &InvalidConfigError{"WatchCacheResyncPeriods", err}
+		}
+		if period < 0 {
+			return &InvalidConfigError{"WatchCacheResyncPeriods", fmt.Errorf("negative period %v for %s", period, resource)}
+		}
+	}
+	for _, resource := range c.WatchCacheResources {
+		if _, err := parseGroupResource(resource); err != nil {
+			return &InvalidConfigError{"WatchCacheResources", err}
+		}
+	}
+	if c.DefaultWatchCacheSize < 0 {
+		return &InvalidConfigError{"DefaultWatchCacheSize", fmt.Errorf("negative size %d", c.DefaultWatchCacheSize)}
+	}
+	for resource, size := range c.WatchCacheSizes {
+		if _, err := parseGroupResource(resource); err != nil {
+			return &InvalidConfigError{"WatchCacheSizes", err}
+		}
+		if size <= 0 {
+			return &InvalidConfigError{"WatchCacheSizes", fmt.Errorf("size %d of %s is not positive", size, resource)}
+		}
+	}
+	for resource, limit := range c.NamespaceObjectLimits {
+		if _, err := parseGroupResource(resource); err != nil {
+			return &InvalidConfigError{"NamespaceObjectLimits", err}
+		}
+		if limit < 0 {
+			return &InvalidConfigError{"NamespaceObjectLimits", fmt.Errorf("negative limit %d of %s", limit, resource)}
+		}
+	}
+	for _, method := range c.DisallowedMethods {
+		if !httpMethodRE.MatchString(method) {
+			return &InvalidConfigError{"DisallowedMethods", fmt.Errorf("invalid HTTP method %q", method)}
+		}
+	}
+	for _, cidr := range c.TrustedProxyCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return &InvalidConfigError{"TrustedProxyCIDRs", err}
+		}
+	}
+	apiPrefix, err := normalizeAPIPrefix(c.APIPrefix)
+	if err != nil {
+		return &InvalidConfigError{"APIPrefix", err}
+	}
+	c.APIPrefix = apiPrefix
+	apiGroupPrefix, err := normalizeAPIPrefix(c.APIGroupPrefix)
+	if err != nil {
+		return &InvalidConfigError{"APIGroupPrefix", err}
+	}
+	c.APIGroupPrefix = apiGroupPrefix
+	return nil
+}
+
+// validatePreinstalledThirdPartyResources checks that the preinstalled third party
+// resources can be installed, and that no two of them are served at the same path.
+func validatePreinstalledThirdPartyResources(c *Config) error {
+	if len(c.PreinstalledThirdPartyResources) == 0 {
+		return nil
+	}
+	if c.APIGroupVersionOverrides["extensions/v1beta1"].Disable {
+		return &InvalidConfigError{"PreinstalledThirdPartyResources", errors.New("requires extensions/v1beta1 to be enabled")}
+	}
+	paths := map[string]string{}
+	for ix := range c.PreinstalledThirdPartyResources {
+		rsrc := &c.PreinstalledThirdPartyResources[ix]
+		field := fmt.Sprintf("PreinstalledThirdPartyResources[%d]", ix)
+		_, group, err := thirdpartyresourcedata.ExtractApiGroupAndKind(rsrc)
+		if err != nil {
+			return &InvalidConfigError{field, err}
+		}
+		if len(rsrc.Versions) == 0 {
+			return &InvalidConfigError{field, fmt.Errorf("%s must have at least one version", rsrc.Name)}
+		}
+		path := makeThirdPartyPath(group)
+		if other, found := paths[path]; found {
+			return &InvalidConfigError{field, fmt.Errorf("%s collides with %s at %s", rsrc.Name, other, path)}
+		}
+		paths[path] = rsrc.Name
+	}
+	return nil
+}
+
+// normalizeAPIPrefix returns prefix with a single leading slash and no trailing
+// slash, e.g. "/api" for "api/", or an error if it is empty or contains spaces.
+func normalizeAPIPrefix(prefix string) (string, error) {
+	if strings.IndexFunc(prefix, unicode.IsSpace) != -1 {
+		return "", fmt.Errorf("%q must not contain spaces", prefix)
+	}
+	trimmed := strings.Trim(prefix, "/")
+	if len(trimmed) == 0 {
+		return "", fmt.Errorf("%q must not be empty", prefix)
+	}
+	return "/" + trimmed, nil
+}
+
+// validateEndpointReconciler checks the reconciliation of the kubernetes service
+// endpoints. With LeaseEndpointReconcilerType, apiservers must renew their lease
+// at least MasterLeaseGracePeriod before it expires.
+func validateEndpointReconciler(c *Config) error {
+	switch c.EndpointReconcilerType {
+	case "", MasterCountEndpointReconcilerType:
+		return nil
+	case LeaseEndpointReconcilerType:
+	default:
+		return &InvalidConfigError{"EndpointReconcilerType", fmt.Errorf("unknown endpoint reconciler type %q", c.EndpointReconcilerType)}
+	}
+	if c.MasterLeaseGracePeriod < 0 {
+		return &InvalidConfigError{"MasterLeaseGracePeriod", fmt.Errorf("%v must not be negative", c.MasterLeaseGracePeriod)}
+	}
+	gracePeriod := c.MasterLeaseGracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = DefaultMasterLeaseGracePeriod
+	}
+	reconcileInterval := DefaultReconcileInterval
+	if c.ReconcileInterval != nil {
+		reconcileInterval = *c.ReconcileInterval
+	}
+	leaseTTL := c.MasterLeaseTTL
+	if leaseTTL == 0 {
+		leaseTTL = defaultMasterLeaseTTL(reconcileInterval, gracePeriod)
+	}
+	if leaseTTL < time.Second {
+		return &InvalidConfigError{"MasterLeaseTTL", fmt.Errorf("%v is shorter than a second", leaseTTL)}
+	}
+	if reconcileInterval <= 0 || reconcileInterval >= leaseTTL {
+		return &InvalidConfigError{"ReconcileInterval", fmt.Errorf("%v must be positive and shorter than the master lease TTL %v", reconcileInterval, leaseTTL)}
+	}
+	if reconcileInterval+gracePeriod > leaseTTL {
+		return &InvalidConfigError{"MasterLeaseGracePeriod", fmt.Errorf("the master lease TTL %v leaves less than %v after the reconcile interval %v", leaseTTL, gracePeriod, reconcileInterval)}
+	}
+	return nil
+}
+
+// validateReservedServiceIPs checks that the reserved service IPs are inside the
+// service cluster IP range, and that none is the IP of the kubernetes service.
+func validateReservedServiceIPs(c *Config) error {
+	if len(c.ReservedServiceIPs) == 0 {
+		return nil
+	}
+	if c.ServiceClusterIPRange == nil {
+		return &InvalidConfigError{"ReservedServiceIPs", errors.New("requires ServiceClusterIPRange to be specified")}
+	}
+	serviceIP := c.ServiceReadWriteIP
+	if serviceIP == nil {
+		ip, err := ipallocator.GetIndexedIP(c.ServiceClusterIPRange, 1)
+		if err != nil {
+			return &InvalidConfigError{"ServiceClusterIPRange", err}
+		}
+		serviceIP = ip
+	}
+	for i, ip := range c.ReservedServiceIPs {
+		field := fmt.Sprintf("ReservedServiceIPs[%d]", i)
+		if !c.ServiceClusterIPRange.Contains(ip) {
+			return &InvalidConfigError{field, fmt.Errorf("%v is not in the service cluster IP range %v", ip, c.ServiceClusterIPRange)}
+		}
+		if ip.Equal(serviceIP) {
+			return &InvalidConfigError{field, fmt.Errorf("%v is the IP of the kubernetes service", ip)}
+		}
+	}
+	return nil
+}
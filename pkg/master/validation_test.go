@@ -0,0 +1,345 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission/webhook"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/kubelet/client"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/plugin/pkg/admission/admit"
+)
+
+// TestNewInvalidConfig verifies that New returns an InvalidConfigError naming
+// the field of a config that can't be used to create a master.
+func TestNewInvalidConfig(t *testing.T) {
+	testCases := map[string]struct {
+		modify func(*Config)
+		field  string
+	}{
+		"no kubelet client": {
+			modify: func(c *Config) { c.KubeletClient = nil },
+			field:  "KubeletClient",
+		},
+		"no public address": {
+			modify: func(c *Config) { c.PublicAddress = nil },
+			field:  "PublicAddress",
+		},
+		"no storage destinations": {
+			modify: func(c *Config) { c.StorageDestinations = NewStorageDestinations() },
+			field:  "StorageDestinations",
+		},
+		"no legacy storage destination": {
+			modify: func(c *Config) { delete(c.StorageDestinations.APIGroups, api.GroupName) },
+			field:  "StorageDestinations",
+		},
+		"storage rate limit without a burst": {
+			modify: func(c *Config) {
+				c.StorageDestinations.SetAPIGroupRateLimit(api.GroupName, StorageRateLimit{QPS: 10})
+			},
+			field: "StorageDestinations",
+		},
+		"small service cluster IP range": {
+			modify: func(c *Config) { _, c.ServiceClusterIPRange, _ = net.ParseCIDR("10.0.0.0/30") },
+			field:  "ServiceClusterIPRange",
+		},
+		"large service cluster IP range": {
+			modify: func(c *Config) { _, c.ServiceClusterIPRange, _ = net.ParseCIDR("10.0.0.0/1") },
+			field:  "ServiceClusterIPRange",
+		},
+		"large IPv6 service cluster IP range": {
+			modify: func(c *Config) { _, c.ServiceClusterIPRange, _ = net.ParseCIDR("fd00::/64") },
+			field:  "ServiceClusterIPRange",
+		},
+		"service cluster IP range with a non-canonical mask": {
+			modify: func(c *Config) {
+				c.ServiceClusterIPRange = &net.IPNet{IP: net.ParseIP("10.0.0.0").To4(), Mask: net.IPv4Mask(255, 0, 255, 0)}
+			},
+			field: "ServiceClusterIPRange",
+		},
+		"reserved service IP without a range": {
+			modify: func(c *Config) { c.ReservedServiceIPs = []net.IP{net.ParseIP("10.0.0.10")} },
+			field:  "ReservedServiceIPs",
+		},
+		"reserved service IP outside of the range": {
+			modify: func(c *Config) {
+				_, c.ServiceClusterIPRange, _ = net.ParseCIDR("10.0.0.0/24")
+				c.ReservedServiceIPs = []net.IP{net.ParseIP("10.0.0.10"), net.ParseIP("10.0.1.10")}
+			},
+			field: "ReservedServiceIPs[1]",
+		},
+		"reserved kubernetes service IP": {
+			modify: func(c *Config) {
+				_, c.ServiceClusterIPRange, _ = net.ParseCIDR("10.0.0.0/24")
+				c.ReservedServiceIPs = []net.IP{net.ParseIP("10.0.0.1")}
+			},
+			field: "ReservedServiceIPs[0]",
+		},
+		"unknown kubernetes service session affinity": {
+			modify: func(c *Config) { c.KubernetesServiceSessionAffinity = "Cookie" },
+			field:  "KubernetesServiceSessionAffinity",
+		},
+		"unknown kubernetes service type": {
+			modify: func(c *Config) { c.KubernetesServiceType = api.ServiceTypeLoadBalancer },
+			field:  "KubernetesServiceType",
+		},
+		"node port on a ClusterIP kubernetes service": {
+			modify: func(c *Config) {
+				c.KubernetesServiceType = api.ServiceTypeClusterIP
+				c.KubernetesServiceNodePort = 30001
+			},
+			field: "KubernetesServiceType",
+		},
+		"extra service node port out of range": {
+			modify: func(c *Config) {
+				c.ServiceNodePortRange = util.PortRange{Base: 30000, Size: 100}
+				c.ExtraServicePorts = []api.ServicePort{
+					{Name: "a", Port: 8080, Protocol: api.ProtocolTCP, NodePort: 30001},
+					{Name: "b", Port: 8081, Protocol: api.ProtocolTCP, NodePort: 30100},
+				}
+			},
+			field: "ExtraServicePorts[1]",
+		},
+		"extra service node port on a ClusterIP kubernetes service": {
+			modify: func(c *Config) {
+				c.KubernetesServiceType = api.ServiceTypeClusterIP
+				c.ExtraServicePorts = []api.ServicePort{{Name: "a", Port: 8080, Protocol: api.ProtocolTCP, NodePort: 30001}}
+			},
+			field: "KubernetesServiceType",
+		},
+		"unknown endpoint reconciler type": {
+			modify: func(c *Config) { c.EndpointReconcilerType = "none" },
+			field:  "EndpointReconcilerType",
+		},
+		"master lease TTL shorter than the reconcile interval": {
+			modify: func(c *Config) {
+				c.EndpointReconcilerType = LeaseEndpointReconcilerType
+				c.MasterLeaseTTL = 5 * time.Second
+			},
+			field: "ReconcileInterval",
+		},
+		"master lease TTL shorter than the reconcile interval and grace period": {
+			modify: func(c *Config) {
+				c.EndpointReconcilerType = LeaseEndpointReconcilerType
+				c.MasterLeaseTTL = 12 * time.Second
+			},
+			field: "MasterLeaseGracePeriod",
+		},
+		"negative master lease grace period": {
+			modify: func(c *Config) {
+				c.EndpointReconcilerType = LeaseEndpointReconcilerType
+				c.MasterLeaseGracePeriod = -time.Second
+			},
+			field: "MasterLeaseGracePeriod",
+		},
+		"leases never renewed": {
+			modify: func(c *Config) {
+				c.EndpointReconcilerType = LeaseEndpointReconcilerType
+				reconcileInterval := time.Duration(0)
+				c.ReconcileInterval = &reconcileInterval
+			},
+			field: "ReconcileInterval",
+		},
+		"unknown feature gate": {
+			modify: func(c *Config) { c.FeatureGates = map[string]bool{"ProblemDetails": true, "Compression": true} },
+			field:  "FeatureGates",
+		},
+		"unknown node address selection": {
+			modify: func(c *Config) { c.NodeAddressSelection = "random" },
+			field:  "NodeAddressSelection",
+		},
+		"unparsable preinstalled third party resource": {
+			modify: func(c *Config) {
+				c.PreinstalledThirdPartyResources = []extensions.ThirdPartyResource{
+					{ObjectMeta: api.ObjectMeta{Name: "foo"}, Versions: []extensions.APIVersion{{Name: "v1"}}},
+				}
+			},
+			field: "PreinstalledThirdPartyResources[0]",
+		},
+		"colliding preinstalled third party resources": {
+			modify: func(c *Config) {
+				c.PreinstalledThirdPartyResources = []extensions.ThirdPartyResource{
+					{ObjectMeta: api.ObjectMeta{Name: "foo.company.com"}, Versions: []extensions.APIVersion{{Name: "v1"}}},
+					{ObjectMeta: api.ObjectMeta{Name: "bar.company.com"}, Versions: []extensions.APIVersion{{Name: "v1"}}},
+				}
+			},
+			field: "PreinstalledThirdPartyResources[1]",
+		},
+		"unparsable third party resource with a default namespace": {
+			modify: func(c *Config) { c.ThirdPartyDefaultNamespaces = map[string]string{"foo": "default"} },
+			field:  "ThirdPartyDefaultNamespaces",
+		},
+		"invalid third party default namespace": {
+			modify: func(c *Config) {
+				c.ThirdPartyDefaultNamespaces = map[string]string{"foo.company.com": "Not_A_Namespace"}
+			},
+			field: "ThirdPartyDefaultNamespaces",
+		},
+		"deprecated group version that isn't built in": {
+			modify: func(c *Config) { c.DeprecatedAPIGroupVersions = map[string]string{"company.com/v1": "deprecated"} },
+			field:  "DeprecatedAPIGroupVersions",
+		},
+		"empty deprecation message": {
+			modify: func(c *Config) { c.DeprecatedAPIGroupVersions = map[string]string{"extensions/v1beta1": ""} },
+			field:  "DeprecatedAPIGroupVersions",
+		},
+		"relative admission webhook URL": {
+			modify: func(c *Config) { c.AdmissionWebhook = &webhook.Config{URL: "/admit"} },
+			field:  "AdmissionWebhook",
+		},
+		"plain HTTP admission webhook URL": {
+			modify: func(c *Config) { c.AdmissionWebhook = &webhook.Config{URL: "http://admission.example.com/admit"} },
+			field:  "AdmissionWebhook",
+		},
+		"negative third party drain timeout": {
+			modify: func(c *Config) { c.ThirdPartyDrainTimeout = -time.Second },
+			field:  "ThirdPartyDrainTimeout",
+		},
+		"negative admission webhook timeout": {
+			modify: func(c *Config) {
+				c.AdmissionWebhook = &webhook.Config{URL: "https://admission.example.com/admit", Timeout: -time.Second}
+			},
+			field: "AdmissionWebhook",
+		},
+		"unknown admission plugin": {
+			modify: func(c *Config) { c.AdmissionPluginResources = map[string][]string{"Unknown": {"pods"}} },
+			field:  "AdmissionPluginResources",
+		},
+		"invalid admission plugin resource": {
+			modify: func(c *Config) {
+				c.AdmissionWebhook = &webhook.Config{URL: "https://admission.example.com/admit"}
+				c.AdmissionPluginResources = map[string][]string{"Webhook": {"extensions/"}}
+			},
+			field: "AdmissionPluginResources",
+		},
+		"admission control not created from plugins": {
+			modify: func(c *Config) {
+				c.AdmissionControl = admit.NewAlwaysAdmit()
+				c.AdmissionPluginResources = map[string][]string{"AlwaysAdmit": {"pods"}}
+			},
+			field: "AdmissionPluginResources",
+		},
+		"invalid trusted proxy CIDR": {
+			modify: func(c *Config) { c.TrustedProxyCIDRs = []string{"10.0.0.0/8", "10.0.0.1"} },
+			field:  "TrustedProxyCIDRs",
+		},
+		"missing favicon": {
+			modify: func(c *Config) { c.FaviconPath = "/does/not/exist.ico" },
+			field:  "FaviconPath",
+		},
+		"negative watch cache resync period": {
+			modify: func(c *Config) { c.WatchCacheResyncPeriod = -time.Second },
+			field:  "WatchCacheResyncPeriod",
+		},
+		"invalid watch cache resync period resource": {
+			modify: func(c *Config) { c.WatchCacheResyncPeriods = map[string]time.Duration{"extensions/": time.Minute} },
+			field:  "WatchCacheResyncPeriods",
+		},
+		"negative watch cache resync period override": {
+			modify: func(c *Config) { c.WatchCacheResyncPeriods = map[string]time.Duration{"pods": -time.Minute} },
+			field:  "WatchCacheResyncPeriods",
+		},
+		"invalid watch cache resource": {
+			modify: func(c *Config) { c.WatchCacheResources = []string{"pods", "extensions/"} },
+			field:  "WatchCacheResources",
+		},
+		"negative default watch cache size": {
+			modify: func(c *Config) { c.DefaultWatchCacheSize = -1 },
+			field:  "DefaultWatchCacheSize",
+		},
+		"zero watch cache size": {
+			modify: func(c *Config) { c.WatchCacheSizes = map[string]int{"pods": 0} },
+			field:  "WatchCacheSizes",
+		},
+		"negative shutdown delay": {
+			modify: func(c *Config) {
+				shutdownDelay := -time.Second
+				c.ShutdownDelay = &shutdownDelay
+			},
+			field: "ShutdownDelay",
+		},
+		"invalid disallowed method": {
+			modify: func(c *Config) { c.DisallowedMethods = []string{"DELETE", "GET /"} },
+			field:  "DisallowedMethods",
+		},
+		"invalid namespace object limit resource": {
+			modify: func(c *Config) { c.NamespaceObjectLimits = map[string]int{"extensions/": 10} },
+			field:  "NamespaceObjectLimits",
+		},
+		"negative namespace object limit": {
+			modify: func(c *Config) { c.NamespaceObjectLimits = map[string]int{"pods": -1} },
+			field:  "NamespaceObjectLimits",
+		},
+		"unsupported default content type": {
+			modify: func(c *Config) { c.DefaultContentType = "application/yaml" },
+			field:  "DefaultContentType",
+		},
+		"protobuf default content type": {
+			modify: func(c *Config) { c.DefaultContentType = apiserver.ProtobufContentType },
+			field:  "DefaultContentType",
+		},
+		"relative self link prefix": {
+			modify: func(c *Config) { c.SelfLinkPrefix = "k8s" },
+			field:  "SelfLinkPrefix",
+		},
+		"self link prefix with a query": {
+			modify: func(c *Config) { c.SelfLinkPrefix = "https://gateway.example.com/k8s?a=b" },
+			field:  "SelfLinkPrefix",
+		},
+		"missing UI asset directory": {
+			modify: func(c *Config) {
+				c.EnableUISupport = true
+				c.UIAssetPath = "/does/not/exist"
+			},
+			field: "UIAssetPath",
+		},
+		"read versions without a storage version": {
+			modify: func(c *Config) { c.StorageReadVersions = map[string][]string{"autoscaling": {"autoscaling/v1"}} },
+			field:  "StorageReadVersions",
+		},
+		"read version of another group": {
+			modify: func(c *Config) { c.StorageReadVersions = map[string][]string{extensions.GroupName: {"v1"}} },
+			field:  "StorageReadVersions",
+		},
+		"empty API prefix": {
+			modify: func(c *Config) { c.APIPrefix = "" },
+			field:  "APIPrefix",
+		},
+		"API group prefix with spaces": {
+			modify: func(c *Config) { c.APIGroupPrefix = "/my apis" },
+			field:  "APIGroupPrefix",
+		},
+	}
+	for name, testCase := range testCases {
+		_, etcdserver, config, assert := setUp(t)
+		config.KubeletClient = client.FakeKubeletClient{}
+		testCase.modify(&config)
+
+		master, err := New(&config)
+		assert.Nil(master, name)
+		if assert.True(IsInvalidConfig(err), "%s: unexpected error %v", name, err) {
+			assert.Equal(testCase.field, err.(*InvalidConfigError).Field, name)
+		}
+		etcdserver.Terminate(t)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	genericetcd "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// parseGroupResource parses a resource of the configuration, "<resource>" in the
+// legacy group or "<group>/<resource>" in other groups.
+func parseGroupResource(resource string) (unversioned.GroupResource, error) {
+	groupResource := unversioned.GroupResource{Resource: resource}
+	if parts := strings.SplitN(resource, "/", 2); len(parts) == 2 {
+		groupResource = unversioned.GroupResource{Group: parts[0], Resource: parts[1]}
+	}
+	if len(groupResource.Resource) == 0 || strings.Contains(groupResource.Resource, "/") {
+		return unversioned.GroupResource{}, fmt.Errorf("invalid resource %q", resource)
+	}
+	return groupResource, nil
+}
+
+// groupResourceKey returns the resource of group as it is written in the
+// configuration, the inverse of parseGroupResource.
+func groupResourceKey(group, resource string) string {
+	if len(group) == 0 {
+		return resource
+	}
+	return group + "/" + resource
+}
+
+// watchCacheResyncPeriod returns the period at which the watch cache of the
+// resource of group relists its objects: its WatchCacheResyncPeriods override, or
+// WatchCacheResyncPeriod.
+func (c *Config) watchCacheResyncPeriod(group, resource string) time.Duration {
+	if period, found := c.WatchCacheResyncPeriods[groupResourceKey(group, resource)]; found {
+		return period
+	}
+	return c.WatchCacheResyncPeriod
+}
+
+// watchCacheEnabled returns true if the storage of the resource of group is
+// served from a watch cache.
+func (c *Config) watchCacheEnabled(group, resource string) bool {
+	if c.EnableWatchCache {
+		return true
+	}
+	key := groupResourceKey(group, resource)
+	for _, cached := range c.WatchCacheResources {
+		if cached == key {
+			return true
+		}
+	}
+	return false
+}
+
+// watchCacheSize returns the number of events the watch cache of the resource of
+// group keeps: its WatchCacheSizes override, or DefaultWatchCacheSize, or else
+// capacity, the one its registry asks for.
+func (c *Config) watchCacheSize(group, resource string, capacity int) int {
+	if size, found := c.WatchCacheSizes[groupResourceKey(group, resource)]; found {
+		return size
+	}
+	if c.DefaultWatchCacheSize > 0 {
+		return c.DefaultWatchCacheSize
+	}
+	return capacity
+}
+
+// watchCacheDecorator returns the decorator wrapping the storage of the resource
+// of group with a watch cache, which it adds to caches unless it is nil.
+func (c *Config) watchCacheDecorator(group, resource string, caches *watchCacheCollector) generic.StorageDecorator {
+	cacher := genericetcd.StorageWithResyncingCacher(c.watchCacheResyncPeriod(group, resource))
+	return func(s storage.Interface, capacity int, objectType runtime.Object, resourcePrefix string, namespaceScoped bool, newListFunc func() runtime.Object) storage.Interface {
+		s = cacher(s, c.watchCacheSize(group, resource, capacity), objectType, resourcePrefix, namespaceScoped, newListFunc)
+		if caches != nil {
+			caches.add(groupResourceKey(group, resource), s.(*storage.Cacher))
+		}
+		return s
+	}
+}
+
+var (
+	watchCacheFullnessDesc = prometheus.NewDesc(
+		"apiserver_watch_cache_fullness",
+		"Fraction of the capacity of the watch cache of each resource that holds events.",
+		[]string{"resource"}, nil,
+	)
+	watchCacheCapacityDesc = prometheus.NewDesc(
+		"apiserver_watch_cache_capacity",
+		"Number of events the watch cache of each resource can hold.",
+		[]string{"resource"}, nil,
+	)
+	watchCacheEvictionsDesc = prometheus.NewDesc(
+		"apiserver_watch_cache_evictions_total",
+		"Counter of the events evicted from the full watch cache of each resource to make room for newer ones.",
+		[]string{"resource"}, nil,
+	)
+)
+
+// watchCacheCollector collects the metrics of the watch caches of a master. The
+// watches that start from the resource version of an evicted event fail with a
+// "too old resource version" error, so a cache that is always full, and evicts
+// events at a high rate, should be made larger.
+type watchCacheCollector struct {
+	lock    sync.Mutex
+	cachers map[string]*storage.Cacher
+}
+
+func newWatchCacheCollector() *watchCacheCollector {
+	return &watchCacheCollector{cachers: map[string]*storage.Cacher{}}
+}
+
+// add collects the metrics of cacher, the watch cache of resource, in place of
+// the metrics of the previous watch cache of resource.
+func (w *watchCacheCollector) add(resource string, cacher *storage.Cacher) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.cachers[resource] = cacher
+}
+
+func (w *watchCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- watchCacheFullnessDesc
+	ch <- watchCacheCapacityDesc
+	ch <- watchCacheEvictionsDesc
+}
+
+func (w *watchCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for resource, cacher := range w.cachers {
+		stats := cacher.WatchCacheStats()
+		ch <- prometheus.MustNewConstMetric(watchCacheFullnessDesc, prometheus.GaugeValue, float64(stats.Size)/float64(stats.Capacity), resource)
+		ch <- prometheus.MustNewConstMetric(watchCacheCapacityDesc, prometheus.GaugeValue, float64(stats.Capacity), resource)
+		ch <- prometheus.MustNewConstMetric(watchCacheEvictionsDesc, prometheus.CounterValue, float64(stats.Evictions), resource)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// TestWatchCacheResyncPeriod verifies that the watch caches relist at the period
+// of the override of their resource, or at the default one.
+func TestWatchCacheResyncPeriod(t *testing.T) {
+	config := Config{
+		WatchCacheResyncPeriod: time.Hour,
+		WatchCacheResyncPeriods: map[string]time.Duration{
+			"pods":                   time.Minute,
+			"extensions/deployments": 2 * time.Minute,
+			"extensions/replicasets": 0,
+		},
+	}
+	testCases := []struct {
+		group    string
+		resource string
+		expected time.Duration
+	}{
+		{"", "pods", time.Minute},
+		{"", "services", time.Hour},
+		{"extensions", "deployments", 2 * time.Minute},
+		{"extensions", "replicasets", 0},
+		{"extensions", "pods", time.Hour},
+		{"", "deployments", time.Hour},
+	}
+	for _, testCase := range testCases {
+		if period := config.watchCacheResyncPeriod(testCase.group, testCase.resource); period != testCase.expected {
+			t.Errorf("%s/%s: expected %v, got %v", testCase.group, testCase.resource, testCase.expected, period)
+		}
+	}
+}
+
+// TestWatchCacheEnabled verifies that only the WatchCacheResources are served
+// from a watch cache, unless EnableWatchCache enables it for every resource.
+func TestWatchCacheEnabled(t *testing.T) {
+	testCases := []struct {
+		enableWatchCache bool
+		group            string
+		resource         string
+		expected         bool
+	}{
+		{false, "", "pods", true},
+		{false, "", "nodes", false},
+		{false, "extensions", "deployments", true},
+		{false, "extensions", "pods", false},
+		{false, "", "deployments", false},
+		{true, "", "nodes", true},
+		{true, "extensions", "jobs", true},
+	}
+	for _, testCase := range testCases {
+		config := Config{
+			EnableWatchCache:    testCase.enableWatchCache,
+			WatchCacheResources: []string{"pods", "extensions/deployments"},
+		}
+		if enabled := config.watchCacheEnabled(testCase.group, testCase.resource); enabled != testCase.expected {
+			t.Errorf("%s/%s (EnableWatchCache %v): expected %v, got %v", testCase.group, testCase.resource, testCase.enableWatchCache, testCase.expected, enabled)
+		}
+	}
+}
+
+// TestWatchCacheSize verifies the capacity of the watch caches, from the most
+// specific setting to the one of their registry.
+func TestWatchCacheSize(t *testing.T) {
+	testCases := []struct {
+		defaultSize int
+		resource    string
+		expected    int
+	}{
+		{0, "pods", 5000},
+		{0, "services", 100},
+		{200, "pods", 5000},
+		{200, "services", 200},
+	}
+	for _, testCase := range testCases {
+		config := Config{
+			DefaultWatchCacheSize: testCase.defaultSize,
+			WatchCacheSizes:       map[string]int{"pods": 5000},
+		}
+		if size := config.watchCacheSize("", testCase.resource, 100); size != testCase.expected {
+			t.Errorf("%s (default %d): expected %d, got %d", testCase.resource, testCase.defaultSize, testCase.expected, size)
+		}
+	}
+}
+
+// TestWatchCacheMetrics verifies that the metrics of the watch caches of a master
+// are collected for the resources served from one.
+func TestWatchCacheMetrics(t *testing.T) {
+	master, etcdserver, config, _ := setUpForInit(t)
+	defer etcdserver.Terminate(t)
+
+	master.metricsRegistry = &testMetricsRegistry{}
+	config.WatchCacheResources = []string{"secrets"}
+	config.WatchCacheSizes = map[string]int{"secrets": 10}
+
+	master.init(&config)
+
+	if len(master.watchCaches.cachers) != 1 {
+		t.Fatalf("expected a watch cache for secrets only, got %v", master.watchCaches.cachers)
+	}
+	if capacity := master.watchCaches.cachers["secrets"].WatchCacheStats().Capacity; capacity != 10 {
+		t.Errorf("expected a capacity of 10, got %d", capacity)
+	}
+	ch := make(chan prometheus.Metric, 10)
+	master.watchCaches.Collect(ch)
+	close(ch)
+	descs := map[*prometheus.Desc]bool{}
+	for metric := range ch {
+		descs[metric.Desc()] = true
+	}
+	for _, desc := range []*prometheus.Desc{watchCacheFullnessDesc, watchCacheCapacityDesc, watchCacheEvictionsDesc} {
+		if !descs[desc] {
+			t.Errorf("%v wasn't collected", desc)
+		}
+	}
+}
@@ -100,6 +100,8 @@ type Etcd struct {
 	DeleteStrategy rest.RESTDeleteStrategy
 	// On deletion of an object, attempt to run a further operation.
 	AfterDelete rest.ObjectFunc
+	// Strips the cluster-specific fields of exported objects, optional
+	ExportStrategy rest.Exporter
 	// If true, return the object that was deleted. Otherwise, return a generic
 	// success status response.
 	ReturnDeletedObject bool
@@ -207,6 +209,9 @@ func (e *Etcd) Create(ctx api.Context, obj runtime.Object) (runtime.Object, erro
 	if err != nil {
 		return nil, err
 	}
+	if api.IsDryRun(ctx) {
+		return e.dryRunCreate(ctx, key, name, obj)
+	}
 	out := e.NewFunc()
 	if err := e.Storage.Create(ctx, key, obj, out, ttl); err != nil {
 		err = etcderr.InterpretCreateError(err, e.EndpointName, name)
@@ -249,7 +254,7 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	// TODO: expose TTL
 	creating := false
 	out := e.NewFunc()
-	err = e.Storage.GuaranteedUpdate(ctx, key, out, true, func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+	tryUpdate := func(existing runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
 		version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
 		if err != nil {
 			return nil, nil, err
@@ -297,8 +302,13 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 			return obj, &ttl, nil
 		}
 		return obj, nil, nil
-	})
-
+	}
+	dryRun := api.IsDryRun(ctx)
+	if dryRun {
+		out, err = e.dryRunUpdate(ctx, key, tryUpdate)
+	} else {
+		err = e.Storage.GuaranteedUpdate(ctx, key, out, true, tryUpdate)
+	}
 	if err != nil {
 		if creating {
 			err = etcderr.InterpretCreateError(err, e.EndpointName, name)
@@ -308,14 +318,15 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 		}
 		return nil, false, err
 	}
+	// Nothing was persisted by a dry run, so the hooks that react to a stored object are skipped.
 	if creating {
-		if e.AfterCreate != nil {
+		if e.AfterCreate != nil && !dryRun {
 			if err := e.AfterCreate(out); err != nil {
 				return nil, false, err
 			}
 		}
 	} else {
-		if e.AfterUpdate != nil {
+		if e.AfterUpdate != nil && !dryRun {
 			if err := e.AfterUpdate(out); err != nil {
 				return nil, false, err
 			}
@@ -329,6 +340,58 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 	return out, creating, nil
 }
 
+// SupportsDryRun implements rest.DryRunner.
+func (e *Etcd) SupportsDryRun() bool {
+	return true
+}
+
+// dryRunCreate returns obj as it would have been created at key, without writing
+// it to etcd. The object already passed BeforeCreate.
+func (e *Etcd) dryRunCreate(ctx api.Context, key, name string, obj runtime.Object) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(ctx, key, existing, true); err != nil {
+		return nil, etcderr.InterpretGetError(err, e.EndpointName, name)
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 {
+		err = kubeerr.NewAlreadyExists(e.EndpointName, name)
+		return nil, rest.CheckGeneratedNameError(e.CreateStrategy, err, obj)
+	}
+	if e.Decorator != nil {
+		if err := e.Decorator(obj); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// dryRunUpdate runs tryUpdate against the object currently stored at key and
+// returns the result, without writing it to etcd.
+func (e *Etcd) dryRunUpdate(ctx api.Context, key string, tryUpdate storage.UpdateFunc) (runtime.Object, error) {
+	existing := e.NewFunc()
+	if err := e.Storage.Get(ctx, key, existing, true); err != nil {
+		return nil, err
+	}
+	version, err := e.Storage.Versioner().ObjectResourceVersion(existing)
+	if err != nil {
+		return nil, err
+	}
+	obj, _, err := tryUpdate(existing, storage.ResponseMeta{ResourceVersion: version})
+	return obj, err
+}
+
+// Export strips the cluster-specific fields of obj with the ExportStrategy, if
+// there is one.
+func (e *Etcd) Export(obj runtime.Object) error {
+	if e.ExportStrategy == nil {
+		return nil
+	}
+	return e.ExportStrategy.Export(obj)
+}
+
 // Get retrieves the item from etcd.
 func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 	obj := e.NewFunc()
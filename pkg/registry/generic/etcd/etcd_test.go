@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/api"
@@ -325,6 +326,94 @@ func TestEtcdUpdate(t *testing.T) {
 
 }
 
+func TestEtcdCreateDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	server, registry := NewTestGenericEtcdRegistry(t)
+	defer server.Terminate(t)
+
+	// a dry run returns the object without storing it
+	obj, err := registry.Create(api.WithDryRun(testContext), podA)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pod := obj.(*api.Pod); pod.Spec.NodeName != "machine" || len(pod.ResourceVersion) != 0 {
+		t.Errorf("Unexpected object: %#v", pod)
+	}
+	_, err = registry.Get(testContext, podA.Name)
+	if !errors.IsNotFound(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// a dry run still detects existing objects
+	if _, err := registry.Create(testContext, podA); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_, err = registry.Create(api.WithDryRun(testContext), podA)
+	if !errors.IsAlreadyExists(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestEtcdUpdateDryRun(t *testing.T) {
+	podA := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test"},
+		Spec:       api.PodSpec{NodeName: "machine"},
+	}
+
+	testContext := api.WithNamespace(api.NewContext(), "test")
+	server, registry := NewTestGenericEtcdRegistry(t)
+	defer server.Terminate(t)
+
+	// a dry run update of a missing object doesn't create it
+	registry.UpdateStrategy.(*testRESTStrategy).allowCreateOnUpdate = true
+	_, created, err := registry.Update(api.WithDryRun(testContext), podA)
+	if err != nil || !created {
+		t.Fatalf("Unexpected result: %v %v", created, err)
+	}
+	_, err = registry.Get(testContext, podA.Name)
+	if !errors.IsNotFound(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	registry.UpdateStrategy.(*testRESTStrategy).allowCreateOnUpdate = false
+
+	stored, err := registry.Create(testContext, podA)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	podB := *stored.(*api.Pod)
+	podB.Spec.NodeName = "machine2"
+	obj, _, err := registry.Update(api.WithDryRun(testContext), &podB)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := "machine2", obj.(*api.Pod).Spec.NodeName; e != a {
+		t.Errorf("Expected %v, got %v", e, a)
+	}
+	checkObj, err := registry.Get(testContext, podA.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e, a := stored, checkObj; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expected %#v, got %#v", e, a)
+	}
+
+	// a dry run still checks the resource version
+	version, err := strconv.ParseUint(podB.ResourceVersion, 10, 64)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	podB.ResourceVersion = strconv.FormatUint(version+1, 10)
+	_, _, err = registry.Update(api.WithDryRun(testContext), &podB)
+	if !errors.IsConflict(err) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
 func TestEtcdGet(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Namespace: "test", Name: "foo"},
@@ -17,6 +17,9 @@ limitations under the License.
 package etcd
 
 import (
+	"time"
+
+	"k8s.io/kubernetes/pkg/registry/generic"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
 	etcdstorage "k8s.io/kubernetes/pkg/storage/etcd"
@@ -34,3 +37,35 @@ func StorageWithCacher(
 		storageInterface, capacity, etcdstorage.APIObjectVersioner{},
 		objectType, resourcePrefix, namespaceScoped, newListFunc)
 }
+
+// StorageWithResyncingCacher returns a decorator creating a cacher, like
+// StorageWithCacher, that relists the objects from the given 'storageInterface'
+// every resyncPeriod.
+func StorageWithResyncingCacher(resyncPeriod time.Duration) generic.StorageDecorator {
+	return func(
+		storageInterface storage.Interface,
+		capacity int,
+		objectType runtime.Object,
+		resourcePrefix string,
+		namespaceScoped bool,
+		newListFunc func() runtime.Object) storage.Interface {
+		config := storage.CacherConfig{
+			CacheCapacity:  capacity,
+			Storage:        storageInterface,
+			Versioner:      etcdstorage.APIObjectVersioner{},
+			Type:           objectType,
+			ResourcePrefix: resourcePrefix,
+			NewListFunc:    newListFunc,
+			ResyncPeriod:   resyncPeriod,
+		}
+		config.KeyFunc = func(obj runtime.Object) (string, error) {
+			return storage.NoNamespaceKeyFunc(resourcePrefix, obj)
+		}
+		if namespaceScoped {
+			config.KeyFunc = func(obj runtime.Object) (string, error) {
+				return storage.NamespaceKeyFunc(resourcePrefix, obj)
+			}
+		}
+		return storage.NewCacherFromConfig(config)
+	}
+}
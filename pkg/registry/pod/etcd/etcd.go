@@ -89,6 +89,7 @@ func NewStorage(
 		CreateStrategy:      pod.Strategy,
 		UpdateStrategy:      pod.Strategy,
 		DeleteStrategy:      pod.Strategy,
+		ExportStrategy:      pod.Strategy,
 		ReturnDeletedObject: true,
 
 		Storage: storageInterface,
@@ -59,6 +59,17 @@ func (podStrategy) PrepareForCreate(obj runtime.Object) {
 	}
 }
 
+// Export clears the node the pod is bound to, so that the pod is scheduled when
+// it is created in another cluster.
+func (podStrategy) Export(obj runtime.Object) error {
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		return fmt.Errorf("not a pod: %#v", obj)
+	}
+	pod.Spec.NodeName = ""
+	return nil
+}
+
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (podStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newPod := obj.(*api.Pod)
@@ -167,3 +167,22 @@ func TestCheckLogLocation(t *testing.T) {
 		}
 	}
 }
+
+func TestExport(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec:       api.PodSpec{NodeName: "node1", Containers: []api.Container{{Name: "bar"}}},
+	}
+	if err := Strategy.Export(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pod.Spec.NodeName) != 0 {
+		t.Errorf("expected the node name to be cleared, got %q", pod.Spec.NodeName)
+	}
+	if len(pod.Spec.Containers) != 1 {
+		t.Errorf("expected the containers to be kept, got %#v", pod.Spec.Containers)
+	}
+	if err := Strategy.Export(&api.Service{}); err == nil {
+		t.Errorf("expected an error exporting a service")
+	}
+}
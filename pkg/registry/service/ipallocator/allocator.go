@@ -131,7 +131,7 @@ func (r *Range) AllocateNext() (net.IP, error) {
 	if !ok {
 		return nil, ErrFull
 	}
-	return addIPOffset(r.base, offset), nil
+	return addIPOffset(r.base, offset, ipLen(r.net.IP)), nil
 }
 
 // Release releases the IP back to the pool. Releasing an
@@ -206,10 +206,22 @@ func bigForIP(ip net.IP) *big.Int {
 	return big.NewInt(0).SetBytes(b)
 }
 
+// ipLen returns the length in bytes of the address family of the provided net.IP.
+func ipLen(ip net.IP) int {
+	if ip.To4() != nil {
+		return net.IPv4len
+	}
+	return net.IPv6len
+}
+
 // addIPOffset adds the provided integer offset to a base big.Int representing a
-// net.IP
-func addIPOffset(base *big.Int, offset int) net.IP {
-	return net.IP(big.NewInt(0).Add(base, big.NewInt(int64(offset))).Bytes())
+// net.IP, returning an address of the given length in bytes. The result is left
+// padded with zeroes, since big.Int drops leading zero bytes.
+func addIPOffset(base *big.Int, offset int, length int) net.IP {
+	b := big.NewInt(0).Add(base, big.NewInt(int64(offset))).Bytes()
+	ip := make(net.IP, length)
+	copy(ip[length-len(b):], b)
+	return ip
 }
 
 // calculateIPOffset calculates the integer offset of ip from base such that
@@ -230,7 +242,7 @@ func RangeSize(subnet *net.IPNet) int64 {
 
 // GetIndexedIP returns a net.IP that is subnet.IP + index in the contiguous IP space.
 func GetIndexedIP(subnet *net.IPNet, index int) (net.IP, error) {
-	ip := addIPOffset(bigForIP(subnet.IP), index)
+	ip := addIPOffset(bigForIP(subnet.IP), index, ipLen(subnet.IP))
 	if !subnet.Contains(ip) {
 		return nil, fmt.Errorf("can't generate IP with index %d from subnet. subnet too small. subnet: %q", index, subnet)
 	}
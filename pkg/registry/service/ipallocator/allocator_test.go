@@ -95,6 +95,42 @@ func TestAllocate(t *testing.T) {
 	}
 }
 
+func TestAllocateIPv6(t *testing.T) {
+	_, cidr, err := net.ParseCIDR("fd00::/112")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewCIDRRange(cidr)
+	if f := r.Free(); f != 65534 {
+		t.Errorf("unexpected free %d", f)
+	}
+	ip, err := r.AllocateNext()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ip) != net.IPv6len || !cidr.Contains(ip) {
+		t.Fatalf("allocated %s which is outside of %s", ip, cidr)
+	}
+	if !r.Has(ip) {
+		t.Errorf("expected %s to be allocated", ip)
+	}
+	if err := r.Allocate(net.ParseIP("fd00::1")); err != nil && err != ErrAllocated {
+		t.Fatal(err)
+	}
+	if err := r.Allocate(net.ParseIP("fd00::ffff")); err != ErrNotInRange {
+		t.Fatal(err)
+	}
+	if err := r.Allocate(net.ParseIP("fd01::1")); err != ErrNotInRange {
+		t.Fatal(err)
+	}
+	if err := r.Release(ip); err != nil {
+		t.Fatal(err)
+	}
+	if r.Has(ip) {
+		t.Errorf("expected %s to be released", ip)
+	}
+}
+
 func TestAllocateTiny(t *testing.T) {
 	_, cidr, err := net.ParseCIDR("192.168.1.0/32")
 	if err != nil {
@@ -219,3 +255,30 @@ func TestSnapshot(t *testing.T) {
 		t.Errorf("counts do not match: %d", other.Free())
 	}
 }
+
+func TestGetIndexedIP(t *testing.T) {
+	testCases := []struct {
+		cidr     string
+		index    int
+		expected string
+	}{
+		{cidr: "192.168.1.0/24", index: 1, expected: "192.168.1.1"},
+		{cidr: "10.0.0.0/8", index: 256, expected: "10.0.1.0"},
+		{cidr: "fd00::/112", index: 1, expected: "fd00::1"},
+		{cidr: "::/112", index: 10, expected: "::a"},
+	}
+	for _, tc := range testCases {
+		_, subnet, err := net.ParseCIDR(tc.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ip, err := GetIndexedIP(subnet, tc.index)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.cidr, err)
+			continue
+		}
+		if !ip.Equal(net.ParseIP(tc.expected)) {
+			t.Errorf("%s: expected %s, got %s", tc.cidr, tc.expected, ip)
+		}
+	}
+}
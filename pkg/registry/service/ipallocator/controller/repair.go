@@ -49,16 +49,19 @@ type Repair struct {
 	registry service.Registry
 	network  *net.IPNet
 	alloc    service.RangeRegistry
+	reserved []net.IP
 }
 
 // NewRepair creates a controller that periodically ensures that all clusterIPs are uniquely allocated across the cluster
-// and generates informational warnings for a cluster that is not in sync.
-func NewRepair(interval time.Duration, registry service.Registry, network *net.IPNet, alloc service.RangeRegistry) *Repair {
+// and generates informational warnings for a cluster that is not in sync. The reserved IPs are always marked as
+// allocated, so that they are never assigned to services.
+func NewRepair(interval time.Duration, registry service.Registry, network *net.IPNet, alloc service.RangeRegistry, reserved []net.IP) *Repair {
 	return &Repair{
 		interval: interval,
 		registry: registry,
 		network:  network,
 		alloc:    alloc,
+		reserved: reserved,
 	}
 }
 
@@ -100,6 +103,13 @@ func (c *Repair) RunOnce() error {
 	}
 
 	r := ipallocator.NewCIDRRange(c.network)
+	reserved := map[string]bool{}
+	for _, ip := range c.reserved {
+		if err := r.Allocate(ip); err != nil {
+			return fmt.Errorf("unable to reserve the service IP %s: %v", ip, err)
+		}
+		reserved[ip.String()] = true
+	}
 	for _, svc := range list.Items {
 		if !api.IsServiceIPSet(&svc) {
 			continue
@@ -110,6 +120,12 @@ func (c *Repair) RunOnce() error {
 			util.HandleError(fmt.Errorf("the cluster IP %s for service %s/%s is not a valid IP; please recreate", svc.Spec.ClusterIP, svc.Name, svc.Namespace))
 			continue
 		}
+		if reserved[ip.String()] {
+			// TODO: send event
+			// cluster IP is broken, reallocate
+			util.HandleError(fmt.Errorf("the cluster IP %s for service %s/%s is reserved; please recreate", ip, svc.Name, svc.Namespace))
+			continue
+		}
 		switch err := r.Allocate(ip); err {
 		case nil:
 		case ipallocator.ErrAllocated:
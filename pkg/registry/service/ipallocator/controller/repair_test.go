@@ -54,7 +54,7 @@ func TestRepair(t *testing.T) {
 	ipregistry := &mockRangeRegistry{
 		item: &api.RangeAllocation{},
 	}
-	r := NewRepair(0, registry, cidr, ipregistry)
+	r := NewRepair(0, registry, cidr, ipregistry, nil)
 
 	if err := r.RunOnce(); err != nil {
 		t.Fatal(err)
@@ -67,7 +67,7 @@ func TestRepair(t *testing.T) {
 		item:      &api.RangeAllocation{},
 		updateErr: fmt.Errorf("test error"),
 	}
-	r = NewRepair(0, registry, cidr, ipregistry)
+	r = NewRepair(0, registry, cidr, ipregistry, nil)
 	if err := r.RunOnce(); !strings.Contains(err.Error(), ": test error") {
 		t.Fatal(err)
 	}
@@ -94,7 +94,7 @@ func TestRepairEmpty(t *testing.T) {
 			Data:  dst.Data,
 		},
 	}
-	r := NewRepair(0, registry, cidr, ipregistry)
+	r := NewRepair(0, registry, cidr, ipregistry, nil)
 	if err := r.RunOnce(); err != nil {
 		t.Fatal(err)
 	}
@@ -150,7 +150,7 @@ func TestRepairWithExisting(t *testing.T) {
 			Data:  dst.Data,
 		},
 	}
-	r := NewRepair(0, registry, cidr, ipregistry)
+	r := NewRepair(0, registry, cidr, ipregistry, nil)
 	if err := r.RunOnce(); err != nil {
 		t.Fatal(err)
 	}
@@ -165,3 +165,44 @@ func TestRepairWithExisting(t *testing.T) {
 		t.Errorf("unexpected ipallocator state: %#v", after)
 	}
 }
+
+func TestRepairWithReserved(t *testing.T) {
+	_, cidr, _ := net.ParseCIDR("192.168.1.0/24")
+	registry := registrytest.NewServiceRegistry()
+	registry.List = api.ServiceList{
+		Items: []api.Service{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "one"},
+				Spec:       api.ServiceSpec{ClusterIP: "192.168.1.1"},
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "reserved"},
+				Spec:       api.ServiceSpec{ClusterIP: "192.168.1.10"},
+			},
+		},
+	}
+	ipregistry := &mockRangeRegistry{
+		item: &api.RangeAllocation{},
+	}
+	r := NewRepair(0, registry, cidr, ipregistry, []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("192.168.1.20")})
+	if err := r.RunOnce(); err != nil {
+		t.Fatal(err)
+	}
+	after := ipallocator.NewCIDRRange(cidr)
+	if err := after.Restore(cidr, ipregistry.updated.Data); err != nil {
+		t.Fatal(err)
+	}
+	for _, ip := range []string{"192.168.1.1", "192.168.1.10", "192.168.1.20"} {
+		if !after.Has(net.ParseIP(ip)) {
+			t.Errorf("expected %s to be allocated: %#v", ip, after)
+		}
+	}
+	if after.Free() != 251 {
+		t.Errorf("unexpected ipallocator state: %#v", after)
+	}
+
+	r = NewRepair(0, registry, cidr, ipregistry, []net.IP{net.ParseIP("10.0.0.1")})
+	if err := r.RunOnce(); err == nil || !strings.Contains(err.Error(), "unable to reserve") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
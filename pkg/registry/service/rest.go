@@ -174,6 +174,11 @@ func (rs *REST) Delete(ctx api.Context, id string) (runtime.Object, error) {
 	return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
 }
 
+// Export strips the cluster IP of an exported service.
+func (rs *REST) Export(obj runtime.Object) error {
+	return Strategy.Export(obj)
+}
+
 func (rs *REST) Get(ctx api.Context, id string) (runtime.Object, error) {
 	return rs.registry.GetService(ctx, id)
 }
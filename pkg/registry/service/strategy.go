@@ -49,6 +49,20 @@ func (svcStrategy) PrepareForCreate(obj runtime.Object) {
 	service.Status = api.ServiceStatus{}
 }
 
+// Export clears the cluster IP allocated to the service, so that one is allocated
+// when it is created in another cluster. The "None" cluster IP of a headless
+// service is kept.
+func (svcStrategy) Export(obj runtime.Object) error {
+	service, ok := obj.(*api.Service)
+	if !ok {
+		return fmt.Errorf("not a service: %#v", obj)
+	}
+	if api.IsServiceIPSet(service) {
+		service.Spec.ClusterIP = ""
+	}
+	return nil
+}
+
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (svcStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	// TODO: once service has a status sub-resource we can enable this.
@@ -127,3 +127,27 @@ func TestBeforeUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestExport(t *testing.T) {
+	tests := []struct {
+		clusterIP         string
+		expectedClusterIP string
+	}{
+		{clusterIP: "10.0.0.1", expectedClusterIP: ""},
+		{clusterIP: api.ClusterIPNone, expectedClusterIP: api.ClusterIPNone},
+		{clusterIP: "", expectedClusterIP: ""},
+	}
+	for _, test := range tests {
+		service := makeValidService()
+		service.Spec.ClusterIP = test.clusterIP
+		if err := Strategy.Export(&service); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if service.Spec.ClusterIP != test.expectedClusterIP {
+			t.Errorf("expected cluster IP %q for %q, got %q", test.expectedClusterIP, test.clusterIP, service.Spec.ClusterIP)
+		}
+	}
+	if err := Strategy.Export(&api.Pod{}); err == nil {
+		t.Errorf("expected an error exporting a pod")
+	}
+}
@@ -109,9 +109,37 @@ func NewCodec(codec runtime.Codec, kind string) runtime.Codec {
 	return &thirdPartyResourceDataCodec{codec, kind}
 }
 
+// unmarshalJSON unmarshals data into obj like json.Unmarshal, except that numbers
+// are kept as json.Number. The third party objects are opaque, so their numbers
+// must be written back exactly as they were read rather than through a float64.
+func unmarshalJSON(data []byte, obj interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(obj)
+}
+
+// StripStatus removes the status from the opaque data of a third party object, if
+// it has one.
+func StripStatus(obj *extensions.ThirdPartyResourceData) error {
+	object := map[string]interface{}{}
+	if err := unmarshalJSON(obj.Data, &object); err != nil {
+		return err
+	}
+	if _, found := object["status"]; !found {
+		return nil
+	}
+	delete(object, "status")
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+	obj.Data = data
+	return nil
+}
+
 func (t *thirdPartyResourceDataCodec) populate(objIn *extensions.ThirdPartyResourceData, data []byte) error {
 	var obj interface{}
-	if err := json.Unmarshal(data, &obj); err != nil {
+	if err := unmarshalJSON(data, &obj); err != nil {
 		fmt.Printf("Invalid JSON:\n%s\n", string(data))
 		return err
 	}
@@ -231,12 +259,13 @@ func (t *thirdPartyResourceDataCodec) DecodeParametersInto(parameters url.Values
 
 const template = `{
   "kind": "%s",
+  "metadata": %s,
   "items": [ %s ]
 }`
 
 func encodeToJSON(obj *extensions.ThirdPartyResourceData, stream io.Writer) error {
 	var objOut interface{}
-	if err := json.Unmarshal(obj.Data, &objOut); err != nil {
+	if err := unmarshalJSON(obj.Data, &objOut); err != nil {
 		return err
 	}
 	objMap, ok := objOut.(map[string]interface{})
@@ -261,18 +290,9 @@ func (t *thirdPartyResourceDataCodec) EncodeToStream(obj runtime.Object, stream
 	case *extensions.ThirdPartyResourceData:
 		return encodeToJSON(obj, stream)
 	case *extensions.ThirdPartyResourceDataList:
-		// TODO: There must be a better way to do this...
-		dataStrings := make([]string, len(obj.Items))
-		for ix := range obj.Items {
-			buff := &bytes.Buffer{}
-			err := encodeToJSON(&obj.Items[ix], buff)
-			if err != nil {
-				return err
-			}
-			dataStrings[ix] = buff.String()
-		}
-		fmt.Fprintf(stream, template, t.kind+"List", strings.Join(dataStrings, ","))
-		return nil
+		return t.encodeList(obj, ListMeta{ListMeta: obj.ListMeta}, stream)
+	case *PagedList:
+		return t.encodeList(&obj.ThirdPartyResourceDataList, ListMeta{ListMeta: obj.ListMeta, Continue: obj.Continue}, stream)
 	case *unversioned.Status:
 		return t.delegate.EncodeToStream(obj, stream)
 	default:
@@ -280,6 +300,25 @@ func (t *thirdPartyResourceDataCodec) EncodeToStream(obj runtime.Object, stream
 	}
 }
 
+func (t *thirdPartyResourceDataCodec) encodeList(list *extensions.ThirdPartyResourceDataList, metadata ListMeta, stream io.Writer) error {
+	// TODO: There must be a better way to do this...
+	dataStrings := make([]string, len(list.Items))
+	for ix := range list.Items {
+		buff := &bytes.Buffer{}
+		err := encodeToJSON(&list.Items[ix], buff)
+		if err != nil {
+			return err
+		}
+		dataStrings[ix] = buff.String()
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stream, template, t.kind+"List", metadataJSON, strings.Join(dataStrings, ","))
+	return nil
+}
+
 func NewObjectCreator(group, version string, delegate runtime.ObjectCreater) runtime.ObjectCreater {
 	return &thirdPartyResourceDataCreator{group, version, delegate}
 }
@@ -306,3 +345,23 @@ func (t *thirdPartyResourceDataCreator) New(kind unversioned.GroupVersionKind) (
 		return t.delegate.New(kind)
 	}
 }
+
+// NewObjectConvertor returns an ObjectConvertor that accepts the field labels third
+// party objects can be selected by, metadata.name and metadata.namespace, and
+// delegates the conversions of objects to delegate.
+func NewObjectConvertor(delegate runtime.ObjectConvertor) runtime.ObjectConvertor {
+	return &thirdPartyResourceDataConvertor{delegate}
+}
+
+type thirdPartyResourceDataConvertor struct {
+	runtime.ObjectConvertor
+}
+
+func (t *thirdPartyResourceDataConvertor) ConvertFieldLabel(version, kind, label, value string) (string, string, error) {
+	switch label {
+	case "metadata.name", "metadata.namespace":
+		return label, value, nil
+	default:
+		return "", "", fmt.Errorf("field label not supported: %s", label)
+	}
+}
@@ -84,6 +84,17 @@ func TestCodec(t *testing.T) {
 			},
 			name: "labels",
 		},
+		{
+			obj: &Foo{
+				ObjectMeta: api.ObjectMeta{
+					Name:        "bar",
+					Annotations: map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"kind":"Foo","otherField":12345678901234567}`},
+				},
+				TypeMeta:   unversioned.TypeMeta{Kind: "Foo"},
+				OtherField: 12345678901234567,
+			},
+			name: "large integer",
+		},
 	}
 	for _, test := range tests {
 		codec := thirdPartyResourceDataCodec{kind: "Foo"}
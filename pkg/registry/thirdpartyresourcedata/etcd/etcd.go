@@ -17,9 +17,14 @@ limitations under the License.
 package etcd
 
 import (
+	"fmt"
+	"net/http"
 	"strings"
+	"sync"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -28,11 +33,22 @@ import (
 	"k8s.io/kubernetes/pkg/registry/thirdpartyresourcedata"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/storage"
+	"k8s.io/kubernetes/pkg/watch"
 )
 
 // REST implements a RESTStorage for ThirdPartyResourceDatas against etcd
 type REST struct {
 	*etcdgeneric.Etcd
+
+	// Columns are the additional columns of the table output of the objects.
+	Columns []extensions.ThirdPartyResourceColumn
+	// MaxObjectBytes is the size, in bytes, of the data of the largest object that
+	// may be created or updated. Zero means there is no limit.
+	MaxObjectBytes int64
+
+	// watches are the watches in progress, shared by the copies of the REST so
+	// that StopWatches stops the ones started from any of them.
+	watches *watchSet
 }
 
 // NewREST returns a registry which will store ThirdPartyResourceData in the given helper
@@ -64,5 +80,157 @@ func NewREST(s storage.Interface, storageDecorator generic.StorageDecorator, gro
 		Storage: storageInterface,
 	}
 
-	return &REST{store}
+	return &REST{Etcd: store, watches: &watchSet{watches: map[*trackedWatch]struct{}{}}}
+}
+
+// maxGenerateNameAttempts is the number of names generated for an object created
+// with a generateName before its creation fails.
+const maxGenerateNameAttempts = 5
+
+// Create stores a third party object. If the object has a generateName and no name,
+// the generated name is regenerated as long as it collides with an existing
+// object, up to maxGenerateNameAttempts times.
+func (r *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	if err := r.checkSize(obj); err != nil {
+		return nil, err
+	}
+	data, ok := obj.(*extensions.ThirdPartyResourceData)
+	if !ok || len(data.Name) != 0 || len(data.GenerateName) == 0 {
+		return r.Etcd.Create(ctx, obj)
+	}
+	for attempt := 1; ; attempt++ {
+		out, err := r.Etcd.Create(ctx, obj)
+		// A collision of a generated name is reported as a server timeout.
+		if !errors.IsServerTimeout(err) || attempt == maxGenerateNameAttempts {
+			return out, err
+		}
+		// Let the create strategy generate another name.
+		data.Name = ""
+	}
+}
+
+// Update updates a third party object, unless its data is larger than MaxObjectBytes.
+func (r *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	if err := r.checkSize(obj); err != nil {
+		return nil, false, err
+	}
+	return r.Etcd.Update(ctx, obj)
+}
+
+// checkSize returns a 413 Request Entity Too Large error if the data of obj is
+// larger than MaxObjectBytes. The data is opaque JSON stored as is, so objects
+// are refused before they reach the storage, whose own limit on the size of
+// values fails with a less helpful error.
+func (r *REST) checkSize(obj runtime.Object) error {
+	data, ok := obj.(*extensions.ThirdPartyResourceData)
+	if !ok || r.MaxObjectBytes <= 0 || int64(len(data.Data)) <= r.MaxObjectBytes {
+		return nil
+	}
+	return errors.NewRequestEntityTooLargeError(fmt.Sprintf("the object is %d bytes, more than the %d bytes allowed for third party objects", len(data.Data), r.MaxObjectBytes))
+}
+
+// List returns the third party objects matching options. If ctx restricts the list
+// to a page, only the objects of that page are returned, in a
+// thirdpartyresourcedata.PagedList. etcd2 has neither ranged reads nor reads at a
+// past resource version, so the whole current list is read and the page is cut
+// from it, see thirdpartyresourcedata.Paginate.
+func (r *REST) List(ctx api.Context, options *unversioned.ListOptions) (runtime.Object, error) {
+	obj, err := r.Etcd.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	page, ok := thirdpartyresourcedata.ListPageFrom(ctx)
+	if !ok {
+		return obj, nil
+	}
+	return thirdpartyresourcedata.Paginate(obj.(*extensions.ThirdPartyResourceDataList), page)
+}
+
+// Export strips the status of a third party object, which is kept in its opaque
+// data rather than in a field of its own.
+func (r *REST) Export(obj runtime.Object) error {
+	data, ok := obj.(*extensions.ThirdPartyResourceData)
+	if !ok {
+		return fmt.Errorf("unexpected object: %#v", obj)
+	}
+	return thirdpartyresourcedata.StripStatus(data)
+}
+
+// Watch begins watching the third party objects. A watch from a resource version
+// whose history has been compacted away ends with a 410 Gone error, telling the
+// client to relist.
+func (r *REST) Watch(ctx api.Context, options *unversioned.ListOptions) (watch.Interface, error) {
+	w, err := r.Etcd.Watch(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	resourceVersion := ""
+	if options != nil {
+		resourceVersion = options.ResourceVersion
+	}
+	return r.watches.track(goneOnExpired(w, resourceVersion)), nil
+}
+
+// StopWatches stops the watches in progress, so that their clients are told the
+// watch ended, e.g. when the third party resource is removed. The watches started
+// later are not affected.
+func (r *REST) StopWatches() {
+	r.watches.stopAll()
+}
+
+// watchSet is a set of watches in progress.
+type watchSet struct {
+	lock    sync.Mutex
+	watches map[*trackedWatch]struct{}
+}
+
+// trackedWatch is a watch that leaves its watchSet when it is stopped.
+type trackedWatch struct {
+	watch.Interface
+	set *watchSet
+}
+
+func (w *trackedWatch) Stop() {
+	w.set.lock.Lock()
+	delete(w.set.watches, w)
+	w.set.lock.Unlock()
+	w.Interface.Stop()
+}
+
+// track adds w to the set until it is stopped.
+func (s *watchSet) track(w watch.Interface) watch.Interface {
+	tracked := &trackedWatch{Interface: w, set: s}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.watches[tracked] = struct{}{}
+	return tracked
+}
+
+// stopAll stops the watches of the set.
+func (s *watchSet) stopAll() {
+	s.lock.Lock()
+	watches := make([]*trackedWatch, 0, len(s.watches))
+	for w := range s.watches {
+		watches = append(watches, w)
+	}
+	s.lock.Unlock()
+	for _, w := range watches {
+		w.Stop()
+	}
+}
+
+// goneOnExpired replaces the error the storage reports when a watch starts from a
+// compacted resource version with a 410 Gone error that explains how to recover.
+func goneOnExpired(w watch.Interface, resourceVersion string) watch.Interface {
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if event.Type != watch.Error {
+			return event, true
+		}
+		status, ok := event.Object.(*unversioned.Status)
+		if !ok || status.Code != http.StatusGone {
+			return event, true
+		}
+		gone := errors.NewGone(fmt.Sprintf("too old resource version: %s (%s), list the objects again and watch from the resource version of the list", resourceVersion, status.Message))
+		return watch.Event{Type: watch.Error, Object: &gone.(*errors.StatusError).ErrStatus}, true
+	})
 }
@@ -17,9 +17,14 @@ limitations under the License.
 package etcd
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apis/extensions"
 	// Ensure that extensions/v1beta1 package is initialized.
 	_ "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
@@ -29,6 +34,8 @@ import (
 	"k8s.io/kubernetes/pkg/registry/registrytest"
 	"k8s.io/kubernetes/pkg/runtime"
 	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
 )
 
 func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
@@ -60,6 +67,52 @@ func TestCreate(t *testing.T) {
 	)
 }
 
+// sequentialNameStrategy is a create strategy generating the names in names, in order.
+type sequentialNameStrategy struct {
+	rest.RESTCreateStrategy
+	names []string
+}
+
+func (s *sequentialNameStrategy) GenerateName(base string) string {
+	name := base + s.names[0]
+	s.names = s.names[1:]
+	return name
+}
+
+// TestCreateGenerateNameCollision verifies that the name of an object created with
+// a generateName is regenerated when it collides with an existing object.
+func TestCreateGenerateNameCollision(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	ctx := api.NewDefaultContext()
+	if _, err := storage.Create(ctx, validNewThirdPartyResourceData("foo-a")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	storage.CreateStrategy = &sequentialNameStrategy{RESTCreateStrategy: storage.CreateStrategy, names: []string{"a", "a", "b"}}
+	rsrc := validNewThirdPartyResourceData("")
+	rsrc.GenerateName = "foo-"
+	obj, err := storage.Create(ctx, rsrc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name := obj.(*extensions.ThirdPartyResourceData).Name; name != "foo-b" {
+		t.Errorf("expected the name foo-b, got %s", name)
+	}
+
+	// An object with a name colliding with an existing object is not renamed.
+	if _, err := storage.Create(ctx, validNewThirdPartyResourceData("foo-a")); !errors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	storage.CreateStrategy = &sequentialNameStrategy{RESTCreateStrategy: storage.CreateStrategy, names: []string{"a", "a", "a", "a", "a"}}
+	rsrc = validNewThirdPartyResourceData("")
+	rsrc.GenerateName = "foo-"
+	if _, err := storage.Create(ctx, rsrc); !errors.IsServerTimeout(err) {
+		t.Errorf("expected a server timeout error after %d attempts, got %v", maxGenerateNameAttempts, err)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	storage, server := newStorage(t)
 	defer server.Terminate(t)
@@ -76,6 +129,42 @@ func TestUpdate(t *testing.T) {
 	)
 }
 
+// TestMaxObjectBytes verifies that the objects whose data is larger than
+// MaxObjectBytes are neither created nor updated.
+func TestMaxObjectBytes(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	storage.MaxObjectBytes = 9
+	ctx := api.NewDefaultContext()
+
+	if _, err := storage.Create(ctx, validNewThirdPartyResourceData("foo")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tooLarge := validNewThirdPartyResourceData("bar")
+	tooLarge.Data = []byte("foobarbazq")
+	if _, err := storage.Create(ctx, tooLarge); !errors.IsRequestEntityTooLarge(err) {
+		t.Errorf("expected a request entity too large error, got %v", err)
+	}
+	if _, err := storage.Get(ctx, "bar"); !errors.IsNotFound(err) {
+		t.Errorf("expected the object not to be created, got %v", err)
+	}
+
+	obj, err := storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	update := obj.(*extensions.ThirdPartyResourceData)
+	update.Data = []byte("foobarbazq")
+	if _, _, err := storage.Update(ctx, update); !errors.IsRequestEntityTooLarge(err) {
+		t.Errorf("expected a request entity too large error, got %v", err)
+	}
+
+	storage.MaxObjectBytes = 0
+	if _, _, err := storage.Update(ctx, update); err != nil {
+		t.Errorf("unexpected error without a limit: %v", err)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	storage, server := newStorage(t)
 	defer server.Terminate(t)
@@ -118,3 +207,55 @@ func TestWatch(t *testing.T) {
 		},
 	)
 }
+
+func TestGoneOnExpired(t *testing.T) {
+	fake := watch.NewFake()
+	w := goneOnExpired(fake, "10")
+	defer w.Stop()
+
+	go func() {
+		fake.Add(validNewThirdPartyResourceData("foo"))
+		fake.Error(&unversioned.Status{
+			Status:  unversioned.StatusFailure,
+			Code:    http.StatusGone,
+			Reason:  unversioned.StatusReasonExpired,
+			Message: "401: The event in requested index is outdated and cleared",
+		})
+	}()
+
+	if event := <-w.ResultChan(); event.Type != watch.Added {
+		t.Errorf("expected the added event to pass through, got %#v", event)
+	}
+	event := <-w.ResultChan()
+	status, ok := event.Object.(*unversioned.Status)
+	if event.Type != watch.Error || !ok {
+		t.Fatalf("expected an error event with a status, got %#v", event)
+	}
+	if status.Code != http.StatusGone || status.Reason != unversioned.StatusReasonGone {
+		t.Errorf("expected a 410 Gone status, got %#v", status)
+	}
+}
+
+func TestStopWatches(t *testing.T) {
+	storage, server := newStorage(t)
+	defer server.Terminate(t)
+	w, err := storage.Watch(api.NewDefaultContext(), &unversioned.ListOptions{ResourceVersion: "1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A copy of the storage, like the one of an updated resource, stops the watches too.
+	copied := *storage
+	copied.StopWatches()
+	select {
+	case _, ok := <-w.ResultChan():
+		if ok {
+			t.Errorf("expected the watch to be stopped")
+		}
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("the watch was not stopped")
+	}
+	if len(storage.watches.watches) != 0 {
+		t.Errorf("expected no watch to be tracked, got %d", len(storage.watches.watches))
+	}
+}
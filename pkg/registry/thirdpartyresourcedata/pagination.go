@@ -0,0 +1,194 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+// ListPage selects a page of a list of third party objects.
+type ListPage struct {
+	// Limit is the maximum number of objects in the page. Zero means no limit.
+	Limit int64
+	// Continue is the continue token of the previous page, or empty for the first page.
+	Continue string
+}
+
+// pageKey is the type of the context key of the page of a list request.
+type pageKey int
+
+// listPageKey is the context key of the page of a list request.
+const listPageKey pageKey = 0
+
+// WithListPage returns a copy of ctx in which the list of third party objects
+// is restricted to page.
+func WithListPage(ctx api.Context, page ListPage) api.Context {
+	return api.WithValue(ctx, listPageKey, page)
+}
+
+// ListPageFrom returns the page the list of third party objects is restricted
+// to in ctx, if any.
+func ListPageFrom(ctx api.Context) (ListPage, bool) {
+	page, ok := ctx.Value(listPageKey).(ListPage)
+	return page, ok
+}
+
+// continueToken is the content of the opaque continue token of a page.
+type continueToken struct {
+	// ResourceVersion is the resource version of the list the first page was
+	// cut from. All the pages report it, so that a watch started from it once
+	// the last page is read observes every change made while paging.
+	ResourceVersion string `json:"rv"`
+	// StartAfter is the key, relative to the resource, of the last object of the
+	// previous page.
+	StartAfter string `json:"start"`
+}
+
+func encodeContinue(token continueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinue(encoded string) (continueToken, error) {
+	token := continueToken{}
+	data, err := base64.URLEncoding.DecodeString(encoded)
+	if err != nil {
+		return token, err
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, err
+	}
+	if len(token.StartAfter) == 0 {
+		return token, fmt.Errorf("no start key")
+	}
+	return token, nil
+}
+
+// objectKey returns the key of obj relative to its resource.
+func objectKey(obj *extensions.ThirdPartyResourceData) string {
+	return obj.Namespace + "/" + obj.Name
+}
+
+// byKey sorts third party objects by key.
+type byKey []extensions.ThirdPartyResourceData
+
+func (s byKey) Len() int           { return len(s) }
+func (s byKey) Less(i, j int) bool { return objectKey(&s[i]) < objectKey(&s[j]) }
+func (s byKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// PagedList is a page of a list of third party objects. The continue token of the
+// next page isn't a field of the list types, so it is only ever encoded by the third
+// party codec, in the metadata of the list.
+type PagedList struct {
+	extensions.ThirdPartyResourceDataList
+
+	// Continue is the continue token of the next page, or empty on the last page.
+	Continue string
+}
+
+// NextPage returns the continue token of the next page of the list.
+func (list *PagedList) NextPage() string {
+	return list.Continue
+}
+
+// ListMeta is the metadata of the lists of third party objects as they are encoded:
+// the standard list metadata and the continue token of the next page.
+type ListMeta struct {
+	unversioned.ListMeta `json:",inline"`
+
+	// Continue is passed as the continue parameter of the list request of the next
+	// page. It is empty on the last page of a paginated list and on the lists that
+	// aren't paginated.
+	Continue string `json:"continue,omitempty"`
+}
+
+// etcdHistoryWindow is the number of events whose history etcd keeps. A watch from
+// an older resource version fails, so the pages of a list whose first page is older
+// are refused too.
+const etcdHistoryWindow = 1000
+
+// Paginate returns the page of list selected by page. The objects of the page are
+// the first page.Limit objects, by key, after the last object of the previous page.
+//
+// list is the current list: the storage can't read the list at the resource version
+// of the first page. The next pages are cut from it only as long as they would be
+// the same at that resource version, i.e. none of their objects was created or
+// updated since, and otherwise a 410 Gone error tells the client to list again from
+// the first page. So are the pages of a first page older than the history of the
+// storage. The objects deleted since the first page are missing from the next
+// pages, and a watch from the resource version of the list reports their deletion.
+func Paginate(list *extensions.ThirdPartyResourceDataList, page ListPage) (*PagedList, error) {
+	token := continueToken{ResourceVersion: list.ResourceVersion}
+	sort.Sort(byKey(list.Items))
+	items := list.Items
+	if len(page.Continue) > 0 {
+		var err error
+		if token, err = decodeContinue(page.Continue); err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		start := sort.Search(len(items), func(i int) bool { return objectKey(&items[i]) > token.StartAfter })
+		items = items[start:]
+		if err := checkUnchangedSince(list.ResourceVersion, token.ResourceVersion, items); err != nil {
+			return nil, err
+		}
+	}
+	paged := &PagedList{ThirdPartyResourceDataList: *list}
+	paged.ResourceVersion = token.ResourceVersion
+	if page.Limit > 0 && int64(len(items)) > page.Limit {
+		items = items[:page.Limit]
+		token.StartAfter = objectKey(&items[len(items)-1])
+		encoded, err := encodeContinue(token)
+		if err != nil {
+			return nil, err
+		}
+		paged.Continue = encoded
+	}
+	paged.Items = items
+	return paged, nil
+}
+
+// checkUnchangedSince returns a 410 Gone error if the list at resourceVersion
+// listed at currentVersion can't be read anymore, or if any of items changed since
+// resourceVersion.
+func checkUnchangedSince(currentVersion, resourceVersion string, items []extensions.ThirdPartyResourceData) error {
+	since, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("invalid continue token: invalid resource version %q", resourceVersion))
+	}
+	if current, err := strconv.ParseUint(currentVersion, 10, 64); err == nil && current > since+etcdHistoryWindow {
+		return errors.NewGone(fmt.Sprintf("too old resource version: %s, list again from the first page", resourceVersion))
+	}
+	for i := range items {
+		version, err := strconv.ParseUint(items[i].ResourceVersion, 10, 64)
+		if err != nil || version > since {
+			return errors.NewGone(fmt.Sprintf("the list changed after its first page was read at resource version %s, list again from the first page", resourceVersion))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+// newDataList returns a list at resourceVersion of the objects with the given
+// namespaces and names, all last updated at resource version 5.
+func newDataList(resourceVersion string, keys ...[2]string) *extensions.ThirdPartyResourceDataList {
+	list := &extensions.ThirdPartyResourceDataList{ListMeta: unversioned.ListMeta{ResourceVersion: resourceVersion}}
+	for _, key := range keys {
+		list.Items = append(list.Items, extensions.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Namespace: key[0], Name: key[1], ResourceVersion: "5"}})
+	}
+	return list
+}
+
+func dataKeys(list *PagedList) []string {
+	keys := []string{}
+	for i := range list.Items {
+		keys = append(keys, objectKey(&list.Items[i]))
+	}
+	return keys
+}
+
+func TestPaginate(t *testing.T) {
+	items := [][2]string{{"ns2", "a"}, {"ns1", "b"}, {"ns1", "a"}, {"ns2", "b"}, {"ns1", "c"}}
+
+	page, err := Paginate(newDataList("10", items...), ListPage{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys := dataKeys(page); !reflect.DeepEqual(keys, []string{"ns1/a", "ns1/b"}) {
+		t.Errorf("unexpected first page: %v", keys)
+	}
+	if len(page.Continue) == 0 || page.ResourceVersion != "10" {
+		t.Fatalf("unexpected page metadata: %#v %q", page.ListMeta, page.Continue)
+	}
+
+	// The objects of the first page may change, and the next pages report the
+	// resource version of the first one.
+	list := newDataList("12", items...)
+	list.Items[2].ResourceVersion = "11"
+	page, err = Paginate(list, ListPage{Limit: 2, Continue: page.Continue})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys := dataKeys(page); !reflect.DeepEqual(keys, []string{"ns1/c", "ns2/a"}) {
+		t.Errorf("unexpected second page: %v", keys)
+	}
+	if len(page.Continue) == 0 || page.ResourceVersion != "10" {
+		t.Fatalf("unexpected page metadata: %#v %q", page.ListMeta, page.Continue)
+	}
+
+	page, err = Paginate(newDataList("12", items...), ListPage{Limit: 2, Continue: page.Continue})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys := dataKeys(page); !reflect.DeepEqual(keys, []string{"ns2/b"}) {
+		t.Errorf("unexpected last page: %v", keys)
+	}
+	if len(page.Continue) != 0 || page.ResourceVersion != "10" {
+		t.Errorf("unexpected page metadata: %#v %q", page.ListMeta, page.Continue)
+	}
+
+	page, err = Paginate(newDataList("10", items...), ListPage{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Items) != len(items) || len(page.Continue) != 0 {
+		t.Errorf("expected an unlimited page to hold every object, got %v", dataKeys(page))
+	}
+}
+
+// TestPaginateChanged verifies that the next pages of a list are refused with a
+// 410 Gone error once they differ from the list at the resource version of the
+// first page, or once that resource version is older than the history of etcd.
+func TestPaginateChanged(t *testing.T) {
+	items := [][2]string{{"ns1", "a"}, {"ns1", "b"}, {"ns1", "c"}}
+	first, err := Paginate(newDataList("10", items...), ListPage{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated := newDataList("12", items...)
+	updated.Items[2].ResourceVersion = "11"
+	created := newDataList("12", append(items, [2]string{"ns1", "bb"})...)
+	created.Items[3].ResourceVersion = "12"
+	testCases := map[string]*extensions.ThirdPartyResourceDataList{
+		"updated":   updated,
+		"created":   created,
+		"compacted": newDataList("1011", items...),
+	}
+	for name, list := range testCases {
+		if _, err := Paginate(list, ListPage{Limit: 1, Continue: first.Continue}); !errors.IsGone(err) {
+			t.Errorf("%s: expected a 410 Gone error, got %v", name, err)
+		}
+	}
+
+	deleted := newDataList("12", items[:2]...)
+	page, err := Paginate(deleted, ListPage{Limit: 1, Continue: first.Continue})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys := dataKeys(page); !reflect.DeepEqual(keys, []string{"ns1/b"}) {
+		t.Errorf("unexpected page after a deletion: %v", keys)
+	}
+}
+
+func TestPaginateInvalidContinue(t *testing.T) {
+	for _, cont := range []string{"not a token", "e30="} {
+		_, err := Paginate(newDataList("10", [2]string{"ns", "a"}), ListPage{Continue: cont})
+		if !errors.IsBadRequest(err) {
+			t.Errorf("expected a bad request for %q, got %v", cont, err)
+		}
+	}
+}
@@ -86,7 +86,8 @@ func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
 	})
 }
 
-// SelectableFields returns a label set that can be used for filter selection
+// SelectableFields returns a label set that can be used for filter selection:
+// metadata.name and metadata.namespace.
 func SelectableFields(obj *extensions.ThirdPartyResourceData) labels.Set {
-	return labels.Set{}
+	return labels.Set(generic.ObjectMetaFieldsSet(obj.ObjectMeta, true))
 }
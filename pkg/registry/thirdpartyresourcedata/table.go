@@ -0,0 +1,156 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/jsonpath"
+)
+
+// Table is the table output of third party objects: a row of cells per object,
+// for the name and age of the object and for the additional printer columns of
+// its resource.
+type Table struct {
+	unversioned.TypeMeta `json:",inline"`
+	ListMeta             `json:"metadata,omitempty"`
+
+	ColumnDefinitions []TableColumnDefinition `json:"columnDefinitions"`
+	Rows              []TableRow              `json:"rows"`
+}
+
+// TableColumnDefinition describes a column of a Table.
+type TableColumnDefinition struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+// TableRow is the row of an object in a Table. A cell whose JSONPath finds nothing
+// in the object is null.
+type TableRow struct {
+	Cells  []interface{}   `json:"cells"`
+	Object json.RawMessage `json:"object,omitempty"`
+}
+
+// NewTable returns the table of data, a JSON encoded third party object or list of
+// them, with the given additional columns. The age of the objects is relative to
+// now.
+func NewTable(data []byte, columns []extensions.ThirdPartyResourceColumn, now time.Time) (*Table, error) {
+	object := struct {
+		unversioned.TypeMeta `json:",inline"`
+		Metadata             ListMeta          `json:"metadata"`
+		Items                []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	table := &Table{
+		TypeMeta: unversioned.TypeMeta{Kind: "Table", APIVersion: object.APIVersion},
+		ColumnDefinitions: []TableColumnDefinition{
+			{Name: "Name", Type: "string", Description: "The name of the object."},
+			{Name: "Age", Type: "string", Description: "The time since the object was created."},
+		},
+		Rows: []TableRow{},
+	}
+	for _, column := range columns {
+		table.ColumnDefinitions = append(table.ColumnDefinitions, TableColumnDefinition{Name: column.Name, Type: "string", Description: column.Description})
+	}
+
+	paths := make([]*jsonpath.JSONPath, len(columns))
+	for ix, column := range columns {
+		path := jsonpath.New(column.Name)
+		if err := path.Parse("{" + column.JSONPath + "}"); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath of column %s: %v", column.Name, err)
+		}
+		paths[ix] = path
+	}
+
+	items := object.Items
+	if object.Items != nil || strings.HasSuffix(object.Kind, "List") {
+		table.ListMeta = object.Metadata
+	} else {
+		items = []json.RawMessage{data}
+		table.ResourceVersion = object.Metadata.ResourceVersion
+	}
+	for _, item := range items {
+		row, err := newTableRow(item, paths, now)
+		if err != nil {
+			return nil, err
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table, nil
+}
+
+func newTableRow(data json.RawMessage, paths []*jsonpath.JSONPath, now time.Time) (TableRow, error) {
+	metadata := struct {
+		Metadata struct {
+			Name              string           `json:"name"`
+			CreationTimestamp unversioned.Time `json:"creationTimestamp"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return TableRow{}, err
+	}
+	object := map[string]interface{}{}
+	if err := unmarshalJSON(data, &object); err != nil {
+		return TableRow{}, err
+	}
+
+	age := "<unknown>"
+	if created := metadata.Metadata.CreationTimestamp; !created.IsZero() {
+		age = shortHumanDuration(now.Sub(created.Time))
+	}
+	row := TableRow{Cells: []interface{}{metadata.Metadata.Name, age}, Object: data}
+	for _, path := range paths {
+		buf := &bytes.Buffer{}
+		if err := path.Execute(buf, object); err != nil {
+			// The field is not set in this object.
+			row.Cells = append(row.Cells, nil)
+			continue
+		}
+		row.Cells = append(row.Cells, buf.String())
+	}
+	return row, nil
+}
+
+// shortHumanDuration formats d like kubectl does in the age column.
+func shortHumanDuration(d time.Duration) string {
+	// Allow deviation no more than 2 seconds(excluded) to tolerate machine time
+	// inconsistence, it can be considered as almost now.
+	if seconds := int(d.Seconds()); seconds < -1 {
+		return "<invalid>"
+	} else if seconds < 0 {
+		return "0s"
+	} else if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	} else if minutes := int(d.Minutes()); minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	} else if hours := int(d.Hours()); hours < 24 {
+		return fmt.Sprintf("%dh", hours)
+	} else if hours < 24*364 {
+		return fmt.Sprintf("%dd", hours/24)
+	}
+	return fmt.Sprintf("%dy", int(d.Hours()/24/365))
+}
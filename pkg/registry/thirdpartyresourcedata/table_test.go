@@ -0,0 +1,82 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestNewTable(t *testing.T) {
+	now := time.Date(2015, 12, 1, 12, 0, 0, 0, time.UTC)
+	columns := []extensions.ThirdPartyResourceColumn{
+		{Name: "Replicas", JSONPath: ".spec.replicas", Description: "The desired replicas."},
+		{Name: "Image", JSONPath: ".spec.image"},
+	}
+	object := `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"a","resourceVersion":"5","creationTimestamp":"2015-12-01T11:55:00Z"},"spec":{"replicas":12345678901234567890,"image":"busybox"}}`
+	other := `{"kind":"Foo","apiVersion":"company.com/v1","metadata":{"name":"b","creationTimestamp":"2015-11-29T12:00:00Z"},"spec":{"replicas":1}}`
+
+	table, err := NewTable([]byte(object), columns, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if table.Kind != "Table" || table.APIVersion != "company.com/v1" || table.ResourceVersion != "5" {
+		t.Errorf("unexpected table metadata: %#v %#v", table.TypeMeta, table.ListMeta)
+	}
+	names := []string{}
+	for _, column := range table.ColumnDefinitions {
+		names = append(names, column.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"Name", "Age", "Replicas", "Image"}) {
+		t.Errorf("unexpected columns: %v", names)
+	}
+	if len(table.Rows) != 1 {
+		t.Fatalf("expected one row, got %#v", table.Rows)
+	}
+	if cells := table.Rows[0].Cells; !reflect.DeepEqual(cells, []interface{}{"a", "5m", "12345678901234567890", "busybox"}) {
+		t.Errorf("unexpected cells: %#v", cells)
+	}
+	if string(table.Rows[0].Object) != object {
+		t.Errorf("unexpected object: %s", table.Rows[0].Object)
+	}
+
+	list := `{"kind":"FooList","apiVersion":"company.com/v1","metadata":{"resourceVersion":"7","continue":"next"},"items":[` + object + `,` + other + `]}`
+	table, err = NewTable([]byte(list), columns, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if table.ResourceVersion != "7" || table.Continue != "next" {
+		t.Errorf("unexpected table metadata: %#v", table.ListMeta)
+	}
+	if len(table.Rows) != 2 {
+		t.Fatalf("expected two rows, got %#v", table.Rows)
+	}
+	if cells := table.Rows[1].Cells; !reflect.DeepEqual(cells, []interface{}{"b", "2d", "1", nil}) {
+		t.Errorf("unexpected cells: %#v", cells)
+	}
+
+	table, err = NewTable([]byte(`{"kind":"FooList","apiVersion":"company.com/v1","metadata":{},"items":null}`), nil, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(table.Rows) != 0 {
+		t.Errorf("expected no rows for an empty list, got %#v", table.Rows)
+	}
+}
@@ -30,6 +30,35 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 )
 
+// envelope is what every object is encoded in: its type and its own protobuf
+// encoding.
+type envelope struct {
+	TypeMeta typeMeta `protobuf:"bytes,1,opt,name=typeMeta"`
+	Raw      []byte   `protobuf:"bytes,2,opt,name=raw"`
+}
+
+func (m *envelope) Reset()         { *m = envelope{} }
+func (m *envelope) String() string { return proto.CompactTextString(m) }
+func (*envelope) ProtoMessage()    {}
+
+type typeMeta struct {
+	APIVersion string `protobuf:"bytes,1,opt,name=apiVersion"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind"`
+}
+
+func (m *typeMeta) Reset()         { *m = typeMeta{} }
+func (m *typeMeta) String() string { return proto.CompactTextString(m) }
+func (*typeMeta) ProtoMessage()    {}
+
+// groupVersionKind returns the type of the object in e.
+func (e *envelope) groupVersionKind() (unversioned.GroupVersionKind, error) {
+	gv, err := unversioned.ParseGroupVersion(e.TypeMeta.APIVersion)
+	if err != nil {
+		return unversioned.GroupVersionKind{}, err
+	}
+	return gv.WithKind(e.TypeMeta.Kind), nil
+}
+
 // NewCodec
 func NewCodec(version string, creater runtime.ObjectCreater, typer runtime.ObjectTyper, convertor runtime.ObjectConvertor) runtime.Codec {
 	return &codec{
@@ -52,11 +81,15 @@ type codec struct {
 var _ runtime.Codec = codec{}
 
 func (c codec) Decode(data []byte) (runtime.Object, error) {
-	unknown := &runtime.Unknown{}
+	unknown := &envelope{}
 	if err := proto.Unmarshal(data, unknown); err != nil {
 		return nil, err
 	}
-	obj, err := c.creater.New(unknown.APIVersion, unknown.Kind)
+	gvk, err := unknown.groupVersionKind()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := c.creater.New(gvk)
 	if err != nil {
 		return nil, err
 	}
@@ -64,10 +97,10 @@ func (c codec) Decode(data []byte) (runtime.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf("runtime object is not a proto.Message: %v", reflect.TypeOf(obj))
 	}
-	if err := proto.Unmarshal(unknown.RawJSON, pobj); err != nil {
+	if err := proto.Unmarshal(unknown.Raw, pobj); err != nil {
 		return nil, err
 	}
-	if unknown.APIVersion != c.outputVersion {
+	if unknown.TypeMeta.APIVersion != c.outputVersion {
 		out, err := c.convertor.ConvertToVersion(obj, c.outputVersion)
 		if err != nil {
 			return nil, err
@@ -82,34 +115,35 @@ func (c codec) DecodeToVersion(data []byte, version unversioned.GroupVersion) (r
 }
 
 func (c codec) DecodeInto(data []byte, obj runtime.Object) error {
-	version, kind, err := c.typer.ObjectVersionAndKind(obj)
+	gvk, err := c.typer.ObjectKind(obj)
 	if err != nil {
 		return err
 	}
-	unknown := &runtime.Unknown{}
+	unknown := &envelope{}
 	if err := proto.Unmarshal(data, unknown); err != nil {
 		return err
 	}
-	if unknown.APIVersion == version && unknown.Kind == kind {
+	if unknown.TypeMeta.APIVersion == gvk.GroupVersion().String() && unknown.TypeMeta.Kind == gvk.Kind {
 		pobj, ok := obj.(proto.Message)
 		if !ok {
 			return fmt.Errorf("runtime object is not a proto.Message: %v", reflect.TypeOf(obj))
 		}
-
-		return proto.Unmarshal(unknown.RawJSON, pobj)
+		return proto.Unmarshal(unknown.Raw, pobj)
 	}
 
-	versioned, err := c.creater.New(unknown.APIVersion, unknown.Kind)
+	unknownGVK, err := unknown.groupVersionKind()
+	if err != nil {
+		return err
+	}
+	versioned, err := c.creater.New(unknownGVK)
 	if err != nil {
 		return err
 	}
-
 	pobj, ok := versioned.(proto.Message)
 	if !ok {
 		return fmt.Errorf("runtime object is not a proto.Message: %v", reflect.TypeOf(obj))
 	}
-
-	if err := proto.Unmarshal(unknown.RawJSON, pobj); err != nil {
+	if err := proto.Unmarshal(unknown.Raw, pobj); err != nil {
 		return err
 	}
 	return c.convertor.Convert(versioned, obj)
@@ -124,11 +158,12 @@ func (c codec) DecodeParametersInto(parameters url.Values, obj runtime.Object) e
 }
 
 func (c codec) Encode(obj runtime.Object) (data []byte, err error) {
-	version, kind, err := c.typer.ObjectVersionAndKind(obj)
+	gvk, err := c.typer.ObjectKind(obj)
 	if err != nil {
 		return nil, err
 	}
-	if len(version) == 0 {
+	version := gvk.GroupVersion().String()
+	if len(gvk.Version) == 0 {
 		version = c.version
 		converted, err := c.convertor.ConvertToVersion(obj, version)
 		if err != nil {
@@ -138,19 +173,19 @@ func (c codec) Encode(obj runtime.Object) (data []byte, err error) {
 	}
 	m, ok := obj.(proto.Marshaler)
 	if !ok {
-		return nil, fmt.Errorf("object %v (kind: %s in version: %s) does not implement ProtoBuf marshalling", reflect.TypeOf(obj), kind, c.version)
+		return nil, fmt.Errorf("object %v (kind: %s in version: %s) does not implement ProtoBuf marshalling", reflect.TypeOf(obj), gvk.Kind, c.version)
 	}
 	b, err := m.Marshal()
 	if err != nil {
 		return nil, err
 	}
-	return (&runtime.Unknown{
-		TypeMeta: runtime.TypeMeta{
-			Kind:       kind,
+	return proto.Marshal(&envelope{
+		TypeMeta: typeMeta{
+			Kind:       gvk.Kind,
 			APIVersion: version,
 		},
-		RawJSON: b,
-	}).Marshal()
+		Raw: b,
+	})
 }
 
 func (c codec) EncodeToStream(obj runtime.Object, stream io.Writer) error {
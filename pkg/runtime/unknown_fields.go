@@ -0,0 +1,125 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// UnknownFields returns the paths of the fields of the JSON object in data that
+// obj, the object data is decoded into, has no field for, e.g. spec.replicsa or
+// spec.containers[0].imagee, in sorted order. The values of types that decode
+// themselves from JSON, like quantities and timestamps, and of interface types
+// are not inspected.
+func UnknownFields(data []byte, obj interface{}) ([]string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	unknown := []string{}
+	findUnknownFields(value, reflect.TypeOf(obj), "", &unknown)
+	sort.Strings(unknown)
+	return unknown, nil
+}
+
+func findUnknownFields(value interface{}, t reflect.Type, path string, unknown *[]string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for name, fieldValue := range object {
+			fieldPath := name
+			if len(path) > 0 {
+				fieldPath = path + "." + name
+			}
+			fieldType, found := fields[name]
+			if !found {
+				*unknown = append(*unknown, fieldPath)
+				continue
+			}
+			findUnknownFields(fieldValue, fieldType, fieldPath, unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			return
+		}
+		for ix, item := range items {
+			findUnknownFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, ix), unknown)
+		}
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, item := range object {
+			findUnknownFields(item, t.Elem(), fmt.Sprintf("%s[%s]", path, key), unknown)
+		}
+	}
+}
+
+// jsonFields returns the types of the fields of the struct type t by their JSON
+// names, including the fields of the structs inlined in t.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if len(field.PkgPath) > 0 && !field.Anonymous {
+			// Unexported.
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && len(name) == 0 {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for name, fieldType := range jsonFields(embedded) {
+					if _, found := fields[name]; !found {
+						fields[name] = fieldType
+					}
+				}
+				continue
+			}
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
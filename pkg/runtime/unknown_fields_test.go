@@ -0,0 +1,48 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime_test
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+func TestUnknownFields(t *testing.T) {
+	testCases := map[string][]string{
+		`{"kind":"Pod","apiVersion":"v1","metadata":{"name":"a","labels":{"app":"a"},"creationTimestamp":null}}`:                   {},
+		`{"kind":"Pod","metadata":{"name":"a","lables":{"app":"a"}},"sepc":{}}`:                                                    {"metadata.lables", "sepc"},
+		`{"spec":{"containers":[{"name":"a","image":"a"},{"name":"b","imagee":"b","resources":{"limits":{"cpu":"1"}}}]}}`:          {"spec.containers[1].imagee"},
+		`{"spec":{"volumes":[{"name":"a","emptyDir":{"medium":""},"hostPath":{"path":"/","type":"x"}}],"nodeSelector":{"a":"b"}}}`: {"spec.volumes[0].hostPath.type"},
+	}
+	for data, expected := range testCases {
+		unknown, err := runtime.UnknownFields([]byte(data), &v1.Pod{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", data, err)
+			continue
+		}
+		if !reflect.DeepEqual(unknown, expected) {
+			t.Errorf("%s: expected %v, got %v", data, expected, unknown)
+		}
+	}
+
+	if _, err := runtime.UnknownFields([]byte(`{"kind":`), &v1.Pod{}); err == nil {
+		t.Errorf("expected an error for invalid JSON")
+	}
+}
@@ -59,6 +59,12 @@ type CacherConfig struct {
 	// objects of type Type.
 	NewListFunc func() runtime.Object
 
+	// If not zero, the cache relists the objects from the underlying storage
+	// every ResyncPeriod, so that it doesn't serve anything it may have missed
+	// for longer. Every relist ends the watches served from the cache, and their
+	// clients must watch again.
+	ResyncPeriod time.Duration
+
 	// Cacher will be stopped when the StopChannel will be closed.
 	StopChannel <-chan struct{}
 }
@@ -145,7 +151,7 @@ func NewCacherFromConfig(config CacherConfig) *Cacher {
 		usable:     sync.RWMutex{},
 		storage:    config.Storage,
 		watchCache: watchCache,
-		reflector:  cache.NewReflector(listerWatcher, config.Type, watchCache, 0),
+		reflector:  cache.NewReflector(listerWatcher, config.Type, watchCache, config.ResyncPeriod),
 		watcherIdx: 0,
 		watchers:   make(map[int]*cacheWatcher),
 		versioner:  config.Versioner,
@@ -320,6 +326,12 @@ func (c *Cacher) Codec() runtime.Codec {
 	return c.storage.Codec()
 }
 
+// WatchCacheStats returns the occupancy of the window of events from which the
+// cacher starts watches, and the number of events evicted from it so far.
+func (c *Cacher) WatchCacheStats() WatchCacheStats {
+	return c.watchCache.Stats()
+}
+
 func (c *Cacher) processEvent(event watchCacheEvent) {
 	c.Lock()
 	defer c.Unlock()
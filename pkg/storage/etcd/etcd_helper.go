@@ -21,8 +21,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -143,6 +145,9 @@ func (h *etcdHelper) Create(ctx context.Context, key string, obj, out runtime.Ob
 		}
 	}
 
+	if err := contextErr(ctx); err != nil {
+		return err
+	}
 	startTime := time.Now()
 	response, err := h.client.Create(key, string(data), ttl)
 	metrics.RecordEtcdRequestLatency("create", getTypeName(obj), startTime)
@@ -169,6 +174,9 @@ func (h *etcdHelper) Set(ctx context.Context, key string, obj, out runtime.Objec
 		return err
 	}
 	key = h.prefixEtcdKey(key)
+	if err := contextErr(ctx); err != nil {
+		return err
+	}
 
 	create := true
 	if h.versioner != nil {
@@ -212,6 +220,9 @@ func (h *etcdHelper) Delete(ctx context.Context, key string, out runtime.Object)
 		panic("unable to convert output object to pointer")
 	}
 
+	if err := contextErr(ctx); err != nil {
+		return err
+	}
 	startTime := time.Now()
 	response, err := h.client.Delete(key, false)
 	metrics.RecordEtcdRequestLatency("delete", getTypeName(out), startTime)
@@ -271,7 +282,7 @@ func (h *etcdHelper) bodyAndExtractObj(ctx context.Context, key string, objPtr r
 		glog.Errorf("Context is nil")
 	}
 	startTime := time.Now()
-	response, err := h.client.Get(key, false, false)
+	response, err := h.get(ctx, key, false)
 	metrics.RecordEtcdRequestLatency("get", getTypeName(objPtr), startTime)
 
 	if err != nil && !etcdutil.IsEtcdNotFound(err) {
@@ -324,7 +335,7 @@ func (h *etcdHelper) GetToList(ctx context.Context, key string, filter storage.F
 	key = h.prefixEtcdKey(key)
 	startTime := time.Now()
 	trace.Step("About to read etcd node")
-	response, err := h.client.Get(key, false, false)
+	response, err := h.get(ctx, key, false)
 	metrics.RecordEtcdRequestLatency("get", getTypeName(listPtr), startTime)
 	trace.Step("Etcd node read")
 	if err != nil {
@@ -429,7 +440,7 @@ func (h *etcdHelper) listEtcdNode(ctx context.Context, key string) ([]*etcd.Node
 	if ctx == nil {
 		glog.Errorf("Context is nil")
 	}
-	result, err := h.client.Get(key, true, true)
+	result, err := h.get(ctx, key, true)
 	if err != nil {
 		var index uint64
 		if etcdError, ok := err.(*etcd.EtcdError); ok {
@@ -445,6 +456,61 @@ func (h *etcdHelper) listEtcdNode(ctx context.Context, key string) ([]*etcd.Node
 	return result.Node.Nodes, result.EtcdIndex, nil
 }
 
+// get reads key from etcd like h.client.Get, sorted and recursive if recursive is
+// true, except that the request is cancelled when ctx is done, e.g. when the
+// client of the API request disconnects or its deadline passes. It returns the
+// error of ctx then.
+func (h *etcdHelper) get(ctx context.Context, key string, recursive bool) (*etcd.Response, error) {
+	if ctx == nil || ctx.Done() == nil {
+		return h.client.Get(key, recursive, recursive)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	cancel := make(chan bool)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(cancel)
+		case <-stop:
+		}
+	}()
+	query := url.Values{}
+	query.Set("recursive", strconv.FormatBool(recursive))
+	query.Set("sorted", strconv.FormatBool(recursive))
+	query.Set("quorum", "false")
+	raw, err := h.client.SendRequest(etcd.NewRawRequest("GET", etcdKeyPath(key)+"?"+query.Encode(), nil, cancel))
+	if err == etcd.ErrRequestCancelled {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return raw.Unmarshal()
+}
+
+// contextErr returns the error of ctx if it is done. The writes are not sent to
+// etcd once their context is done, but a write that has been sent is waited for,
+// so that the outcome it reports is the one of etcd.
+func contextErr(ctx context.Context) error {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Err()
+}
+
+// etcdKeyPath returns the path of key in the etcd keys API, relative to its version,
+// e.g. keys/registry/pods, escaped like the etcd client does.
+func etcdKeyPath(key string) string {
+	p := strings.Replace(url.QueryEscape(path.Join("keys", key)), "%2F", "/", -1)
+	if p == "keys" {
+		p = "keys/"
+	}
+	return p
+}
+
 // Implements storage.Interface.
 func (h *etcdHelper) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate storage.UpdateFunc) error {
 	if ctx == nil {
@@ -502,6 +568,9 @@ func (h *etcdHelper) GuaranteedUpdate(ctx context.Context, key string, ptrToType
 		if err != nil {
 			return err
 		}
+		if err := contextErr(ctx); err != nil {
+			return err
+		}
 
 		// First time this key has been used, try creating new value.
 		if index == 0 {
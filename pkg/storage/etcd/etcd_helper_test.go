@@ -19,8 +19,10 @@ package etcd
 import (
 	"path"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-etcd/etcd"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +37,7 @@ import (
 	etcdtesting "k8s.io/kubernetes/pkg/storage/etcd/testing"
 	etcdutil "k8s.io/kubernetes/pkg/storage/etcd/util"
 	storagetesting "k8s.io/kubernetes/pkg/storage/testing"
+	"k8s.io/kubernetes/pkg/util"
 )
 
 const validEtcdVersion = "etcd 2.0.9"
@@ -537,3 +540,79 @@ func TestPrefixEtcdKey(t *testing.T) {
 
 	assert.Equal(t, keyBefore, keyAfter, "Prefix incorrectly added by EtcdHelper")
 }
+
+// TestReadsCancelled verifies that the reads from etcd are cancelled when their
+// context is done, and return the error of the context.
+func TestReadsCancelled(t *testing.T) {
+	server := etcdtesting.NewHangingEtcdServer()
+	defer server.Close()
+	helper := newEtcdHelper(server.Client, testapi.Default.Codec(), etcdtest.PathPrefix())
+
+	reads := map[string]func(ctx context.Context) error{
+		"get": func(ctx context.Context) error {
+			return helper.Get(ctx, "/pods/foo", &api.Pod{}, false)
+		},
+		"get to list": func(ctx context.Context) error {
+			return helper.GetToList(ctx, "/pods/foo", storage.Everything, &api.PodList{})
+		},
+		"list": func(ctx context.Context) error {
+			return helper.List(ctx, "/pods", "", storage.Everything, &api.PodList{})
+		},
+		"guaranteed update": func(ctx context.Context) error {
+			return helper.GuaranteedUpdate(ctx, "/pods/foo", &api.Pod{}, true, func(obj runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+				return obj, nil, nil
+			})
+		},
+	}
+	for name, read := range reads {
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- read(ctx)
+		}()
+		cancel()
+		select {
+		case err := <-errCh:
+			if err != context.Canceled {
+				t.Errorf("%s: expected %v, got %v", name, context.Canceled, err)
+			}
+		case <-time.After(util.ForeverTestTimeout):
+			t.Fatalf("%s: the read was not cancelled", name)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := helper.Get(ctx, "/pods/foo", &api.Pod{}, false); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	select {
+	case path := <-server.Cancelled:
+		if !strings.HasPrefix(path, "/v2/keys"+etcdtest.AddPrefix("/pods")) {
+			t.Errorf("unexpected path of the cancelled read: %s", path)
+		}
+	case <-time.After(util.ForeverTestTimeout):
+		t.Errorf("expected etcd to see the read cancelled")
+	}
+}
+
+// TestWritesNotStartedAfterContextDone verifies that no write is sent to etcd once
+// its context is done.
+func TestWritesNotStartedAfterContextDone(t *testing.T) {
+	server := etcdtesting.NewEtcdTestClientServer(t)
+	defer server.Terminate(t)
+	helper := newEtcdHelper(server.Client, testapi.Default.Codec(), etcdtest.PathPrefix())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
+	if err := helper.Create(ctx, "/pods/foo", pod, &api.Pod{}, 0); err != context.Canceled {
+		t.Errorf("create: expected %v, got %v", context.Canceled, err)
+	}
+	if err := helper.Set(ctx, "/pods/foo", pod, &api.Pod{}, 0); err != context.Canceled {
+		t.Errorf("set: expected %v, got %v", context.Canceled, err)
+	}
+	if _, err := server.Client.Get(etcdtest.AddPrefix("/pods/foo"), false, false); !etcdutil.IsEtcdNotFound(err) {
+		t.Errorf("expected the pod not to be written, got %v", err)
+	}
+}
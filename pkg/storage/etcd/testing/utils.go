@@ -153,3 +153,42 @@ func NewEtcdTestClientServer(t *testing.T) *EtcdTestServer {
 	}
 	return server
 }
+
+// HangingEtcdServer is a fake etcd server whose reads never complete, for testing
+// that they are cancelled. The paths of the reads its clients cancel are sent to
+// Cancelled, and its other requests fail.
+type HangingEtcdServer struct {
+	*httptest.Server
+	Client    *goetcd.Client
+	Cancelled chan string
+
+	stop chan struct{}
+}
+
+// NewHangingEtcdServer starts a HangingEtcdServer and creates its client.
+func NewHangingEtcdServer() *HangingEtcdServer {
+	s := &HangingEtcdServer{
+		Cancelled: make(chan string, 100),
+		stop:      make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != "GET" {
+			http.Error(w, "not supported", http.StatusInternalServerError)
+			return
+		}
+		select {
+		case <-w.(http.CloseNotifier).CloseNotify():
+			s.Cancelled <- req.URL.Path
+		case <-s.stop:
+		}
+	}))
+	s.Client = goetcd.NewClient([]string{s.URL})
+	return s
+}
+
+// Close releases the pending reads and shuts the server down.
+func (s *HangingEtcdServer) Close() {
+	close(s.stop)
+	s.Client.Close()
+	s.Server.Close()
+}
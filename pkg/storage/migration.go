@@ -0,0 +1,95 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// migrationCodec is a runtime.Codec that encodes objects with the wrapped codec,
+// and decodes only the objects stored in one of a set of versions.
+type migrationCodec struct {
+	runtime.Codec
+	typer    runtime.ObjectTyper
+	versions sets.String
+}
+
+// NewMigrationCodec returns a codec for migrating the stored objects of a group
+// from the read versions to the storage version codec encodes in. Objects are
+// always encoded with codec, in the storage version, while objects stored in the
+// storage version or in any of the read versions are decoded. Decoding an object
+// stored in any other version fails, so that a storage migration can't silently
+// mix in objects of an unexpected version. typer identifies the stored versions.
+//
+// The codec doesn't migrate the objects itself: an object stored in a read version
+// stays in it until it is written again, when it is encoded in the storage version.
+// Every such object must be rewritten, e.g. by updating it unchanged, before its
+// version is dropped from the read versions.
+func NewMigrationCodec(codec runtime.Codec, typer runtime.ObjectTyper, storageVersion string, readVersions []string) runtime.Codec {
+	if len(readVersions) == 0 {
+		return codec
+	}
+	versions := sets.NewString(readVersions...)
+	versions.Insert(storageVersion)
+	return &migrationCodec{Codec: codec, typer: typer, versions: versions}
+}
+
+// checkVersion returns an error if data is stored in a version that isn't readable.
+// Data without a version is left to the wrapped codec.
+func (c *migrationCodec) checkVersion(data []byte) error {
+	gvk, err := c.typer.DataKind(data)
+	if err != nil {
+		return err
+	}
+	version := gvk.GroupVersion()
+	if version.IsEmpty() || c.versions.Has(version.String()) {
+		return nil
+	}
+	return fmt.Errorf("object is stored in version %s, which is not one of the readable storage versions %v", version, c.versions.List())
+}
+
+func (c *migrationCodec) Decode(data []byte) (runtime.Object, error) {
+	if err := c.checkVersion(data); err != nil {
+		return nil, err
+	}
+	return c.Codec.Decode(data)
+}
+
+func (c *migrationCodec) DecodeToVersion(data []byte, groupVersion unversioned.GroupVersion) (runtime.Object, error) {
+	if err := c.checkVersion(data); err != nil {
+		return nil, err
+	}
+	return c.Codec.DecodeToVersion(data, groupVersion)
+}
+
+func (c *migrationCodec) DecodeInto(data []byte, obj runtime.Object) error {
+	if err := c.checkVersion(data); err != nil {
+		return err
+	}
+	return c.Codec.DecodeInto(data, obj)
+}
+
+func (c *migrationCodec) DecodeIntoWithSpecifiedVersionKind(data []byte, obj runtime.Object, groupVersionKind unversioned.GroupVersionKind) error {
+	if err := c.checkVersion(data); err != nil {
+		return err
+	}
+	return c.Codec.DecodeIntoWithSpecifiedVersionKind(data, obj, groupVersionKind)
+}
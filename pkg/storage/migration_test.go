@@ -0,0 +1,110 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+)
+
+type TypeMeta struct {
+	Kind       string `json:"kind,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+func (obj *TypeMeta) SetGroupVersionKind(gvk *unversioned.GroupVersionKind) {
+	obj.APIVersion, obj.Kind = gvk.ToAPIVersionAndKind()
+}
+
+func (obj *TypeMeta) GroupVersionKind() *unversioned.GroupVersionKind {
+	return unversioned.FromAPIVersionAndKind(obj.APIVersion, obj.Kind)
+}
+
+type internalSimple struct {
+	TypeMeta `json:",inline"`
+	Name     string `json:"name"`
+}
+
+type externalSimple struct {
+	TypeMeta `json:",inline"`
+	Name     string `json:"name"`
+}
+
+type olderExternalSimple struct {
+	TypeMeta `json:",inline"`
+	Name     string `json:"name"`
+}
+
+func (obj *internalSimple) GetObjectKind() unversioned.ObjectKind      { return &obj.TypeMeta }
+func (obj *externalSimple) GetObjectKind() unversioned.ObjectKind      { return &obj.TypeMeta }
+func (obj *olderExternalSimple) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
+
+// TestMigrationCodec verifies that the migration codec decodes objects stored in
+// the storage version or a read version of the same group, rejects any other
+// version, and always encodes in the storage version.
+func TestMigrationCodec(t *testing.T) {
+	internalGV := unversioned.GroupVersion{Group: "test.group", Version: ""}
+	storageGV := unversioned.GroupVersion{Group: "test.group", Version: "v2"}
+	readGV := unversioned.GroupVersion{Group: "test.group", Version: "v1"}
+
+	scheme := runtime.NewScheme()
+	scheme.AddInternalGroupVersion(internalGV)
+	scheme.AddKnownTypeWithName(internalGV.WithKind("Simple"), &internalSimple{})
+	scheme.AddKnownTypeWithName(storageGV.WithKind("Simple"), &externalSimple{})
+	scheme.AddKnownTypeWithName(readGV.WithKind("Simple"), &olderExternalSimple{})
+
+	storedData, err := scheme.EncodeToVersion(&internalSimple{Name: "foo"}, storageGV.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	readData, err := scheme.EncodeToVersion(&internalSimple{Name: "bar"}, readGV.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	otherData := []byte(`{"kind":"Simple","apiVersion":"test.group/v3","name":"baz"}`)
+
+	codec := storage.NewMigrationCodec(runtime.CodecFor(scheme, storageGV), scheme, storageGV.String(), []string{readGV.String()})
+	if obj, err := codec.Decode(storedData); err != nil {
+		t.Errorf("unexpected error decoding the storage version: %v", err)
+	} else if obj.(*internalSimple).Name != "foo" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+	obj := &internalSimple{}
+	if err := codec.DecodeInto(readData, obj); err != nil {
+		t.Errorf("unexpected error decoding a read version: %v", err)
+	} else if obj.Name != "bar" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+	if _, err := codec.Decode(otherData); err == nil {
+		t.Errorf("expected an error decoding a version that is not readable")
+	}
+	if err := codec.DecodeInto(otherData, &internalSimple{}); err == nil {
+		t.Errorf("expected an error decoding a version that is not readable")
+	}
+
+	data, err := runtime.Encode(codec, obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), `"apiVersion":"`+storageGV.String()+`"`) {
+		t.Errorf("expected the object to be encoded in the storage version, got %s", data)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"strings"
+
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"golang.org/x/net/context"
+)
+
+// prefixStorage is an Interface that stores the objects of the wrapped Interface
+// under prefix.
+type prefixStorage struct {
+	Interface
+	prefix string
+}
+
+// NewPrefixStorage returns an Interface that prepends prefix to the key of every
+// operation of s, so that several logical clusters can share the keyspace of s
+// without seeing each other's objects. The prefix is relative to the path prefix
+// s itself stores its keys under. If prefix is empty or "/", s is returned
+// unchanged.
+func NewPrefixStorage(s Interface, prefix string) Interface {
+	prefix = strings.Trim(prefix, "/")
+	if len(prefix) == 0 {
+		return s
+	}
+	return &prefixStorage{Interface: s, prefix: "/" + prefix}
+}
+
+func (s *prefixStorage) key(key string) string {
+	if !strings.HasPrefix(key, "/") {
+		key = "/" + key
+	}
+	return s.prefix + key
+}
+
+func (s *prefixStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	return s.Interface.Create(ctx, s.key(key), obj, out, ttl)
+}
+
+func (s *prefixStorage) Set(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	return s.Interface.Set(ctx, s.key(key), obj, out, ttl)
+}
+
+func (s *prefixStorage) Delete(ctx context.Context, key string, out runtime.Object) error {
+	return s.Interface.Delete(ctx, s.key(key), out)
+}
+
+func (s *prefixStorage) Watch(ctx context.Context, key string, resourceVersion string, filter FilterFunc) (watch.Interface, error) {
+	return s.Interface.Watch(ctx, s.key(key), resourceVersion, filter)
+}
+
+func (s *prefixStorage) WatchList(ctx context.Context, key string, resourceVersion string, filter FilterFunc) (watch.Interface, error) {
+	return s.Interface.WatchList(ctx, s.key(key), resourceVersion, filter)
+}
+
+func (s *prefixStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	return s.Interface.Get(ctx, s.key(key), objPtr, ignoreNotFound)
+}
+
+func (s *prefixStorage) GetToList(ctx context.Context, key string, filter FilterFunc, listObj runtime.Object) error {
+	return s.Interface.GetToList(ctx, s.key(key), filter, listObj)
+}
+
+func (s *prefixStorage) List(ctx context.Context, key string, resourceVersion string, filter FilterFunc, listObj runtime.Object) error {
+	return s.Interface.List(ctx, s.key(key), resourceVersion, filter, listObj)
+}
+
+func (s *prefixStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate UpdateFunc) error {
+	return s.Interface.GuaranteedUpdate(ctx, s.key(key), ptrToType, ignoreNotFound, tryUpdate)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"golang.org/x/net/context"
+)
+
+// keyRecordingStorage is an Interface that records the keys it is given.
+type keyRecordingStorage struct {
+	Interface
+	keys []string
+}
+
+func (s *keyRecordingStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	s.keys = append(s.keys, key)
+	return nil
+}
+
+func (s *keyRecordingStorage) List(ctx context.Context, key string, resourceVersion string, filter FilterFunc, listObj runtime.Object) error {
+	s.keys = append(s.keys, key)
+	return nil
+}
+
+func TestPrefixStorage(t *testing.T) {
+	recording := &keyRecordingStorage{}
+	for _, prefix := range []string{"", "/"} {
+		if s := NewPrefixStorage(recording, prefix); s != Interface(recording) {
+			t.Errorf("expected the storage to be returned unchanged for prefix %q, got %#v", prefix, s)
+		}
+	}
+
+	for _, prefix := range []string{"tenant-a", "/tenant-a", "/tenant-a/"} {
+		recording.keys = nil
+		s := NewPrefixStorage(recording, prefix)
+		s.Get(context.TODO(), "/pods/default/foo", &api.Pod{}, false)
+		s.List(context.TODO(), "ThirdPartyResourceData/company.com/foos", "", Everything, &api.PodList{})
+		expected := []string{"/tenant-a/pods/default/foo", "/tenant-a/ThirdPartyResourceData/company.com/foos"}
+		if len(recording.keys) != len(expected) || recording.keys[0] != expected[0] || recording.keys[1] != expected[1] {
+			t.Errorf("prefix %q: expected keys %v, got %v", prefix, expected, recording.keys)
+		}
+	}
+}
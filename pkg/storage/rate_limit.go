@@ -0,0 +1,146 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/juju/ratelimit"
+	"golang.org/x/net/context"
+)
+
+// RateLimiter is a token bucket limiting the rate of the operations of the
+// storage it is shared by.
+type RateLimiter struct {
+	bucket  *ratelimit.Bucket
+	maxWait time.Duration
+}
+
+// NewRateLimiter returns a RateLimiter that lets qps operations start per second,
+// and bursts of up to burst operations. An operation that can't start within
+// maxWait fails with a too many requests error, which is served as a 429. A zero
+// maxWait fails the operations that can't start right away. qps and burst must be
+// positive.
+func NewRateLimiter(qps float64, burst int, maxWait time.Duration) *RateLimiter {
+	return &RateLimiter{
+		bucket:  ratelimit.NewBucketWithRate(qps, int64(burst)),
+		maxWait: maxWait,
+	}
+}
+
+// accept takes a token for the operation on key, waiting for up to maxWait, or
+// returns a too many requests error. The operations the apiserver makes itself,
+// whose ctx isn't that of a client request, are never limited, so that its
+// controllers and the watch caches, which list and watch for all the clients,
+// can't be starved by them.
+func (l *RateLimiter) accept(ctx context.Context, operation, key string) error {
+	if !api.IsClientRequest(ctx) {
+		return nil
+	}
+	if l.bucket.WaitMaxDuration(1, l.maxWait) {
+		return nil
+	}
+	return errors.NewTooManyRequestsError(fmt.Sprintf("the rate of storage operations is limited, %s of %s was rejected", operation, key), 1)
+}
+
+// rateLimitedStorage is an Interface whose operations are limited by a
+// RateLimiter.
+type rateLimitedStorage struct {
+	Interface
+	limiter *RateLimiter
+}
+
+// NewRateLimitedStorage returns an Interface that starts every Create, Set,
+// Delete, Watch, WatchList, Get, GetToList, List and GuaranteedUpdate of s only
+// once limiter lets it, if it is made for a client request. The Interfaces
+// sharing a limiter share its rate. A watch counts once, when it starts. If
+// limiter is nil, s is returned unchanged.
+func NewRateLimitedStorage(s Interface, limiter *RateLimiter) Interface {
+	if limiter == nil {
+		return s
+	}
+	return &rateLimitedStorage{Interface: s, limiter: limiter}
+}
+
+func (s *rateLimitedStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	if err := s.limiter.accept(ctx, "create", key); err != nil {
+		return err
+	}
+	return s.Interface.Create(ctx, key, obj, out, ttl)
+}
+
+func (s *rateLimitedStorage) Set(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	if err := s.limiter.accept(ctx, "set", key); err != nil {
+		return err
+	}
+	return s.Interface.Set(ctx, key, obj, out, ttl)
+}
+
+func (s *rateLimitedStorage) Delete(ctx context.Context, key string, out runtime.Object) error {
+	if err := s.limiter.accept(ctx, "delete", key); err != nil {
+		return err
+	}
+	return s.Interface.Delete(ctx, key, out)
+}
+
+func (s *rateLimitedStorage) Watch(ctx context.Context, key string, resourceVersion string, filter FilterFunc) (watch.Interface, error) {
+	if err := s.limiter.accept(ctx, "watch", key); err != nil {
+		return nil, err
+	}
+	return s.Interface.Watch(ctx, key, resourceVersion, filter)
+}
+
+func (s *rateLimitedStorage) WatchList(ctx context.Context, key string, resourceVersion string, filter FilterFunc) (watch.Interface, error) {
+	if err := s.limiter.accept(ctx, "watch", key); err != nil {
+		return nil, err
+	}
+	return s.Interface.WatchList(ctx, key, resourceVersion, filter)
+}
+
+func (s *rateLimitedStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	if err := s.limiter.accept(ctx, "get", key); err != nil {
+		return err
+	}
+	return s.Interface.Get(ctx, key, objPtr, ignoreNotFound)
+}
+
+func (s *rateLimitedStorage) GetToList(ctx context.Context, key string, filter FilterFunc, listObj runtime.Object) error {
+	if err := s.limiter.accept(ctx, "get", key); err != nil {
+		return err
+	}
+	return s.Interface.GetToList(ctx, key, filter, listObj)
+}
+
+func (s *rateLimitedStorage) List(ctx context.Context, key string, resourceVersion string, filter FilterFunc, listObj runtime.Object) error {
+	if err := s.limiter.accept(ctx, "list", key); err != nil {
+		return err
+	}
+	return s.Interface.List(ctx, key, resourceVersion, filter, listObj)
+}
+
+func (s *rateLimitedStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate UpdateFunc) error {
+	if err := s.limiter.accept(ctx, "update", key); err != nil {
+		return err
+	}
+	return s.Interface.GuaranteedUpdate(ctx, key, ptrToType, ignoreNotFound, tryUpdate)
+}
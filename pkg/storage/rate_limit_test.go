@@ -0,0 +1,88 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"golang.org/x/net/context"
+)
+
+// countingStorage is an Interface that counts its Gets.
+type countingStorage struct {
+	Interface
+	gets int
+}
+
+func (s *countingStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	s.gets++
+	return nil
+}
+
+func TestRateLimitedStorage(t *testing.T) {
+	ctx := api.WithClientRequest(api.NewContext())
+	counting := &countingStorage{}
+	if s := NewRateLimitedStorage(counting, nil); s != Interface(counting) {
+		t.Errorf("expected the storage to be returned unchanged without a limiter, got %#v", s)
+	}
+
+	// The storages sharing the limiter share its burst.
+	limiter := NewRateLimiter(0.001, 2, 0)
+	first, second := NewRateLimitedStorage(counting, limiter), NewRateLimitedStorage(counting, limiter)
+	for _, s := range []Interface{first, second} {
+		if err := s.Get(ctx, "/pods/foo", &api.Pod{}, false); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	err := first.Get(ctx, "/pods/foo", &api.Pod{}, false)
+	statusErr, ok := err.(*errors.StatusError)
+	if !ok {
+		t.Fatalf("expected a status error, got %v", err)
+	}
+	if statusErr.ErrStatus.Code != errors.StatusTooManyRequests {
+		t.Errorf("expected code %d, got %d", errors.StatusTooManyRequests, statusErr.ErrStatus.Code)
+	}
+	if counting.gets != 2 {
+		t.Errorf("expected the rejected get not to reach the storage, got %d gets", counting.gets)
+	}
+
+	// The operations the apiserver makes itself aren't limited.
+	if err := first.Get(context.TODO(), "/pods/foo", &api.Pod{}, false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if counting.gets != 3 {
+		t.Errorf("expected the get of the apiserver to reach the storage, got %d gets", counting.gets)
+	}
+}
+
+func TestRateLimitedStorageWaits(t *testing.T) {
+	counting := &countingStorage{}
+	s := NewRateLimitedStorage(counting, NewRateLimiter(100, 1, time.Minute))
+	for i := 0; i < 3; i++ {
+		if err := s.Get(api.WithClientRequest(api.NewContext()), "/pods/foo", &api.Pod{}, false); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if counting.gets != 3 {
+		t.Errorf("expected 3 gets, got %d", counting.gets)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sync"
+
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// StorageVersionCodec is a runtime.Codec whose storage version can be changed
+// while it is in use. Objects are encoded in the current storage version, and
+// decoded like with the codec returned by NewMigrationCodec. When read versions
+// are set, the storage versions used before stay readable, so that the objects
+// written before a change can still be read.
+type StorageVersionCodec struct {
+	interfacesFunc meta.VersionInterfacesFunc
+	typer          runtime.ObjectTyper
+
+	lock           sync.RWMutex
+	codec          runtime.Codec
+	storageVersion string
+	readVersions   []string
+}
+
+// NewStorageVersionCodec returns a StorageVersionCodec that encodes in
+// storageVersion, with the codec interfacesFunc returns for it, and reads the
+// objects stored in storageVersion or in any of readVersions. With no read
+// versions, objects stored in any version are read.
+func NewStorageVersionCodec(interfacesFunc meta.VersionInterfacesFunc, typer runtime.ObjectTyper, storageVersion string, readVersions []string) (*StorageVersionCodec, error) {
+	c := &StorageVersionCodec{
+		interfacesFunc: interfacesFunc,
+		typer:          typer,
+		readVersions:   append([]string{}, readVersions...),
+	}
+	if err := c.SetStorageVersion(storageVersion); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetStorageVersion makes the objects encoded from now on be stored in version.
+// The previous storage version is added to the read versions, if there are any.
+// It returns an error if version is not known to the codec's interfaces.
+func (c *StorageVersionCodec) SetStorageVersion(version string) error {
+	groupVersion, err := unversioned.ParseGroupVersion(version)
+	if err != nil {
+		return err
+	}
+	interfaces, err := c.interfacesFunc(groupVersion)
+	if err != nil {
+		return fmt.Errorf("unknown storage version %q: %v", version, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.storageVersion) > 0 && len(c.readVersions) > 0 && c.storageVersion != version {
+		readVersions := sets.NewString(c.readVersions...)
+		readVersions.Insert(c.storageVersion)
+		c.readVersions = readVersions.List()
+	}
+	c.storageVersion = version
+	c.codec = NewMigrationCodec(interfaces.Codec, c.typer, version, c.readVersions)
+	return nil
+}
+
+// StorageVersion returns the version objects are currently encoded in.
+func (c *StorageVersionCodec) StorageVersion() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.storageVersion
+}
+
+// current returns the codec of the current storage version.
+func (c *StorageVersionCodec) current() runtime.Codec {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.codec
+}
+
+func (c *StorageVersionCodec) Encode(obj runtime.Object) ([]byte, error) {
+	return c.current().Encode(obj)
+}
+
+func (c *StorageVersionCodec) EncodeToStream(obj runtime.Object, stream io.Writer) error {
+	return c.current().EncodeToStream(obj, stream)
+}
+
+func (c *StorageVersionCodec) Decode(data []byte) (runtime.Object, error) {
+	return c.current().Decode(data)
+}
+
+func (c *StorageVersionCodec) DecodeToVersion(data []byte, groupVersion unversioned.GroupVersion) (runtime.Object, error) {
+	return c.current().DecodeToVersion(data, groupVersion)
+}
+
+func (c *StorageVersionCodec) DecodeInto(data []byte, obj runtime.Object) error {
+	return c.current().DecodeInto(data, obj)
+}
+
+func (c *StorageVersionCodec) DecodeIntoWithSpecifiedVersionKind(data []byte, obj runtime.Object, groupVersionKind unversioned.GroupVersionKind) error {
+	return c.current().DecodeIntoWithSpecifiedVersionKind(data, obj, groupVersionKind)
+}
+
+func (c *StorageVersionCodec) DecodeParametersInto(parameters url.Values, obj runtime.Object) error {
+	return c.current().DecodeParametersInto(parameters, obj)
+}
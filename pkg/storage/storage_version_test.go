@@ -0,0 +1,96 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/testapi"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+)
+
+// testInterfaces returns the interfaces of the legacy and extensions test
+// versions, and fails for any other version.
+func testInterfaces(version unversioned.GroupVersion) (*meta.VersionInterfaces, error) {
+	switch version {
+	case *testapi.Default.GroupVersion():
+		return &meta.VersionInterfaces{Codec: testapi.Default.Codec()}, nil
+	case *testapi.Extensions.GroupVersion():
+		return &meta.VersionInterfaces{Codec: testapi.Extensions.Codec()}, nil
+	}
+	return nil, fmt.Errorf("unsupported version %s", version)
+}
+
+// TestStorageVersionCodec verifies that changing the storage version of the
+// codec changes the version objects are encoded in, and that the objects stored
+// in the previous storage version can still be read.
+func TestStorageVersionCodec(t *testing.T) {
+	defaultVersion := testapi.Default.GroupVersion().String()
+	extensionsVersion := testapi.Extensions.GroupVersion().String()
+	codec, err := storage.NewStorageVersionCodec(testInterfaces, api.Scheme, defaultVersion, []string{"autoscaling/v1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"}}
+	podData, err := runtime.Encode(codec, pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(podData), `"apiVersion":"`+defaultVersion+`"`) {
+		t.Errorf("expected the object to be encoded in %s, got %s", defaultVersion, podData)
+	}
+
+	if err := codec.SetStorageVersion("unknown/v1"); err == nil {
+		t.Errorf("expected an error setting an unknown storage version")
+	}
+	if version := codec.StorageVersion(); version != defaultVersion {
+		t.Errorf("expected storage version %s, got %s", defaultVersion, version)
+	}
+
+	if err := codec.SetStorageVersion(extensionsVersion); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version := codec.StorageVersion(); version != extensionsVersion {
+		t.Errorf("expected storage version %s, got %s", extensionsVersion, version)
+	}
+	job := &extensions.Job{ObjectMeta: api.ObjectMeta{Name: "bar", Namespace: "default"}}
+	jobData, err := runtime.Encode(codec, job)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(jobData), `"apiVersion":"`+extensionsVersion+`"`) {
+		t.Errorf("expected the object to be encoded in %s, got %s", extensionsVersion, jobData)
+	}
+	if obj, err := codec.Decode(podData); err != nil {
+		t.Errorf("unexpected error decoding the previous storage version: %v", err)
+	} else if obj.(*api.Pod).Name != "foo" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+	if obj, err := codec.Decode(jobData); err != nil {
+		t.Errorf("unexpected error decoding the storage version: %v", err)
+	} else if obj.(*extensions.Job).Name != "bar" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"golang.org/x/net/context"
+)
+
+// timeoutStorage is an Interface that bounds the operations of the wrapped
+// Interface with a deadline.
+type timeoutStorage struct {
+	Interface
+	timeout time.Duration
+}
+
+// NewTimeoutStorage returns an Interface that passes every Create, Set, Delete, Get,
+// GetToList, List and GuaranteedUpdate of s a context that expires after timeout,
+// and fails them with a timeout error, which is served as a 504, if s gives up on
+// them when it expires. The etcd storage cancels the reads in flight then, and
+// starts no more writes, but it waits for the writes it has sent, so that a write
+// that was applied is never reported as timed out. The operations run in the
+// calling goroutine, so nothing writes to the objects they were given once they
+// return. Watches are long running and are not subject to the timeout. If timeout
+// is not positive, s is returned unchanged.
+func NewTimeoutStorage(s Interface, timeout time.Duration) Interface {
+	if timeout <= 0 {
+		return s
+	}
+	return &timeoutStorage{Interface: s, timeout: timeout}
+}
+
+// run calls fn with a context that expires after the timeout, and returns a
+// timeout error if fn gave up because it expired.
+func (s *timeoutStorage) run(ctx context.Context, operation, key string, fn func(ctx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.TODO()
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	err := fn(ctx)
+	if err == context.DeadlineExceeded {
+		return errors.NewTimeoutError(fmt.Sprintf("%s of %s did not complete within %v", operation, key, s.timeout), 0)
+	}
+	return err
+}
+
+func (s *timeoutStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	return s.run(ctx, "create", key, func(ctx context.Context) error {
+		return s.Interface.Create(ctx, key, obj, out, ttl)
+	})
+}
+
+func (s *timeoutStorage) Set(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	return s.run(ctx, "set", key, func(ctx context.Context) error {
+		return s.Interface.Set(ctx, key, obj, out, ttl)
+	})
+}
+
+func (s *timeoutStorage) Delete(ctx context.Context, key string, out runtime.Object) error {
+	return s.run(ctx, "delete", key, func(ctx context.Context) error {
+		return s.Interface.Delete(ctx, key, out)
+	})
+}
+
+func (s *timeoutStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	return s.run(ctx, "get", key, func(ctx context.Context) error {
+		return s.Interface.Get(ctx, key, objPtr, ignoreNotFound)
+	})
+}
+
+func (s *timeoutStorage) GetToList(ctx context.Context, key string, filter FilterFunc, listObj runtime.Object) error {
+	return s.run(ctx, "get", key, func(ctx context.Context) error {
+		return s.Interface.GetToList(ctx, key, filter, listObj)
+	})
+}
+
+func (s *timeoutStorage) List(ctx context.Context, key string, resourceVersion string, filter FilterFunc, listObj runtime.Object) error {
+	return s.run(ctx, "list", key, func(ctx context.Context) error {
+		return s.Interface.List(ctx, key, resourceVersion, filter, listObj)
+	})
+}
+
+func (s *timeoutStorage) GuaranteedUpdate(ctx context.Context, key string, ptrToType runtime.Object, ignoreNotFound bool, tryUpdate UpdateFunc) error {
+	return s.run(ctx, "update", key, func(ctx context.Context) error {
+		return s.Interface.GuaranteedUpdate(ctx, key, ptrToType, ignoreNotFound, tryUpdate)
+	})
+}
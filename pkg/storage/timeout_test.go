@@ -0,0 +1,93 @@
+/*
+Copyright 2015 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"golang.org/x/net/context"
+)
+
+// blockingStorage is an Interface whose Get blocks until release is closed or its
+// context is done, and whose Create completes after a delay whatever its context.
+type blockingStorage struct {
+	Interface
+	release chan struct{}
+	delay   time.Duration
+}
+
+func (s *blockingStorage) Get(ctx context.Context, key string, objPtr runtime.Object, ignoreNotFound bool) error {
+	select {
+	case <-s.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *blockingStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	time.Sleep(s.delay)
+	out.(*api.Pod).Name = "created"
+	return nil
+}
+
+func TestTimeoutStorage(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	blocking := &blockingStorage{release: release}
+
+	if s := NewTimeoutStorage(blocking, 0); s != Interface(blocking) {
+		t.Errorf("expected the storage to be returned unchanged without a timeout, got %#v", s)
+	}
+
+	s := NewTimeoutStorage(blocking, 10*time.Millisecond)
+	err := s.Get(context.TODO(), "/pods/foo", &api.Pod{}, false)
+	statusErr, ok := err.(*errors.StatusError)
+	if !ok {
+		t.Fatalf("expected a status error, got %v", err)
+	}
+	if statusErr.ErrStatus.Code != errors.StatusServerTimeout {
+		t.Errorf("expected code %d, got %d", errors.StatusServerTimeout, statusErr.ErrStatus.Code)
+	}
+}
+
+func TestTimeoutStorageCompletes(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	s := NewTimeoutStorage(&blockingStorage{release: release}, time.Minute)
+	if err := s.Get(context.TODO(), "/pods/foo", &api.Pod{}, false); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestTimeoutStorageWaitsForWrites verifies that a write that completes after the
+// timeout reports its outcome rather than a timeout.
+func TestTimeoutStorageWaitsForWrites(t *testing.T) {
+	s := NewTimeoutStorage(&blockingStorage{delay: 50 * time.Millisecond}, 10*time.Millisecond)
+	out := &api.Pod{}
+	if err := s.Create(context.TODO(), "/pods/foo", &api.Pod{}, out, 0); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if out.Name != "created" {
+		t.Errorf("expected the created object, got %#v", out)
+	}
+}
@@ -47,6 +47,17 @@ type watchCacheElement struct {
 	watchCacheEvent watchCacheEvent
 }
 
+// WatchCacheStats describes the window of events kept by a watch cache.
+type WatchCacheStats struct {
+	// The number of events in the window.
+	Size int
+	// The maximum number of events in the window.
+	Capacity int
+	// The number of events removed from the full window to make room for newer
+	// ones. A watch can't start from the resource version of an evicted event.
+	Evictions uint64
+}
+
 // watchCache implements a Store interface.
 // However, it depends on the elements implementing runtime.Object interface.
 //
@@ -71,6 +82,10 @@ type watchCache struct {
 	startIndex int
 	endIndex   int
 
+	// The number of events removed from the full cache to make room for newer
+	// ones.
+	evictions uint64
+
 	// store will effectively support LIST operation from the "end of cache
 	// history" i.e. from the moment just after the newest cached watched event.
 	// It is necessary to effectively allow clients to start watching at now.
@@ -184,11 +199,23 @@ func (w *watchCache) updateCache(resourceVersion uint64, event watchCacheEvent)
 	if w.endIndex == w.startIndex+w.capacity {
 		// Cache is full - remove the oldest element.
 		w.startIndex++
+		w.evictions++
 	}
 	w.cache[w.endIndex%w.capacity] = watchCacheElement{resourceVersion, event}
 	w.endIndex++
 }
 
+// Stats returns the occupancy of the cache and its evictions so far.
+func (w *watchCache) Stats() WatchCacheStats {
+	w.RLock()
+	defer w.RUnlock()
+	return WatchCacheStats{
+		Size:      w.endIndex - w.startIndex,
+		Capacity:  w.capacity,
+		Evictions: w.evictions,
+	}
+}
+
 func (w *watchCache) List() []interface{} {
 	w.RLock()
 	defer w.RUnlock()
@@ -110,6 +110,28 @@ func TestWatchCacheBasic(t *testing.T) {
 	}
 }
 
+// TestWatchCacheStats verifies that the events evicted from the full window are
+// counted.
+func TestWatchCacheStats(t *testing.T) {
+	store := newWatchCache(3)
+	add := func(first, last uint64) {
+		for i := first; i <= last; i++ {
+			store.Add(makeTestPod("pod"+strconv.FormatUint(i, 10), i))
+		}
+	}
+
+	add(1, 2)
+	expected := WatchCacheStats{Size: 2, Capacity: 3, Evictions: 0}
+	if stats := store.Stats(); stats != expected {
+		t.Errorf("expected %#v, got %#v", expected, stats)
+	}
+	add(3, 5)
+	expected = WatchCacheStats{Size: 3, Capacity: 3, Evictions: 2}
+	if stats := store.Stats(); stats != expected {
+		t.Errorf("expected %#v, got %#v", expected, stats)
+	}
+}
+
 func TestEvents(t *testing.T) {
 	store := newWatchCache(5)
 
@@ -32,18 +32,25 @@ type MuxInterface interface {
 	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
 }
 
-func InstallSupport(mux MuxInterface, enableSwaggerSupport bool) {
+// InstallSupport installs the UI at /ui/, and the swagger UI at /swagger-ui/ if
+// enableSwaggerSupport is true. If assetPath is empty, /ui/ redirects to the
+// kube-ui dashboard, otherwise the files in the assetPath directory are served.
+func InstallSupport(mux MuxInterface, enableSwaggerSupport bool, assetPath string) {
 
 	// Send correct mime type for .svg files.  TODO: remove when
 	// https://github.com/golang/go/commit/21e47d831bafb59f22b1ea8098f709677ec8ce33
 	// makes it into all of our supported go versions.
 	mime.AddExtensionType(".svg", "image/svg+xml")
 
-	// Redirect /ui to the kube-ui proxy path
 	prefix := "/ui/"
-	mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
-		http.Redirect(w, r, dashboardPath, http.StatusTemporaryRedirect)
-	})
+	if len(assetPath) > 0 {
+		mux.Handle(prefix, http.StripPrefix(prefix, http.FileServer(http.Dir(assetPath))))
+	} else {
+		// Redirect /ui to the kube-ui proxy path
+		mux.HandleFunc(prefix, func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, dashboardPath, http.StatusTemporaryRedirect)
+		})
+	}
 
 	if enableSwaggerSupport {
 		// Expose files in third_party/swagger-ui/ on <host>/swagger-ui
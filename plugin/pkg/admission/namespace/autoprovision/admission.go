@@ -67,7 +67,8 @@ func (p *provision) Admit(a admission.Attributes) (err error) {
 	if err != nil {
 		return admission.NewForbidden(a, err)
 	}
-	if exists {
+	// the namespace of a dry run is not created, as the dry run creates nothing
+	if exists || a.IsDryRun() {
 		return nil
 	}
 	_, err = p.client.Namespaces().Create(namespace)
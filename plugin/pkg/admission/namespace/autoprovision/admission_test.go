@@ -83,6 +83,30 @@ func TestAdmissionNamespaceExists(t *testing.T) {
 	}
 }
 
+// TestAdmissionDryRun verifies that no namespace is created for a dry run
+func TestAdmissionDryRun(t *testing.T) {
+	namespace := "test"
+	mockClient := &testclient.Fake{}
+	handler := &provision{
+		client: mockClient,
+		store:  cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image"}},
+		},
+	}
+	err := handler.Admit(admission.WithDryRun(admission.NewAttributesRecord(&pod, api.Kind("Pod"), pod.Namespace, pod.Name, api.Resource("pods"), "", admission.Create, nil)))
+	if err != nil {
+		t.Errorf("Unexpected error returned from admission handler")
+	}
+	if len(mockClient.Actions()) != 0 {
+		t.Errorf("No client request should have been made")
+	}
+}
+
 // TestIgnoreAdmission validates that a request is ignored if its not a create
 func TestIgnoreAdmission(t *testing.T) {
 	namespace := "test"
@@ -131,6 +131,11 @@ func (q *quota) Admit(a admission.Attributes) (err error) {
 				return admission.NewForbidden(a, err)
 			}
 
+			// a dry run is checked against the quota without charging it
+			if a.IsDryRun() {
+				break
+			}
+
 			if dirty {
 				// construct a usage record
 				usage := api.ResourceQuota{
@@ -393,7 +393,10 @@ func TestAuthModeAlwaysAllow(t *testing.T) {
 	defer s.Close()
 
 	masterConfig := framework.NewIntegrationTestMasterConfig()
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 	previousResourceVersion := make(map[string]float64)
@@ -496,7 +499,10 @@ func TestAuthModeAlwaysDeny(t *testing.T) {
 
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authorizer = apiserver.NewAlwaysDenyAuthorizer()
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 
@@ -552,7 +558,10 @@ func TestAliceNotForbiddenOrUnauthorized(t *testing.T) {
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = allowAliceAuthorizer{}
 	masterConfig.AdmissionControl = admit.NewAlwaysAdmit()
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	previousResourceVersion := make(map[string]float64)
 	transport := http.DefaultTransport
@@ -626,7 +635,10 @@ func TestBobIsForbidden(t *testing.T) {
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = allowAliceAuthorizer{}
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 
@@ -674,7 +686,10 @@ func TestUnknownUserIsUnauthorized(t *testing.T) {
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = allowAliceAuthorizer{}
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 
@@ -747,7 +762,10 @@ func TestAuthorizationAttributeDetermination(t *testing.T) {
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = trackingAuthorizer
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 
@@ -815,7 +833,10 @@ func TestNamespaceAuthorization(t *testing.T) {
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = a
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	previousResourceVersion := make(map[string]float64)
 	transport := http.DefaultTransport
@@ -917,7 +938,10 @@ func TestKindAuthorization(t *testing.T) {
 	masterConfig := framework.NewIntegrationTestMasterConfig()
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = a
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	previousResourceVersion := make(map[string]float64)
 	transport := http.DefaultTransport
@@ -1007,7 +1031,10 @@ func TestReadOnlyAuthorization(t *testing.T) {
 	masterConfig.Authenticator = getTestTokenAuth()
 	masterConfig.Authorizer = a
 
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	transport := http.DefaultTransport
 
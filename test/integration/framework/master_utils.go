@@ -130,7 +130,10 @@ func startMasterOrDie(masterConfig *master.Config) (*master.Master, *httptest.Se
 		masterConfig.EnableProfiling = true
 		masterConfig.EnableSwaggerSupport = true
 	}
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		glog.Fatalf("Invalid master configuration: %v", err)
+	}
 	return m, s
 }
 
@@ -154,6 +157,8 @@ func NewMasterConfig() *master.Config {
 		APIGroupPrefix:      "/apis",
 		Authorizer:          apiserver.NewAlwaysAllowAuthorizer(),
 		AdmissionControl:    admit.NewAlwaysAdmit(),
+		EnableMetrics:       true,
+		PublicAddress:       net.ParseIP("192.168.10.4"),
 	}
 }
 
@@ -162,7 +167,6 @@ func NewIntegrationTestMasterConfig() *master.Config {
 	masterConfig := NewMasterConfig()
 	masterConfig.EnableCoreControllers = true
 	masterConfig.EnableIndex = true
-	masterConfig.PublicAddress = net.ParseIP("192.168.10.4")
 	return masterConfig
 }
 
@@ -278,7 +282,10 @@ func StartPods(numPods int, host string, restClient *client.Client) error {
 func RunAMaster(t *testing.T) (*master.Master, *httptest.Server) {
 	masterConfig := NewMasterConfig()
 	masterConfig.EnableProfiling = true
-	m := master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		m.Handler.ServeHTTP(w, req)
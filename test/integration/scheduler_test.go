@@ -62,7 +62,10 @@ func TestUnschedulableNodes(t *testing.T) {
 	defer s.Close()
 
 	masterConfig := framework.NewIntegrationTestMasterConfig()
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		t.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	restClient := client.NewOrDie(&client.Config{Host: s.URL, GroupVersion: testapi.Default.GroupVersion()})
 
@@ -285,7 +288,10 @@ func BenchmarkScheduling(b *testing.B) {
 	defer s.Close()
 
 	masterConfig := framework.NewIntegrationTestMasterConfig()
-	m = master.New(masterConfig)
+	m, err := master.New(masterConfig)
+	if err != nil {
+		b.Fatalf("Invalid master configuration: %v", err)
+	}
 
 	c := client.NewOrDie(&client.Config{
 		Host:         s.URL,